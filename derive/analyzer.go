@@ -0,0 +1,258 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/packages"
+)
+
+// NewAnalyzer returns an analyzer that reports derive function calls that are not supported by the plugins,
+// functions that have not been generated yet and a derived.gen.go file that is out of date.
+//
+// When the package already has a derived.gen.go file, the diagnostics suggest a fix that replaces its contents with the regenerated code.
+// Analyzers cannot create files, so goderive has to be run to create the derived.gen.go file.
+// Unlike goderive, the analyzer does not reload the package, so calls with arguments that are themselves
+// derive function calls that are not yet generated, are reported as undefined.
+//
+// go vet, using unitchecker, does not run analyzers on packages that do not type check,
+// which includes every package with a call to a derive function that has not been generated yet.
+// Only an out of date derived.gen.go file is reported by go vet, since it does not cause type errors.
+func NewAnalyzer(plugins []Plugin) *analysis.Analyzer {
+	sortPlugins(plugins)
+	return &analysis.Analyzer{
+		Name: "goderive",
+		Doc:  "report derive function calls, for which the generated code is missing or out of date",
+		URL:  "https://github.com/awalterschulze/goderive",
+		// Calls to functions that have not been generated yet, are type errors.
+		RunDespiteErrors: true,
+		Run: func(pass *analysis.Pass) (interface{}, error) {
+			return nil, analyze(pass, plugins)
+		},
+	}
+}
+
+func analyze(pass *analysis.Pass, plugins []Plugin) error {
+	pkgInfo := &packages.Package{
+		ID:        pass.Pkg.Path(),
+		Name:      pass.Pkg.Name(),
+		PkgPath:   pass.Pkg.Path(),
+		Fset:      pass.Fset,
+		Syntax:    pass.Files,
+		Types:     pass.Pkg,
+		TypesInfo: pass.TypesInfo,
+	}
+	for _, f := range pass.Files {
+		if file := pass.Fset.File(f.FileStart); file != nil {
+			pkgInfo.GoFiles = append(pkgInfo.GoFiles, file.Name())
+		}
+	}
+	if len(pkgInfo.GoFiles) > 0 {
+		if modDir, modPath := module(filepath.Dir(pkgInfo.GoFiles[0])); len(modDir) > 0 {
+			pkgInfo.Module = &packages.Module{Dir: modDir, Path: modPath}
+		}
+	}
+	cs, err := configFiles(pkgInfo, plugins, nil)
+	if err != nil {
+		return err
+	}
+	s := resolve(plugins, false, false, cs, nil)
+	// Function names are never changed, since these would be edits to files other than the derived file.
+	s.autoname, s.dedup = false, false
+	pkgGen, err := newPackage(pkgInfo, s, newFiles(nil), log.New(io.Discard, "", 0))
+	if err != nil {
+		return err
+	}
+	for _, u := range pkgGen.undefined {
+		msg := "cannot yet infer the argument types of " + types.ExprString(u.Expr) + ", please run goderive"
+		report(pass, u.Expr.Pos(), CodeUndefined, msg, nil)
+	}
+	pkgGen.Generate()
+	for _, d := range pkgGen.diagnostics {
+		report(pass, d.pos, d.Code, d.Plugin+": "+d.Message, nil)
+	}
+	if len(pkgGen.diagnostics) > 0 {
+		return nil
+	}
+	contents, err := pkgGen.Contents()
+	if err != nil {
+		return err
+	}
+
+	// The fix replaces the whole contents of the existing derived files, which is only done if no existing functions would be removed,
+	// since the package could be analyzed without its test files, that also call derive functions.
+	// Analyzers cannot create files, so derived files that do not exist yet, are left to goderive.
+	var edits []analysis.TextEdit
+	var names []string
+	type staleFile struct {
+		file *ast.File
+		name string
+	}
+	var stale []staleFile
+	for _, name := range pkgGen.outputs.all() {
+		content, ok := contents[name]
+		if !ok {
+			continue
+		}
+		derivedFile := findDerivedFile(pass, name)
+		if derivedFile == nil {
+			continue
+		}
+		existing, err := readFile(pass, pass.Fset.File(derivedFile.FileStart).Name())
+		if err != nil {
+			return err
+		}
+		generatedFuncs, err := funcDecls(name, content)
+		if err != nil {
+			return err
+		}
+		existingFuncs, err := funcDecls(name, existing)
+		if err != nil {
+			return err
+		}
+		if content != nil && !bytes.Equal(existing, content) && containsAll(generatedFuncs, existingFuncs) {
+			edits = append(edits, analysis.TextEdit{
+				Pos:     derivedFile.FileStart,
+				End:     derivedFile.FileEnd,
+				NewText: content,
+			})
+			names = append(names, name)
+		}
+		for funcName, decl := range generatedFuncs {
+			if old, ok := existingFuncs[funcName]; ok && old != decl {
+				stale = append(stale, staleFile{derivedFile, name})
+				break
+			}
+		}
+	}
+	var fixes []analysis.SuggestedFix
+	if len(edits) > 0 {
+		fixes = []analysis.SuggestedFix{{
+			Message:   "Regenerate " + strings.Join(names, ", "),
+			TextEdits: edits,
+		}}
+	}
+
+	for _, fileInfo := range newFileInfos(pkgInfo, pkgGen.outputs) {
+		for _, call := range fileInfo.undefined {
+			if call.HasUndefined() {
+				continue
+			}
+			plugin := pkgGen.pluginName(call.Name)
+			if len(plugin) == 0 {
+				continue
+			}
+			msg := plugin + ": " + call.Name + " has not been generated yet, please run goderive"
+			report(pass, call.Expr.Pos(), CodeMissing, msg, fixes)
+		}
+	}
+
+	for _, f := range stale {
+		msg := f.name + " is out of date, please run goderive"
+		report(pass, f.file.Name.Pos(), CodeStale, msg, fixes)
+	}
+	return nil
+}
+
+func report(pass *analysis.Pass, pos token.Pos, code, msg string, fixes []analysis.SuggestedFix) {
+	pass.Report(analysis.Diagnostic{
+		Pos:            pos,
+		Category:       code,
+		Message:        msg,
+		SuggestedFixes: fixes,
+	})
+}
+
+func findDerivedFile(pass *analysis.Pass, output string) *ast.File {
+	for _, f := range pass.Files {
+		file := pass.Fset.File(f.FileStart)
+		if file != nil && filepath.Base(file.Name()) == output {
+			return f
+		}
+	}
+	return nil
+}
+
+// readFile reads the file using the driver, which could have unsaved changes, if the driver supports it.
+func readFile(pass *analysis.Pass, filename string) ([]byte, error) {
+	if pass.ReadFile != nil {
+		return pass.ReadFile(filename)
+	}
+	return os.ReadFile(filename)
+}
+
+// funcDecls returns the source of each function declaration, including its doc comment, by function name.
+func funcDecls(filename string, content []byte) (map[string]string, error) {
+	decls := make(map[string]string)
+	if content == nil {
+		return decls, nil
+	}
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, content, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok {
+			continue
+		}
+		start := fn.Pos()
+		if fn.Doc != nil {
+			start = fn.Doc.Pos()
+		}
+		decls[fn.Name.Name] = string(content[fset.Position(start).Offset:fset.Position(fn.End()).Offset])
+	}
+	return decls, nil
+}
+
+// module returns the directory and the module path of the go.mod file, that is in the directory or one of its parents.
+func module(dir string) (string, string) {
+	for {
+		if data, err := os.ReadFile(filepath.Join(dir, "go.mod")); err == nil {
+			for _, line := range strings.Split(string(data), "\n") {
+				if fields := strings.Fields(line); len(fields) == 2 && fields[0] == "module" {
+					return dir, strings.Trim(fields[1], `"`)
+				}
+			}
+			return dir, ""
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", ""
+		}
+		dir = parent
+	}
+}
+
+func containsAll(this, that map[string]string) bool {
+	for name := range that {
+		if _, ok := this[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
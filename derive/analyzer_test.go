@@ -0,0 +1,25 @@
+package derive_test
+
+import (
+	"testing"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/equal"
+	"awalterschulze.org/go/goderive/plugin/filter"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+func TestAnalyzerStale(t *testing.T) {
+	analyzer := derive.NewAnalyzer([]derive.Plugin{equal.NewPlugin()})
+	analysistest.RunWithSuggestedFixes(t, analysistest.TestData(), analyzer, "stale")
+}
+
+func TestAnalyzerMissing(t *testing.T) {
+	analyzer := derive.NewAnalyzer([]derive.Plugin{equal.NewPlugin()})
+	analysistest.Run(t, analysistest.TestData(), analyzer, "missing")
+}
+
+func TestAnalyzerInvalid(t *testing.T) {
+	analyzer := derive.NewAnalyzer([]derive.Plugin{filter.NewPlugin()})
+	analysistest.Run(t, analysistest.TestData(), analyzer, "invalid")
+}
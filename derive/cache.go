@@ -0,0 +1,249 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"runtime/debug"
+	"sort"
+	"sync"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// DefaultCacheDir returns the default directory of the cache, which is goderive inside the user's cache directory.
+func DefaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "goderive"), nil
+}
+
+// Version returns the version of the goderive module, which is (devel) if it is not known.
+func Version() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "(devel)"
+	}
+	const path = "awalterschulze.org/go/goderive"
+	if info.Main.Path == path && len(info.Main.Version) > 0 {
+		return info.Main.Version
+	}
+	for _, dep := range info.Deps {
+		if dep.Path == path {
+			return dep.Version
+		}
+	}
+	return "(devel)"
+}
+
+// Stats are the statistics of a single run.
+type Stats struct {
+	// Dirs is the number of directories, for which a derived file was generated or removed.
+	Dirs int
+	// CacheHits is the number of directories, for which the derived file was found in the cache.
+	CacheHits int
+	// CacheMisses is the number of directories, for which the derived file had to be generated.
+	CacheMisses int
+}
+
+// cache stores the derived files of a directory, keyed by a hash of the configuration and the sources of its packages,
+// including the sources of all their dependencies.
+// The derived files are stored together as a JSON object, with the content of each derived file by filename,
+// where null means that the derived file was removed.
+type cache struct {
+	dir string
+	// config is the hash of the goderive version, the executable and the build tags.
+	// The settings of each directory are added to the key separately.
+	config []byte
+	// hashes contains the hash of the sources of each package, including its dependencies, by package ID.
+	hashes map[string][]byte
+	// overlay replaces the contents of files on disk.
+	overlay map[string][]byte
+
+	mu     sync.Mutex
+	hits   int
+	misses int
+}
+
+func newCache(dir string, pg *program) (*cache, error) {
+	h := sha256.New()
+	fmt.Fprintf(h, "goderive %s\n", Version())
+	// The version is not enough for development builds, so the executable itself is also part of the key.
+	exe, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	if err := hashFile(h, exe); err != nil {
+		return nil, err
+	}
+	fmt.Fprintf(h, "tags %q\n", pg.tags)
+	c := &cache{
+		dir:     dir,
+		config:  h.Sum(nil),
+		hashes:  make(map[string][]byte),
+		overlay: pg.overlay,
+	}
+	for _, pkg := range pg.pkgs {
+		if _, err := c.hashPackage(pkg); err != nil {
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+// hashPackage returns the hash of the sources of the package and the hashes of its dependencies.
+// Derived files are left out, since their contents are the output of goderive,
+// except for their exported functions and methods, which can change the code that is generated for other packages, see ConfigFile.Export.
+// Derived files are recognized by their header, since their filenames can be configured.
+func (c *cache) hashPackage(pkg *packages.Package) ([]byte, error) {
+	if sum, ok := c.hashes[pkg.ID]; ok {
+		return sum, nil
+	}
+	h := sha256.New()
+	fmt.Fprintf(h, "package %s\n", pkg.ID)
+	for _, filename := range pkg.CompiledGoFiles {
+		if err := hashSource(h, filename, c.overlay); err != nil {
+			return nil, err
+		}
+	}
+	paths := make([]string, 0, len(pkg.Imports))
+	for path := range pkg.Imports {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		sum, err := c.hashPackage(pkg.Imports[path])
+		if err != nil {
+			return nil, err
+		}
+		fmt.Fprintf(h, "import %s %x\n", path, sum)
+	}
+	sum := h.Sum(nil)
+	c.hashes[pkg.ID] = sum
+	return sum, nil
+}
+
+// exportedDecls matches the lines that declare the exported functions and methods in a derived file.
+var exportedDecls = regexp.MustCompile(`(?m)^func (\([^)]*\) )?[A-Z].*$`)
+
+// hashSource adds the content of a source file to the hash, or only the exported declarations of a derived file.
+func hashSource(h hash.Hash, filename string, overlay map[string][]byte) error {
+	content, ok := overlay[filename]
+	if !ok {
+		var err error
+		content, err = os.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+	}
+	if isDerived(content) {
+		for _, decl := range exportedDecls.FindAll(content, -1) {
+			h.Write(decl)
+			io.WriteString(h, "\n")
+		}
+		return nil
+	}
+	fmt.Fprintf(h, "file %s %d\n", filepath.Base(filename), len(content))
+	h.Write(content)
+	return nil
+}
+
+func hashFile(h hash.Hash, filename string) error {
+	fmt.Fprintf(h, "file %s\n", filepath.Base(filename))
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(h, "%d\n", info.Size())
+	_, err = io.Copy(h, f)
+	return err
+}
+
+// key returns the key of the derived files for the packages in a directory, with the given settings.
+func (c *cache) key(pkgs []*packages.Package, s *settings) string {
+	h := sha256.New()
+	h.Write(c.config)
+	io.WriteString(h, s.String())
+	for _, pkg := range pkgs {
+		h.Write(c.hashes[pkg.ID])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Get returns the contents of the derived files by filename and whether they were found in the cache.
+// A nil content means that the derived file was removed.
+func (c *cache) Get(key string) (map[string][]byte, bool) {
+	data, err := os.ReadFile(filepath.Join(c.dir, key[:2], key))
+	var contents map[string][]byte
+	if err == nil {
+		err = json.Unmarshal(data, &contents)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if err != nil {
+		c.misses++
+		return nil, false
+	}
+	c.hits++
+	return contents, true
+}
+
+// Put stores the contents of the derived files in the cache.
+// The file is written to a temporary file first, so that a concurrent goderive never reads a partially written file.
+func (c *cache) Put(key string, contents map[string][]byte) error {
+	content, err := json.Marshal(contents)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Join(c.dir, key[:2])
+	if err := os.MkdirAll(dir, 0777); err != nil {
+		return err
+	}
+	f, err := os.CreateTemp(dir, key+".tmp*")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), filepath.Join(dir, key))
+}
+
+func (c *cache) stats() (hits, misses int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.hits, c.misses
+}
@@ -0,0 +1,59 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// Clean loads the packages matching the patterns and returns a result, that removes their derived files,
+// including the derived test files.
+// Only files that start with the generated comment of goderive are removed, see Result.Write.
+func Clean(config Config, patterns ...string) (*Result, error) {
+	prog, err := newProgram(config, patterns)
+	if err != nil {
+		return nil, err
+	}
+	return prog.clean()
+}
+
+func (pg *program) clean() (*Result, error) {
+	res := &Result{Files: make(map[string][]byte), Graph: &Graph{}}
+	for _, d := range groupByDir(pg.pkgs) {
+		s, err := pg.settings(d[0])
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range newOutputs(s.output).all() {
+			filename := filepath.Join(dir(d[0]), name)
+			content, ok := pg.overlay[filename]
+			if !ok {
+				content, err = os.ReadFile(filename)
+				if os.IsNotExist(err) {
+					continue
+				}
+				if err != nil {
+					return nil, err
+				}
+			}
+			if isDerived(content) {
+				res.Files[filename] = nil
+			}
+		}
+		res.Stats.Dirs++
+	}
+	return res, nil
+}
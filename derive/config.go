@@ -0,0 +1,376 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/build/constraint"
+	"os"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// ConfigFilename is the name of the configuration file,
+// that is read from the root directory of the module and from the directory of each package.
+const ConfigFilename = "goderive.json"
+
+// ConfigFile is the configuration of goderive, which can be set in a goderive.json file.
+// For example:
+//
+//	{
+//		"prefix": "gen",
+//		"prefixes": {"equal": "eq"},
+//		"plugins": {"gostring": false},
+//		"autoname": true,
+//		"output": "goderive.gen.go",
+//		"shared": "internal/derived",
+//		"header": "Copyright 2017 The Authors",
+//		"build": "!purego",
+//		"generate": "goderive .",
+//		"metadata": true,
+//		"options": {"keys": {"sorted": "true"}}
+//	}
+type ConfigFile struct {
+	// Prefix replaces derive in the default prefix of every plugin, for example deriveEqual becomes genEqual.
+	Prefix *string `json:"prefix,omitempty"`
+	// Prefixes sets the prefix of a plugin by plugin name and takes precedence over Prefix.
+	Prefixes map[string]string `json:"prefixes,omitempty"`
+	// Plugins enables or disables plugins by name. All plugins are enabled by default.
+	Plugins map[string]bool `json:"plugins,omitempty"`
+	// Autoname renames functions that are conflicting with other functions.
+	Autoname *bool `json:"autoname,omitempty"`
+	// Dedup renames functions to functions that are duplicates.
+	Dedup *bool `json:"dedup,omitempty"`
+	// Output is the filename of the generated file. The default is derived.gen.go.
+	Output string `json:"output,omitempty"`
+	// Shared is the directory of a package, relative to the root directory of the module,
+	// where the functions for types from the standard library and other modules are generated, for example internal/derived.
+	// Derived files call these exported functions, instead of each package generating its own copy.
+	Shared string `json:"shared,omitempty"`
+	// Header is a comment, for example a license, that is written at the top of the derived files.
+	Header string `json:"header,omitempty"`
+	// Build is a build constraint expression, that is written as a //go:build line in the derived files.
+	Build string `json:"build,omitempty"`
+	// Generate is a command, that is written as a //go:generate line in the derived files, for example goderive .
+	Generate string `json:"generate,omitempty"`
+	// Metadata writes a comment with the version of goderive and the plugins in the derived files,
+	// so that reviewers know how to reproduce them.
+	Metadata *bool `json:"metadata,omitempty"`
+	// Safe never uses the reflect and unsafe packages to access the private fields of structs in external packages.
+	// Plugins use the exported methods of these types instead, where possible, and otherwise report the private field.
+	Safe *bool `json:"safe,omitempty"`
+	// Export adds an exported function to the derived file, for every derived function with an exported type of the package as argument,
+	// for example DeriveEqual_PtrTo_User, so that the derived files of other packages call it, instead of generating their own copy.
+	Export *bool `json:"export,omitempty"`
+	// Options are the options of each plugin by plugin name.
+	// Options can only be set for plugins with generators that implement Configurable.
+	Options map[string]map[string]string `json:"options,omitempty"`
+}
+
+// Configurable is implemented by a Generator that has options, which can be set in a goderive.json file.
+type Configurable interface {
+	Configure(options map[string]string) error
+}
+
+// ReadConfigFile reads and validates a goderive.json file.
+// The names of plugins are checked against the given plugins.
+func ReadConfigFile(filename string, plugins []Plugin) (*ConfigFile, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return parseConfigFile(filename, data, plugins)
+}
+
+func parseConfigFile(filename string, data []byte, plugins []Plugin) (*ConfigFile, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	c := &ConfigFile{}
+	if err := dec.Decode(c); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	if err := c.Validate(plugins); err != nil {
+		return nil, fmt.Errorf("%s: %v", filename, err)
+	}
+	return c, nil
+}
+
+// Validate returns an error if the configuration refers to plugins that do not exist or has an invalid output filename.
+func (c *ConfigFile) Validate(plugins []Plugin) error {
+	names := make(map[string]bool, len(plugins))
+	for _, p := range plugins {
+		names[p.Name()] = true
+	}
+	var unknown []string
+	for name := range c.Prefixes {
+		if !names[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	for name := range c.Plugins {
+		if !names[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	for name := range c.Options {
+		if !names[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return fmt.Errorf("unknown plugins: %s", strings.Join(unknown, ", "))
+	}
+	if len(c.Output) > 0 {
+		if filepath.Base(c.Output) != c.Output || !strings.HasSuffix(c.Output, ".go") || strings.HasSuffix(c.Output, "_test.go") {
+			return fmt.Errorf("output %s must be the name of a go file, that is not a test file, without a directory", c.Output)
+		}
+	}
+	if len(c.Build) > 0 {
+		if _, err := constraint.Parse("//go:build " + c.Build); err != nil {
+			return fmt.Errorf("build %s: %v", c.Build, err)
+		}
+	}
+	if len(c.Shared) > 0 {
+		if pathpkg.IsAbs(c.Shared) || pathpkg.Clean(c.Shared) != c.Shared || c.Shared == "." || c.Shared == ".." || strings.HasPrefix(c.Shared, "../") {
+			return fmt.Errorf("shared %s must be a directory inside the module, relative to the root directory of the module", c.Shared)
+		}
+	}
+	return nil
+}
+
+// merge returns a new configuration, where the fields that are set in other, replace the fields in c.
+// Prefixes, plugins and the options of each plugin are merged by name.
+func (c *ConfigFile) merge(other *ConfigFile) *ConfigFile {
+	if other == nil {
+		return c
+	}
+	m := *c
+	if other.Prefix != nil {
+		m.Prefix = other.Prefix
+	}
+	m.Prefixes = mergeStrings(c.Prefixes, other.Prefixes)
+	m.Plugins = make(map[string]bool, len(c.Plugins)+len(other.Plugins))
+	for name, enabled := range c.Plugins {
+		m.Plugins[name] = enabled
+	}
+	for name, enabled := range other.Plugins {
+		m.Plugins[name] = enabled
+	}
+	if other.Autoname != nil {
+		m.Autoname = other.Autoname
+	}
+	if other.Dedup != nil {
+		m.Dedup = other.Dedup
+	}
+	if len(other.Output) > 0 {
+		m.Output = other.Output
+	}
+	if len(other.Shared) > 0 {
+		m.Shared = other.Shared
+	}
+	if len(other.Header) > 0 {
+		m.Header = other.Header
+	}
+	if len(other.Build) > 0 {
+		m.Build = other.Build
+	}
+	if len(other.Generate) > 0 {
+		m.Generate = other.Generate
+	}
+	if other.Metadata != nil {
+		m.Metadata = other.Metadata
+	}
+	if other.Safe != nil {
+		m.Safe = other.Safe
+	}
+	if other.Export != nil {
+		m.Export = other.Export
+	}
+	m.Options = make(map[string]map[string]string, len(c.Options)+len(other.Options))
+	for name, options := range c.Options {
+		m.Options[name] = options
+	}
+	for name, options := range other.Options {
+		m.Options[name] = mergeStrings(m.Options[name], options)
+	}
+	return &m
+}
+
+func mergeStrings(this, that map[string]string) map[string]string {
+	m := make(map[string]string, len(this)+len(that))
+	for k, v := range this {
+		m[k] = v
+	}
+	for k, v := range that {
+		m[k] = v
+	}
+	return m
+}
+
+// settings are the resolved configuration of a single package.
+type settings struct {
+	plugins  []Plugin
+	autoname bool
+	dedup    bool
+	output   string
+	shared   string
+	header   Header
+	metadata bool
+	safe     bool
+	export   bool
+	options  map[string]map[string]string
+}
+
+// newSettings resolves the configuration, by applying the prefixes and enabling the plugins.
+// The plugins are wrapped, so that the prefixes of the given plugins are not changed.
+func newSettings(plugins []Plugin, c *ConfigFile) *settings {
+	s := &settings{
+		output:  derivedFilename,
+		options: c.Options,
+	}
+	if c.Autoname != nil {
+		s.autoname = *c.Autoname
+	}
+	if c.Dedup != nil {
+		s.dedup = *c.Dedup
+	}
+	if len(c.Output) > 0 {
+		s.output = c.Output
+	}
+	s.shared = c.Shared
+	s.header = Header{License: c.Header, Build: c.Build, Generate: c.Generate}
+	if c.Metadata != nil {
+		s.metadata = *c.Metadata
+	}
+	if c.Safe != nil {
+		s.safe = *c.Safe
+	}
+	if c.Export != nil {
+		s.export = *c.Export
+	}
+	for _, p := range plugins {
+		if enabled, ok := c.Plugins[p.Name()]; ok && !enabled {
+			continue
+		}
+		prefix := p.GetPrefix()
+		if c.Prefix != nil {
+			prefix = strings.Replace(prefix, "derive", *c.Prefix, 1)
+		}
+		if override, ok := c.Prefixes[p.Name()]; ok {
+			prefix = override
+		}
+		s.plugins = append(s.plugins, &configuredPlugin{p, prefix})
+	}
+	sortPlugins(s.plugins)
+	if s.metadata {
+		s.header.Metadata = metadata(s.plugins)
+	}
+	return s
+}
+
+// metadata returns the version of goderive and the names of the plugins, with their prefixes if these were changed.
+func metadata(plugins []Plugin) string {
+	names := make([]string, len(plugins))
+	for i, p := range plugins {
+		names[i] = p.Name()
+		if c, ok := p.(*configuredPlugin); ok && c.Plugin.GetPrefix() != c.prefix {
+			names[i] += "=" + c.prefix
+		}
+	}
+	sort.Strings(names)
+	return "goderive " + Version() + " with plugins " + strings.Join(names, ", ")
+}
+
+// String returns a description of the settings, that is used as part of the key of the cache.
+func (s *settings) String() string {
+	var b strings.Builder
+	for _, p := range s.plugins {
+		fmt.Fprintf(&b, "plugin %s %s %v\n", p.Name(), p.GetPrefix(), s.options[p.Name()])
+	}
+	fmt.Fprintf(&b, "autoname %v\ndedup %v\noutput %s\nshared %s\n", s.autoname, s.dedup, s.output, s.shared)
+	fmt.Fprintf(&b, "header %q\n", s.header)
+	fmt.Fprintf(&b, "safe %v\nexport %v\n", s.safe, s.export)
+	return b.String()
+}
+
+// resolve merges the configuration files, in order, on top of the defaults and resolves the settings.
+func resolve(plugins []Plugin, autoname, dedup bool, cs []*ConfigFile, override *ConfigFile) *settings {
+	c := &ConfigFile{Autoname: &autoname, Dedup: &dedup}
+	for _, file := range cs {
+		c = c.merge(file)
+	}
+	return newSettings(plugins, c.merge(override))
+}
+
+// configuredPlugin is a plugin with the prefix of a package.
+type configuredPlugin struct {
+	Plugin
+	prefix string
+}
+
+func (p *configuredPlugin) GetPrefix() string {
+	return p.prefix
+}
+
+func (p *configuredPlugin) SetPrefix(prefix string) {
+	p.prefix = prefix
+}
+
+// configFiles reads the goderive.json files of the package,
+// first from the root directory of the module and then from the directory of the package.
+func configFiles(pkg *packages.Package, plugins []Plugin, overlay map[string][]byte) ([]*ConfigFile, error) {
+	var cs []*ConfigFile
+	for _, filename := range configFilenames(pkg) {
+		data, ok := overlay[filename]
+		if !ok {
+			var err error
+			data, err = os.ReadFile(filename)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		c, err := parseConfigFile(filename, data, plugins)
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	return cs, nil
+}
+
+// configFilenames returns the names of the goderive.json files, that could apply to the package, whether they exist or not.
+func configFilenames(pkg *packages.Package) []string {
+	var filenames []string
+	if pkg.Module != nil && len(pkg.Module.Dir) > 0 {
+		filenames = append(filenames, filepath.Join(pkg.Module.Dir, ConfigFilename))
+	}
+	if d := dir(pkg); filepath.IsAbs(d) {
+		filename := filepath.Join(d, ConfigFilename)
+		if len(filenames) == 0 || filenames[0] != filename {
+			filenames = append(filenames, filename)
+		}
+	}
+	return filenames
+}
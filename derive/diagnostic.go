@@ -0,0 +1,127 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"encoding/json"
+	"go/token"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Codes are machine readable descriptions of the kind of problem a Diagnostic reports.
+const (
+	// CodeInvalidCall is reported when a plugin does not support the arguments of a call.
+	CodeInvalidCall = "invalid-call"
+	// CodeGenerate is reported when a plugin failed to generate a function.
+	CodeGenerate = "generate"
+	// CodeUndefined is reported when the argument types of a call could not be inferred.
+	CodeUndefined = "undefined"
+	// CodeInvalidDirective is reported when a //goderive:methods directive cannot be satisfied.
+	CodeInvalidDirective = "invalid-directive"
+	// CodeMissing is reported by the Analyzer when a called function has not been generated yet.
+	CodeMissing = "missing"
+	// CodeStale is reported by the Analyzer when the derived file is out of date.
+	CodeStale = "stale"
+)
+
+// Diagnostic is a problem, found while generating code, that can be traced back to a derive function call.
+type Diagnostic struct {
+	// Pos is the position of the call, if it is known.
+	Pos token.Position
+	// Plugin is the name of the plugin that reported the problem.
+	Plugin  string
+	Code    string
+	Message string
+
+	pos token.Pos
+}
+
+func (d *Diagnostic) Error() string {
+	s := d.Plugin + ": " + d.Message
+	if d.Pos.IsValid() {
+		s = d.Pos.String() + ": " + s
+	}
+	return s
+}
+
+type jsonDiagnostic struct {
+	Filename string `json:"filename,omitempty"`
+	Line     int    `json:"line,omitempty"`
+	Column   int    `json:"column,omitempty"`
+	Plugin   string `json:"plugin"`
+	Code     string `json:"code"`
+	Message  string `json:"message"`
+}
+
+// MarshalJSON returns the diagnostic as a flat JSON object.
+func (d *Diagnostic) MarshalJSON() ([]byte, error) {
+	return json.Marshal(jsonDiagnostic{
+		Filename: d.Pos.Filename,
+		Line:     d.Pos.Line,
+		Column:   d.Pos.Column,
+		Plugin:   d.Plugin,
+		Code:     d.Code,
+		Message:  d.Message,
+	})
+}
+
+// Diagnostics is returned as an error when code could not be generated for some calls.
+// It contains all the problems that were found and not only the first.
+type Diagnostics []*Diagnostic
+
+func (ds Diagnostics) Error() string {
+	ss := make([]string, len(ds))
+	for i, d := range ds {
+		ss[i] = d.Error()
+	}
+	return strings.Join(ss, "\n")
+}
+
+// WriteJSON writes every diagnostic as a JSON object on its own line.
+func (ds Diagnostics) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, d := range ds {
+		if err := enc.Encode(d); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sortDiagnostics sorts the diagnostics by position, so that the output is the same for every run.
+func sortDiagnostics(ds Diagnostics) {
+	sort.SliceStable(ds, func(i, j int) bool {
+		pi, pj := ds[i].Pos, ds[j].Pos
+		if pi.Filename != pj.Filename {
+			return pi.Filename < pj.Filename
+		}
+		if pi.Offset != pj.Offset {
+			return pi.Offset < pj.Offset
+		}
+		return ds[i].Message < ds[j].Message
+	})
+}
+
+func newDiagnostic(fset *token.FileSet, pos token.Pos, plugin, code string, err error) *Diagnostic {
+	return &Diagnostic{
+		Pos:     fset.Position(pos),
+		pos:     pos,
+		Plugin:  plugin,
+		Code:    code,
+		Message: err.Error(),
+	}
+}
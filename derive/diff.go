@@ -0,0 +1,260 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+)
+
+const diffContext = 3
+
+type edit struct {
+	op   byte // ' ' for an unchanged line, '-' for a deleted line and '+' for an inserted line.
+	line string
+}
+
+// unifiedDiff writes a unified diff between the old and new content to w.
+// The old or new name can be /dev/null, when a file is created or deleted.
+func unifiedDiff(w io.Writer, oldName, newName string, old, new []byte) error {
+	edits := diffLines(splitLines(old), splitLines(new))
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "--- %s\n+++ %s\n", oldName, newName)
+	oldLine, newLine := 0, 0
+	i := 0
+	for i < len(edits) {
+		if edits[i].op == ' ' {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+		// find the start and end of the hunk, including the context and any changes that are close by.
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		end := i
+		for end < len(edits) {
+			if edits[end].op != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(edits) && edits[next].op == ' ' {
+				next++
+			}
+			if next == len(edits) || next-end > 2*diffContext {
+				end += diffContext
+				if end > len(edits) {
+					end = len(edits)
+				}
+				break
+			}
+			end = next
+		}
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		oldLen, newLen := 0, 0
+		for _, e := range edits[start:end] {
+			if e.op != '+' {
+				oldLen++
+			}
+			if e.op != '-' {
+				newLen++
+			}
+		}
+		fmt.Fprintf(buf, "@@ -%s +%s @@\n", hunkRange(oldStart, oldLen), hunkRange(newStart, newLen))
+		for _, e := range edits[start:end] {
+			buf.WriteByte(e.op)
+			buf.WriteString(e.line)
+			if len(e.line) == 0 || e.line[len(e.line)-1] != '\n' {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		oldLine, newLine = oldStart+oldLen, newStart+newLen
+		i = end
+	}
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+func hunkRange(start, length int) string {
+	if length == 0 {
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
+
+func splitLines(content []byte) []string {
+	var lines []string
+	for len(content) > 0 {
+		i := bytes.IndexByte(content, '\n')
+		if i < 0 {
+			lines = append(lines, string(content))
+			break
+		}
+		lines = append(lines, string(content[:i+1]))
+		content = content[i+1:]
+	}
+	return lines
+}
+
+// diffLines returns the edits that transform the old lines into the new lines.
+// It uses the linear space variant of Myers' diff algorithm,
+// so that large derived files with many changes can also be compared.
+func diffLines(old, new []string) []edit {
+	ids := make(map[string]int)
+	toIDs := func(lines []string) []int {
+		is := make([]int, len(lines))
+		for i, line := range lines {
+			id, ok := ids[line]
+			if !ok {
+				id = len(ids)
+				ids[line] = id
+			}
+			is[i] = id
+		}
+		return is
+	}
+	d := &differ{old: old, new: new, a: toIDs(old), b: toIDs(new)}
+	d.diff(0, len(d.a), 0, len(d.b))
+	return d.edits
+}
+
+type differ struct {
+	old, new []string
+	a, b     []int
+	edits    []edit
+}
+
+func (d *differ) equal(aLo, aHi, bLo, bHi int) {
+	for i := aLo; i < aHi; i++ {
+		d.edits = append(d.edits, edit{' ', d.old[i]})
+	}
+}
+
+func (d *differ) change(aLo, aHi, bLo, bHi int) {
+	for i := aLo; i < aHi; i++ {
+		d.edits = append(d.edits, edit{'-', d.old[i]})
+	}
+	for i := bLo; i < bHi; i++ {
+		d.edits = append(d.edits, edit{'+', d.new[i]})
+	}
+}
+
+func (d *differ) diff(aLo, aHi, bLo, bHi int) {
+	prefix := 0
+	for aLo+prefix < aHi && bLo+prefix < bHi && d.a[aLo+prefix] == d.b[bLo+prefix] {
+		prefix++
+	}
+	d.equal(aLo, aLo+prefix, bLo, bLo+prefix)
+	aLo, bLo = aLo+prefix, bLo+prefix
+	suffix := 0
+	for aLo < aHi-suffix && bLo < bHi-suffix && d.a[aHi-suffix-1] == d.b[bHi-suffix-1] {
+		suffix++
+	}
+	aEnd, bEnd := aHi-suffix, bHi-suffix
+	if aLo == aEnd || bLo == bEnd {
+		d.change(aLo, aEnd, bLo, bEnd)
+	} else if x, y, ok := d.bisect(aLo, aEnd, bLo, bEnd); ok {
+		d.diff(aLo, x, bLo, y)
+		d.diff(x, aEnd, y, bEnd)
+	} else {
+		d.change(aLo, aEnd, bLo, bEnd)
+	}
+	d.equal(aEnd, aHi, bEnd, bHi)
+}
+
+// bisect finds the middle snake of the shortest edit script and returns the point where the problem can be split.
+func (d *differ) bisect(aLo, aHi, bLo, bHi int) (int, int, bool) {
+	a, b := d.a[aLo:aHi], d.b[bLo:bHi]
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD + 1
+	v1 := make([]int, 2*offset+1)
+	v2 := make([]int, 2*offset+1)
+	for i := range v1 {
+		v1[i] = -1
+		v2[i] = -1
+	}
+	v1[offset+1] = 0
+	v2[offset+1] = 0
+	delta := n - m
+	front := delta%2 != 0
+	k1start, k1end, k2start, k2end := 0, 0, 0, 0
+	for depth := 0; depth < maxD; depth++ {
+		for k1 := -depth + k1start; k1 <= depth-k1end; k1 += 2 {
+			k1Offset := offset + k1
+			var x1 int
+			if k1 == -depth || (k1 != depth && v1[k1Offset-1] < v1[k1Offset+1]) {
+				x1 = v1[k1Offset+1]
+			} else {
+				x1 = v1[k1Offset-1] + 1
+			}
+			y1 := x1 - k1
+			for x1 < n && y1 < m && a[x1] == b[y1] {
+				x1++
+				y1++
+			}
+			v1[k1Offset] = x1
+			if x1 > n {
+				k1end += 2
+			} else if y1 > m {
+				k1start += 2
+			} else if front {
+				k2Offset := offset + delta - k1
+				if k2Offset >= 0 && k2Offset < len(v2) && v2[k2Offset] != -1 {
+					if x1 >= n-v2[k2Offset] {
+						return aLo + x1, bLo + y1, true
+					}
+				}
+			}
+		}
+		for k2 := -depth + k2start; k2 <= depth-k2end; k2 += 2 {
+			k2Offset := offset + k2
+			var x2 int
+			if k2 == -depth || (k2 != depth && v2[k2Offset-1] < v2[k2Offset+1]) {
+				x2 = v2[k2Offset+1]
+			} else {
+				x2 = v2[k2Offset-1] + 1
+			}
+			y2 := x2 - k2
+			for x2 < n && y2 < m && a[n-x2-1] == b[m-y2-1] {
+				x2++
+				y2++
+			}
+			v2[k2Offset] = x2
+			if x2 > n {
+				k2end += 2
+			} else if y2 > m {
+				k2start += 2
+			} else if !front {
+				k1Offset := offset + delta - k2
+				if k1Offset >= 0 && k1Offset < len(v1) && v1[k1Offset] != -1 {
+					x1 := v1[k1Offset]
+					y1 := offset + x1 - k1Offset
+					if x1 >= n-x2 {
+						return aLo + x1, bLo + y1, true
+					}
+				}
+			}
+		}
+	}
+	return 0, 0, false
+}
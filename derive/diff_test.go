@@ -0,0 +1,143 @@
+package derive
+
+import (
+	"bytes"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestUnifiedDiff(t *testing.T) {
+	// The expected diffs are the output of GNU diff -u --label a --label b a b.
+	tests := []struct {
+		name     string
+		old, new string
+		want     string
+	}{
+		{
+			name: "empty to content",
+			old:  "",
+			new:  "a\nb\n",
+			want: "--- a\n+++ b\n@@ -0,0 +1,2 @@\n+a\n+b\n",
+		},
+		{
+			name: "content to empty",
+			old:  "a\nb\n",
+			new:  "",
+			want: "--- a\n+++ b\n@@ -1,2 +0,0 @@\n-a\n-b\n",
+		},
+		{
+			name: "missing trailing newline",
+			old:  "a\nb\n",
+			new:  "a\nb",
+			want: "--- a\n+++ b\n@@ -1,2 +1,2 @@\n a\n-b\n+b\n\\ No newline at end of file\n",
+		},
+		{
+			name: "insert at start",
+			old:  "b\nc\nd\ne\n",
+			new:  "a\nb\nc\nd\ne\n",
+			want: "--- a\n+++ b\n@@ -1,3 +1,4 @@\n+a\n b\n c\n d\n",
+		},
+		{
+			name: "delete at start",
+			old:  "a\nb\nc\nd\ne\n",
+			new:  "b\nc\nd\ne\n",
+			want: "--- a\n+++ b\n@@ -1,4 +1,3 @@\n-a\n b\n c\n d\n",
+		},
+		{
+			name: "insert at end",
+			old:  "a\nb\nc\nd\n",
+			new:  "a\nb\nc\nd\ne\n",
+			want: "--- a\n+++ b\n@@ -2,3 +2,4 @@\n b\n c\n d\n+e\n",
+		},
+		{
+			name: "delete at end",
+			old:  "a\nb\nc\nd\ne\n",
+			new:  "a\nb\nc\nd\n",
+			want: "--- a\n+++ b\n@@ -2,4 +2,3 @@\n b\n c\n d\n-e\n",
+		},
+		{
+			name: "changes within twice the context are merged into one hunk",
+			old:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n",
+			new:  "1\nX\n3\n4\n5\n6\n7\n8\nY\n10\n",
+			want: "--- a\n+++ b\n@@ -1,10 +1,10 @@\n 1\n-2\n+X\n 3\n 4\n 5\n 6\n 7\n 8\n-9\n+Y\n 10\n",
+		},
+		{
+			name: "changes further apart are separate hunks",
+			old:  "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n",
+			new:  "X\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\nY\n",
+			want: "--- a\n+++ b\n@@ -1,4 +1,4 @@\n-1\n+X\n 2\n 3\n 4\n@@ -9,4 +9,4 @@\n 9\n 10\n 11\n-12\n+Y\n",
+		},
+		{
+			name: "identical",
+			old:  "a\nb\n",
+			new:  "a\nb\n",
+			want: "--- a\n+++ b\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			buf := bytes.NewBuffer(nil)
+			if err := unifiedDiff(buf, "a", "b", []byte(test.old), []byte(test.new)); err != nil {
+				t.Fatal(err)
+			}
+			if got := buf.String(); got != test.want {
+				t.Fatalf("want\n%s\nbut got\n%s", test.want, got)
+			}
+		})
+	}
+}
+
+// TestDiffLines tests that the edits of random inputs transform the old lines into the new lines,
+// with the least number of inserted and deleted lines.
+func TestDiffLines(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(12))
+		for i := range lines {
+			lines[i] = string(rune('a'+r.Intn(3))) + "\n"
+		}
+		return lines
+	}
+	for i := 0; i < 1000; i++ {
+		old, new := randomLines(), randomLines()
+		edits := diffLines(old, new)
+		var gotOld, gotNew []string
+		changes := 0
+		for _, e := range edits {
+			if e.op != '+' {
+				gotOld = append(gotOld, e.line)
+			}
+			if e.op != '-' {
+				gotNew = append(gotNew, e.line)
+			}
+			if e.op != ' ' {
+				changes++
+			}
+		}
+		if strings.Join(gotOld, "") != strings.Join(old, "") || strings.Join(gotNew, "") != strings.Join(new, "") {
+			t.Fatalf("the edits %v do not transform %q into %q", edits, old, new)
+		}
+		if want := len(old) + len(new) - 2*lcs(old, new); changes != want {
+			t.Fatalf("want %d changes from %q to %q, but got %d: %v", want, old, new, changes, edits)
+		}
+	}
+}
+
+// lcs returns the length of the longest common subsequence of the lines.
+func lcs(a, b []string) int {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			} else {
+				lengths[i][j] = max(lengths[i+1][j], lengths[i][j+1])
+			}
+		}
+	}
+	return lengths[0][0]
+}
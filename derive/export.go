@@ -0,0 +1,381 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// exportedName returns the name of the exported function, that calls the derived function with the argument types,
+// for example DeriveEqual_PtrTo_User, where the types of the exporting package are not qualified.
+// It returns false, if the function is not exported, because the argument types do not include an exported type of the package,
+// which means that other packages never ask for it, or include types that other packages cannot refer to.
+func exportedName(prefix string, typs []types.Type, p *types.Package) (string, bool) {
+	if len(prefix) == 0 {
+		return "", false
+	}
+	local := 0
+	for _, typ := range typs {
+		if !exportable(types.Default(typ), p, &local) {
+			return "", false
+		}
+	}
+	if local == 0 {
+		return "", false
+	}
+	key, ok := typesKey(typs, func(q *types.Package) string {
+		if q.Path() == p.Path() {
+			return ""
+		}
+		return q.Name()
+	})
+	if !ok {
+		return "", false
+	}
+	return exported(prefix) + "_" + key, true
+}
+
+// exportable returns whether other packages can refer to the type, by name, and counts the named types of the package.
+func exportable(typ types.Type, p *types.Package, local *int) bool {
+	switch t := typ.(type) {
+	case *types.Basic:
+		return true
+	case *types.Named:
+		if !exportableObj(t.Obj(), p, local) {
+			return false
+		}
+		args := t.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			if !exportable(args.At(i), p, local) {
+				return false
+			}
+		}
+		return true
+	case *types.Alias:
+		return exportableObj(t.Obj(), p, local)
+	case *types.Pointer:
+		return exportable(t.Elem(), p, local)
+	case *types.Slice:
+		return exportable(t.Elem(), p, local)
+	case *types.Array:
+		return exportable(t.Elem(), p, local)
+	case *types.Map:
+		return exportable(t.Key(), p, local) && exportable(t.Elem(), p, local)
+	}
+	return false
+}
+
+func exportableObj(obj *types.TypeName, p *types.Package, local *int) bool {
+	if obj.Pkg() == nil {
+		// Predeclared types, like error.
+		return true
+	}
+	if !obj.Exported() {
+		return false
+	}
+	if obj.Pkg().Path() == p.Path() {
+		*local++
+	}
+	return true
+}
+
+// addExport adds an exported function to the derived file, that calls the generated function, see ConfigFile.Export.
+// The exported function is only written to the derived file of the package, if the generated function is.
+func (pkg *pkg) addExport(p part, plugin Plugin, typs []types.Type) {
+	if !pkg.export || pkg.xtest || pkg.info.Types.Name() == "main" || p.shared != nil {
+		return
+	}
+	name, ok := exportedName(plugin.GetPrefix(), typs, pkg.info.Types)
+	if !ok {
+		return
+	}
+	for _, e := range pkg.exports {
+		if e.name == name {
+			// For example the curried and uncurried deriveEqual for the same type.
+			return
+		}
+	}
+	// A function with the same name, that was not derived, is not replaced.
+	if obj := pkg.info.Types.Scope().Lookup(name); obj != nil {
+		if file := pkg.info.Fset.File(obj.Pos()); file == nil || !pkg.outputs.contains(file.Name()) {
+			return
+		}
+	}
+	code, ok := wrap(pkg.printer.w.Bytes()[p.start:p.end], p.name, p.name)
+	if !ok {
+		return
+	}
+	s := strings.Replace(string(code), "// "+p.name+" ", "// "+name+" ", 1)
+	s = strings.Replace(s, "func "+p.name+"(", "func "+name+"(", 1)
+	start := pkg.printer.mark()
+	pkg.printer.w.WriteString(s)
+	pkg.exports[p.name] = export{name: name, start: start, end: pkg.printer.mark()}
+}
+
+// export is an exported function, that calls a generated function.
+type export struct {
+	name       string
+	start, end int
+}
+
+// withExports returns the parts, with the exported function of each generated function after it.
+func (pkg *pkg) withExports(parts []part) []part {
+	if len(pkg.exports) == 0 {
+		return parts
+	}
+	var ps []part
+	for _, p := range parts {
+		ps = append(ps, p)
+		if e, ok := pkg.exports[p.name]; ok && len(p.name) > 0 {
+			ps = append(ps, part{start: e.start, end: e.end})
+		}
+	}
+	return ps
+}
+
+// reuse prints a function that calls the exported function of another package, instead of generating the function,
+// if the package of one of the argument types exports the derived function for these types, see ConfigFile.Export.
+func (pkg *pkg) reuse(plugin Plugin, g Generator, name string, typs []types.Type) (part, bool) {
+	for _, q := range packagesOf(typs) {
+		if q.Path() == pkg.info.Types.Path() || q.Name() == "main" || !canImport(pkg.info.PkgPath, q.Path()) {
+			continue
+		}
+		exportedName, ok := exportedName(plugin.GetPrefix(), typs, q)
+		if !ok {
+			continue
+		}
+		fn, ok := q.Scope().Lookup(exportedName).(*types.Func)
+		if !ok || !hasParams(fn.Signature(), typs) {
+			continue
+		}
+		g.Generating(typs...)
+		start := pkg.printer.mark()
+		pkg.printCall(g, name, q, fn)
+		return part{name: name, start: start, end: pkg.printer.mark()}, true
+	}
+	return part{}, false
+}
+
+// printCall prints a function with the signature of the exported function, that only calls it.
+func (pkg *pkg) printCall(g Generator, name string, q *types.Package, fn *types.Func) {
+	sig := fn.Signature()
+	params := make([]string, sig.Params().Len())
+	args := make([]string, sig.Params().Len())
+	for i := range params {
+		param := sig.Params().At(i)
+		args[i] = param.Name()
+		if len(args[i]) == 0 || args[i] == "_" {
+			args[i] = fmt.Sprintf("arg%d", i)
+		}
+		typ := g.TypeString(param.Type())
+		if sig.Variadic() && i == len(params)-1 {
+			typ = "..." + g.TypeString(param.Type().(*types.Slice).Elem())
+			args[i] += "..."
+		}
+		params[i] = strings.TrimSuffix(args[i], "...") + " " + typ
+	}
+	results := make([]string, sig.Results().Len())
+	for i := range results {
+		results[i] = g.TypeString(sig.Results().At(i).Type())
+	}
+	result := strings.Join(results, ", ")
+	if len(results) > 1 {
+		result = "(" + result + ")"
+	}
+	call := pkg.printer.NewImport(q.Name(), q.Path())() + "." + fn.Name() + "(" + strings.Join(args, ", ") + ")"
+	if len(results) > 0 {
+		call = "return " + call
+	}
+	p := pkg.printer
+	p.P("")
+	p.P("// %s calls %s.%s, which is exported by the derived file of the %s package, instead of generating the same function again.", name, q.Name(), fn.Name(), q.Name())
+	p.P("func %s(%s) %s {", name, strings.Join(params, ", "), result)
+	p.In()
+	p.P(call)
+	p.Out()
+	p.P("}")
+}
+
+// hasParams returns whether the parameters of the function have the argument types.
+func hasParams(sig *types.Signature, typs []types.Type) bool {
+	if sig.Params().Len() != len(typs) {
+		return false
+	}
+	for i, typ := range typs {
+		if !types.Identical(sig.Params().At(i).Type(), types.Default(typ)) {
+			return false
+		}
+	}
+	return true
+}
+
+// packagesOf returns the packages of the named types, that the argument types refer to, sorted by path.
+func packagesOf(typs []types.Type) []*types.Package {
+	pkgs := make(map[string]*types.Package)
+	var walk func(typ types.Type)
+	walk = func(typ types.Type) {
+		switch t := typ.(type) {
+		case *types.Named:
+			if p := t.Obj().Pkg(); p != nil {
+				pkgs[p.Path()] = p
+			}
+			args := t.TypeArgs()
+			for i := 0; i < args.Len(); i++ {
+				walk(args.At(i))
+			}
+		case *types.Alias:
+			if p := t.Obj().Pkg(); p != nil {
+				pkgs[p.Path()] = p
+			}
+		case *types.Pointer:
+			walk(t.Elem())
+		case *types.Slice:
+			walk(t.Elem())
+		case *types.Array:
+			walk(t.Elem())
+		case *types.Map:
+			walk(t.Key())
+			walk(t.Elem())
+		}
+	}
+	for _, typ := range typs {
+		walk(typ)
+	}
+	paths := make([]string, 0, len(pkgs))
+	for path := range pkgs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	sorted := make([]*types.Package, len(paths))
+	for i, path := range paths {
+		sorted[i] = pkgs[path]
+	}
+	return sorted
+}
+
+// canImport returns whether the package with the import path from is allowed to import the package with the given path,
+// which is not the case for internal packages of other trees.
+func canImport(from, path string) bool {
+	elems := strings.Split(path, "/")
+	for i := len(elems) - 1; i >= 0; i-- {
+		if elems[i] != "internal" {
+			continue
+		}
+		parent := strings.Join(elems[:i], "/")
+		return len(parent) > 0 && (from == parent || strings.HasPrefix(from, parent+"/"))
+	}
+	return true
+}
+
+// orderExports orders the directories, so that the directories, that export their derived functions, see ConfigFile.Export,
+// come before the directories that import them, and returns the indexes of the directories, that each directory waits for.
+// Test packages could import each other's directories, so a directory only waits for directories that come before it.
+func orderExports(dirs [][]*packages.Package, dirSettings []*settings) ([][]*packages.Package, []*settings, [][]int) {
+	exporters := make(map[string]int)
+	for i, d := range dirs {
+		if !dirSettings[i].export {
+			continue
+		}
+		for _, pkg := range d {
+			exporters[pkg.PkgPath] = i
+		}
+	}
+	waits := make([][]int, len(dirs))
+	if len(exporters) == 0 {
+		return dirs, dirSettings, waits
+	}
+	imported := make(map[string]map[int]bool)
+	var importsExporters func(pkg *packages.Package) map[int]bool
+	importsExporters = func(pkg *packages.Package) map[int]bool {
+		if is, ok := imported[pkg.ID]; ok {
+			return is
+		}
+		is := make(map[int]bool)
+		imported[pkg.ID] = is
+		for _, imp := range pkg.Imports {
+			if j, ok := exporters[imp.PkgPath]; ok {
+				is[j] = true
+			}
+			for j := range importsExporters(imp) {
+				is[j] = true
+			}
+		}
+		return is
+	}
+	deps := make([][]int, len(dirs))
+	for i, d := range dirs {
+		is := make(map[int]bool)
+		for _, pkg := range d {
+			for j := range importsExporters(pkg) {
+				if j != i {
+					is[j] = true
+				}
+			}
+		}
+		for j := range is {
+			deps[i] = append(deps[i], j)
+		}
+		sort.Ints(deps[i])
+	}
+	order := make([]int, 0, len(dirs))
+	visited := make([]bool, len(dirs))
+	var visit func(i int)
+	visit = func(i int) {
+		if visited[i] {
+			return
+		}
+		visited[i] = true
+		for _, j := range deps[i] {
+			visit(j)
+		}
+		order = append(order, i)
+	}
+	for i := range dirs {
+		visit(i)
+	}
+	index := make([]int, len(dirs))
+	for k, i := range order {
+		index[i] = k
+	}
+	ordered := make([][]*packages.Package, len(dirs))
+	orderedSettings := make([]*settings, len(dirs))
+	for k, i := range order {
+		ordered[k] = dirs[i]
+		orderedSettings[k] = dirSettings[i]
+		for _, j := range deps[i] {
+			if index[j] < k {
+				waits[k] = append(waits[k], index[j])
+			}
+		}
+	}
+	return ordered, orderedSettings, waits
+}
+
+// changedOutputs returns whether the derived files of the directory were changed in this run.
+func changedOutputs(files *files, pkgs []*packages.Package, s *settings) bool {
+	d := dir(pkgs[0])
+	for _, name := range newOutputs(s.output).all() {
+		if files.Changed(filepath.Join(d, name)) {
+			return true
+		}
+	}
+	return false
+}
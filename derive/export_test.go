@@ -0,0 +1,4 @@
+package derive
+
+// Signatures returns the signatures of the registered plugin with the name.
+var Signatures = signatures
@@ -15,7 +15,9 @@
 package derive
 
 import (
+	"fmt"
 	"go/types"
+	"reflect"
 	"strings"
 )
 
@@ -23,6 +25,8 @@ import (
 type Named struct {
 	Fields  []*Field
 	Reflect bool
+	// Skipped are the fields that were removed by For, because of their derive struct tag.
+	Skipped []*Field
 }
 
 // Field describes a struct field.
@@ -31,6 +35,7 @@ type Field struct {
 	external bool
 	Type     types.Type
 	typeStr  func() string
+	tag      string
 }
 
 // Name returns the field name, given the receiver and the unsafe import, if needed.
@@ -51,6 +56,68 @@ func (f *Field) Private() bool {
 	return strings.ToLower(f.name[0:1]) == f.name[0:1]
 }
 
+// Tag returns the value of the derive struct tag of the field.
+func (f *Field) Tag() string {
+	return reflect.StructTag(f.tag).Get("derive")
+}
+
+// Skip returns whether the plugin should skip the field, because of its derive struct tag.
+func (f *Field) Skip(plugin string) bool {
+	return SkipTag(f.tag, plugin)
+}
+
+// SkipComment returns a comment for the generated code, that documents why the field was skipped.
+func (f *Field) SkipComment() string {
+	return fmt.Sprintf("// %s is skipped, since it is tagged with `derive:%q`.", f.name, f.Tag())
+}
+
+// SkipTag returns whether the plugin should skip a struct field with the given struct tag.
+// A field is skipped by all plugins with the struct tag `derive:"-"`
+// and only by specific plugins with a struct tag like `derive:"equal=-,hash=-"`.
+func SkipTag(tag string, plugin string) bool {
+	for _, option := range strings.Split(reflect.StructTag(tag).Get("derive"), ",") {
+		option = strings.TrimSpace(option)
+		if option == "-" || option == plugin+"=-" {
+			return true
+		}
+	}
+	return false
+}
+
+// PrivateExternal returns the first field, that can only be accessed using reflect and unsafe,
+// since it is a private field of a struct in an external package, or nil if there is no such field.
+func (n *Named) PrivateExternal() *Field {
+	for _, field := range n.Fields {
+		if field.Private() && field.external {
+			return field
+		}
+	}
+	return nil
+}
+
+// SafeError returns the error for a private field of a struct in an external package, that cannot be accessed in safe mode.
+// typeStr is the type of the struct.
+func (f *Field) SafeError(typeStr string) error {
+	return fmt.Errorf("%s is a private field of the external type %s, which cannot be accessed in safe mode, without reflect and unsafe", f.name, typeStr)
+}
+
+// For returns the fields that the plugin should visit, without the fields that are skipped because of their derive struct tag.
+// Reflect is only true if one of the remaining fields requires it.
+func (n *Named) For(plugin string) *Named {
+	visit := &Named{Skipped: n.Skipped}
+	for _, field := range n.Fields {
+		if field.Skip(plugin) {
+			visit.Skipped = append(visit.Skipped, field)
+			continue
+		}
+		visit.Fields = append(visit.Fields, field)
+		if field.Private() && field.external {
+			visit.Reflect = true
+		}
+	}
+	return visit
+}
+
 // Fields returns a new Named object containing a list of Fields for a given input struct.
 func Fields(typesMap TypesMap, typ *types.Struct, external bool) *Named {
 	numFields := typ.NumFields()
@@ -68,6 +135,7 @@ func Fields(typesMap TypesMap, typ *types.Struct, external bool) *Named {
 			typeStr: func() string {
 				return typesMap.TypeString(fieldType)
 			},
+			tag: typ.Tag(i),
 		}
 		if n.Fields[i].Private() {
 			if external {
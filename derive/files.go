@@ -0,0 +1,302 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+)
+
+// files keeps all the generated code and renamed function calls in memory, without touching the file system.
+// A nil content represents a removed file.
+// Packages are generated concurrently, so all methods are safe for concurrent use.
+type files struct {
+	mu       sync.Mutex
+	base     map[string][]byte
+	contents map[string][]byte
+	renames  map[string][]Rename
+	funcs    map[string][]*Func
+	unused   []*Unused
+	// shared are the functions that each derived file requires from a shared package.
+	shared map[string]*sharedFuncs
+	// hidden are source files, that only exist in the overlay and are never written to disk.
+	hidden map[string][]byte
+	// derived are the derived files, all other files are source files.
+	derived map[string]bool
+}
+
+// newFiles returns files, where the base overlay replaces the contents of the files on disk.
+func newFiles(base map[string][]byte) *files {
+	return &files{
+		base:     base,
+		contents: make(map[string][]byte),
+		renames:  make(map[string][]Rename),
+		funcs:    make(map[string][]*Func),
+		shared:   make(map[string]*sharedFuncs),
+		hidden:   make(map[string][]byte),
+		derived:  make(map[string]bool),
+	}
+}
+
+// WriteFile writes the content of a source file.
+func (m *files) WriteFile(filename string, content []byte) error {
+	return m.write(filename, content, false)
+}
+
+// WriteDerived writes the content of a derived file.
+func (m *files) WriteDerived(filename string, content []byte) error {
+	return m.write(filename, content, true)
+}
+
+func (m *files) write(filename string, content []byte, derived bool) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if content == nil {
+		content = []byte{}
+	}
+	m.contents[abs] = content
+	m.derived[abs] = derived
+	return nil
+}
+
+// Remove removes a derived file.
+func (m *files) Remove(filename string) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.contents[abs] = nil
+	m.derived[abs] = true
+	return nil
+}
+
+// Rename records a function call that was renamed in a source file.
+func (m *files) Rename(filename string, rename Rename) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.renames[abs] = append(m.renames[abs], rename)
+	return nil
+}
+
+// SetFuncs records the graph of the functions in a derived file.
+func (m *files) SetFuncs(filename string, funcs []*Func) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.funcs[abs] = funcs
+	return nil
+}
+
+// AddUnused adds functions that are no longer used or that shadow derived functions.
+func (m *files) AddUnused(unused []*Unused) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.unused = append(m.unused, unused...)
+}
+
+// SetShared records the functions that a derived file requires from the shared package.
+func (m *files) SetShared(filename string, sp *sharedPackage, funcs []*sharedFunc) error {
+	abs, err := filepath.Abs(filename)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(funcs) == 0 {
+		delete(m.shared, abs)
+		return nil
+	}
+	s := &sharedFuncs{pkg: sp, funcs: make(map[string]*sharedFunc, len(funcs))}
+	for _, f := range funcs {
+		s.funcs[f.name] = f
+	}
+	m.shared[abs] = s
+	return nil
+}
+
+// Shared returns the functions that the derived files require from each shared package, sorted by the directory of the shared package.
+func (m *files) Shared() ([]*sharedFuncs, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	filenames := make([]string, 0, len(m.shared))
+	for filename := range m.shared {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	byDir := make(map[string]*sharedFuncs)
+	var shared []*sharedFuncs
+	for _, filename := range filenames {
+		s := m.shared[filename]
+		merged, ok := byDir[s.pkg.dir]
+		if !ok {
+			merged = &sharedFuncs{pkg: s.pkg, funcs: make(map[string]*sharedFunc)}
+			byDir[s.pkg.dir] = merged
+			shared = append(shared, merged)
+		}
+		names := make([]string, 0, len(s.funcs))
+		for name := range s.funcs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			if err := merged.add(s.funcs[name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Slice(shared, func(i, j int) bool {
+		return shared[i].pkg.dir < shared[j].pkg.dir
+	})
+	return shared, nil
+}
+
+// AddOverlay adds a source file, that only exists in the overlay.
+func (m *files) AddOverlay(filename string, content []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.hidden[filename] = content
+}
+
+// Overlay returns the contents of the base overlay together with the written files,
+// so that packages can be reloaded with the generated code.
+// The go command does not support removing files using an overlay,
+// so a removed file is replaced by only its package clause.
+func (m *files) Overlay() map[string][]byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	overlay := make(map[string][]byte, len(m.base)+len(m.contents))
+	for filename, content := range m.base {
+		overlay[filename] = content
+	}
+	for filename, content := range m.hidden {
+		overlay[filename] = content
+	}
+	for filename, content := range m.contents {
+		if content != nil {
+			overlay[filename] = content
+			continue
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), filename, m.base[filename], parser.PackageClauseOnly)
+		if err != nil {
+			delete(overlay, filename)
+			continue
+		}
+		overlay[filename] = []byte("package " + f.Name.Name + "\n")
+	}
+	return overlay
+}
+
+// Result returns the derived files and the edits to source files.
+// Files that are removed, but never existed, are left out.
+func (m *files) Result() *Result {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	res := &Result{Files: make(map[string][]byte), Graph: &Graph{}}
+	filenames := make([]string, 0, len(m.contents))
+	for filename := range m.contents {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	for _, filename := range filenames {
+		content := m.contents[filename]
+		if !m.derived[filename] {
+			res.Edits = append(res.Edits, &Edit{
+				Filename: filename,
+				Content:  content,
+				Renames:  m.renames[filename],
+			})
+			continue
+		}
+		if content == nil && !m.exists(filename) {
+			continue
+		}
+		res.Files[filename] = content
+		res.Graph.Funcs = append(res.Graph.Funcs, m.funcs[filename]...)
+	}
+	res.Graph.sort()
+	res.Unused = append(res.Unused, m.unused...)
+	sort.Slice(res.Unused, func(i, j int) bool {
+		if res.Unused[i].Pos.Filename != res.Unused[j].Pos.Filename {
+			return res.Unused[i].Pos.Filename < res.Unused[j].Pos.Filename
+		}
+		return res.Unused[i].Pos.Offset < res.Unused[j].Pos.Offset
+	})
+	return res
+}
+
+// Get returns the content of a file that was written or removed.
+func (m *files) Get(filename string) ([]byte, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	content, ok := m.contents[filename]
+	return content, ok
+}
+
+// Edited returns whether any of the source files were changed.
+func (m *files) Edited(filenames []string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for _, filename := range filenames {
+		if _, ok := m.contents[filename]; ok && !m.derived[filename] {
+			return true
+		}
+	}
+	return false
+}
+
+// Changed returns whether the file was written or removed, so that it differs from the file on disk or in the base overlay.
+func (m *files) Changed(filename string) bool {
+	m.mu.Lock()
+	content, ok := m.contents[filename]
+	base, inBase := m.base[filename]
+	m.mu.Unlock()
+	if !ok {
+		return false
+	}
+	if !inBase {
+		var err error
+		base, err = os.ReadFile(filename)
+		if err != nil {
+			return content != nil
+		}
+	}
+	return content == nil || !bytes.Equal(content, base)
+}
+
+func (m *files) exists(filename string) bool {
+	if _, ok := m.base[filename]; ok {
+		return true
+	}
+	_, err := os.Stat(filename)
+	return err == nil
+}
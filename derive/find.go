@@ -16,141 +16,351 @@ package derive
 
 import (
 	"go/ast"
+	"go/token"
 	"go/types"
-	"path/filepath"
 	"strings"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
+// derivedFilename is the default filename of the derived file.
 const derivedFilename = "derived.gen.go"
 
+// methodsDirective is the prefix of a comment on a type declaration, that is followed by a comma separated list of plugin names.
+// For example: //goderive:methods equal,hash
+const methodsDirective = "//goderive:methods"
+
 type fileInfo struct {
 	astFile   *ast.File
 	fullpath  string
 	undefined []*call
 	derived   []*call
 	funcNames map[string]struct{}
+	methods   []*methods
+}
+
+// methods is a //goderive:methods directive, which requests the plugins to generate methods for the type.
+type methods struct {
+	Pos     token.Pos
+	Type    *types.TypeName
+	Plugins []string
 }
 
-func newFileInfos(program *loader.Program, pkgInfo *loader.PackageInfo) []*fileInfo {
+// newFileInfos finds the derive function calls in the files of the package, except in the derived files.
+func newFileInfos(pkgInfo *packages.Package, outputs outputs) []*fileInfo {
 	files := []*fileInfo{}
-	for i := range pkgInfo.Files {
-		astFile := pkgInfo.Files[i]
-		file := program.Fset.File(astFile.Pos())
+	for i := range pkgInfo.Syntax {
+		astFile := pkgInfo.Syntax[i]
+		file := pkgInfo.Fset.File(astFile.Pos())
 		if file == nil {
 			// probably derived.gen.go has non parsable code.
 			continue
 		}
 		fullpath := file.Name()
 
-		_, fname := filepath.Split(fullpath)
-		if fname == derivedFilename {
+		if outputs.contains(fullpath) {
 			continue
 		}
 
-		f := &finder{program, pkgInfo, nil, nil, make(map[string]struct{})}
+		f := &finder{pkgInfo, outputs, nil, nil, make(map[string]struct{})}
 		for _, d := range astFile.Decls {
 			ast.Walk(f, d)
 		}
-		undefined := make([]*call, len(f.undefined))
-		for i := range f.undefined {
-			undefined[i] = newCall(pkgInfo, f.undefined[i])
-		}
-		derived := make([]*call, len(f.derived))
-		for i := range f.derived {
-			derived[i] = newCall(pkgInfo, f.derived[i])
-		}
 
 		files = append(files, &fileInfo{
-			astFile:   pkgInfo.Files[i],
+			astFile:   pkgInfo.Syntax[i],
 			fullpath:  fullpath,
-			undefined: undefined,
-			derived:   derived,
+			undefined: f.undefined,
+			derived:   f.derived,
 			funcNames: f.funcNames,
+			methods:   findMethods(pkgInfo, astFile),
 		})
 	}
 	return files
 }
 
+// findMethods returns the //goderive:methods directives in the doc comments of the type declarations in the file.
+func findMethods(pkgInfo *packages.Package, astFile *ast.File) []*methods {
+	var ms []*methods
+	for _, decl := range astFile.Decls {
+		genDecl, ok := decl.(*ast.GenDecl)
+		if !ok || genDecl.Tok != token.TYPE {
+			continue
+		}
+		for _, spec := range genDecl.Specs {
+			typeSpec := spec.(*ast.TypeSpec)
+			doc := typeSpec.Doc
+			if doc == nil && len(genDecl.Specs) == 1 {
+				doc = genDecl.Doc
+			}
+			if doc == nil {
+				continue
+			}
+			for _, comment := range doc.List {
+				if !strings.HasPrefix(comment.Text, methodsDirective+" ") {
+					continue
+				}
+				typeName, _ := pkgInfo.TypesInfo.Defs[typeSpec.Name].(*types.TypeName)
+				if typeName == nil {
+					continue
+				}
+				var plugins []string
+				for _, name := range strings.Split(strings.TrimPrefix(comment.Text, methodsDirective), ",") {
+					if name = strings.TrimSpace(name); len(name) > 0 {
+						plugins = append(plugins, name)
+					}
+				}
+				ms = append(ms, &methods{comment.Pos(), typeName, plugins})
+			}
+		}
+	}
+	return ms
+}
+
 type finder struct {
-	program   *loader.Program
-	pkgInfo   *loader.PackageInfo
-	undefined []*ast.CallExpr
-	derived   []*ast.CallExpr
+	pkgInfo   *packages.Package
+	outputs   outputs
+	undefined []*call
+	derived   []*call
 	funcNames map[string]struct{}
 }
 
 func (f *finder) Visit(node ast.Node) (w ast.Visitor) {
-	call, ok := node.(*ast.CallExpr)
-	if !ok {
-		return f
-	}
-	fn, ok := call.Fun.(*ast.Ident)
-	if !ok {
-		return f
+	switch n := node.(type) {
+	case *ast.CallExpr:
+		if fn, ok := n.Fun.(*ast.Ident); ok {
+			f.add(fn, func() *call { return newCall(f.pkgInfo, n, fn) })
+			for _, arg := range n.Args {
+				ast.Walk(f, arg)
+			}
+			f.findValues(n.Args, f.paramTypes(n))
+			return nil
+		}
+		f.findValues(n.Args, f.paramTypes(n))
+	case *ast.AssignStmt:
+		if n.Tok == token.ASSIGN && len(n.Lhs) == len(n.Rhs) {
+			expected := make([]types.Type, len(n.Lhs))
+			for i, lhs := range n.Lhs {
+				expected[i] = f.pkgInfo.TypesInfo.TypeOf(lhs)
+			}
+			f.findValues(n.Rhs, expected)
+		}
+	case *ast.ValueSpec:
+		if n.Type != nil {
+			typ := f.pkgInfo.TypesInfo.TypeOf(n.Type)
+			expected := make([]types.Type, len(n.Values))
+			for i := range expected {
+				expected[i] = typ
+			}
+			f.findValues(n.Values, expected)
+		}
+	case *ast.CompositeLit:
+		f.findElemValues(n)
 	}
-	def, ok := f.pkgInfo.Uses[fn]
+	return f
+}
+
+// add adds the function, that is either called or used as a value, to the undefined or derived functions,
+// if it is not defined or defined in the derived file.
+func (f *finder) add(fn *ast.Ident, newCall func() *call) {
+	def, ok := f.pkgInfo.TypesInfo.Uses[fn]
 	if !ok {
-		f.undefined = append(f.undefined, call)
-		return f
+		f.undefined = append(f.undefined, newCall())
+		return
 	}
 	if _, ok := def.(*types.Builtin); ok {
-		return f
+		return
 	}
-	file := f.program.Fset.File(def.Pos())
+	file := f.pkgInfo.Fset.File(def.Pos())
 	if file == nil {
 		// probably a cast, for example float64()
-		return f
+		return
 	}
-	_, filename := filepath.Split(file.Name())
-	if filename == derivedFilename {
-		f.derived = append(f.derived, call)
-		return f
+	if f.outputs.contains(file.Name()) {
+		f.derived = append(f.derived, newCall())
+		return
+	}
+	if _, ok := def.(*types.Func); ok {
+		f.funcNames[fn.Name] = struct{}{}
 	}
-	f.funcNames[fn.Name] = struct{}{}
-	return f
 }
 
-type call struct {
-	Expr *ast.CallExpr
-	Name string
-	Args []types.Type
+// findValues finds the functions that are used as values, for example deriveEqual in slices.EqualFunc(a, b, deriveEqual).
+// Each of the expressions is assigned to a value of the expected type at the same index, if the expected type is known.
+func (f *finder) findValues(exprs []ast.Expr, expected []types.Type) {
+	for i, expr := range exprs {
+		fn, ok := expr.(*ast.Ident)
+		if !ok {
+			continue
+		}
+		var typ types.Type
+		if i < len(expected) {
+			typ = expected[i]
+		}
+		if def, ok := f.pkgInfo.TypesInfo.Uses[fn]; ok {
+			if _, isFunc := def.(*types.Func); !isFunc {
+				continue
+			}
+			// The function is already defined, so its own signature is used instead.
+			typ = def.Type()
+		} else if _, isDef := f.pkgInfo.TypesInfo.Defs[fn]; isDef {
+			continue
+		}
+		f.add(fn, func() *call { return newValue(fn, typ) })
+	}
+}
+
+// findElemValues finds the functions that are used as values in the elements of a composite literal,
+// for example a struct field or an element of a slice or map.
+func (f *finder) findElemValues(lit *ast.CompositeLit) {
+	typ := f.pkgInfo.TypesInfo.TypeOf(lit)
+	if typ == nil {
+		return
+	}
+	for i, elt := range lit.Elts {
+		var expected types.Type
+		value := elt
+		if kv, ok := elt.(*ast.KeyValueExpr); ok {
+			value = kv.Value
+		}
+		switch t := typ.Underlying().(type) {
+		case *types.Struct:
+			if kv, ok := elt.(*ast.KeyValueExpr); ok {
+				if key, ok := kv.Key.(*ast.Ident); ok {
+					for j := 0; j < t.NumFields(); j++ {
+						if t.Field(j).Name() == key.Name {
+							expected = t.Field(j).Type()
+						}
+					}
+				}
+			} else if i < t.NumFields() {
+				expected = t.Field(i).Type()
+			}
+		case *types.Slice:
+			expected = t.Elem()
+		case *types.Array:
+			expected = t.Elem()
+		case *types.Map:
+			expected = t.Elem()
+		}
+		f.findValues([]ast.Expr{value}, []types.Type{expected})
+	}
 }
 
-func newCall(pkgInfo *loader.PackageInfo, expr *ast.CallExpr) *call {
-	fn, ok := expr.Fun.(*ast.Ident)
+// paramTypes returns the types of the parameters, that each of the arguments of the call is assigned to.
+// Nothing is returned if the type of the function that is called, is not known,
+// or if its type arguments could not be inferred.
+func (f *finder) paramTypes(call *ast.CallExpr) []types.Type {
+	sig, ok := types.Unalias(typeOf(f.pkgInfo, call.Fun)).(*types.Signature)
 	if !ok {
-		panic("unreachable, finder has already eliminated this option")
+		return nil
+	}
+	if sig.TypeParams().Len() > 0 {
+		sig = infer(sig, getInputTypes(f.pkgInfo, call))
+		if sig == nil {
+			return nil
+		}
+	}
+	params := sig.Params()
+	typs := make([]types.Type, len(call.Args))
+	for i := range call.Args {
+		switch {
+		case sig.Variadic() && i >= params.Len()-1:
+			if call.Ellipsis.IsValid() {
+				typs[i] = params.At(params.Len() - 1).Type()
+			} else if slice, ok := params.At(params.Len() - 1).Type().(*types.Slice); ok {
+				typs[i] = slice.Elem()
+			}
+		case i < params.Len():
+			typs[i] = params.At(i).Type()
+		}
+	}
+	return typs
+}
+
+// typeOf returns the type of the expression, which is the instantiated type for generic functions.
+func typeOf(pkgInfo *packages.Package, expr ast.Expr) types.Type {
+	var ident *ast.Ident
+	switch e := expr.(type) {
+	case *ast.Ident:
+		ident = e
+	case *ast.SelectorExpr:
+		ident = e.Sel
+	}
+	if ident != nil {
+		if inst, ok := pkgInfo.TypesInfo.Instances[ident]; ok {
+			return inst.Type
+		}
 	}
-	name := fn.Name
+	return pkgInfo.TypesInfo.TypeOf(expr)
+}
+
+// call is a call to a derive function or a derive function that is used as a value.
+type call struct {
+	// Expr is the call expression or, if the function is used as a value, the identifier.
+	Expr  ast.Expr
+	Ident *ast.Ident
+	Name  string
+	Args  []types.Type
+	// Value is true if the function is used as a value.
+	Value bool
+}
+
+func newCall(pkgInfo *packages.Package, expr *ast.CallExpr, fn *ast.Ident) *call {
 	typs := getInputTypes(pkgInfo, expr)
-	return &call{expr, name, typs}
+	return &call{Expr: expr, Ident: fn, Name: fn.Name, Args: typs}
+}
+
+// newValue returns a call for a function that is used as a value,
+// where the parameters of the expected function signature are used as the argument types.
+func newValue(fn *ast.Ident, expected types.Type) *call {
+	c := &call{Expr: fn, Ident: fn, Name: fn.Name, Value: true}
+	if expected == nil {
+		return c
+	}
+	sig, ok := expected.Underlying().(*types.Signature)
+	if !ok {
+		return c
+	}
+	c.Args = make([]types.Type, sig.Params().Len())
+	for i := range c.Args {
+		c.Args[i] = sig.Params().At(i).Type()
+	}
+	return c
 }
 
 // argTypes returns the argument types of a function call.
-func getInputTypes(pkgInfo *loader.PackageInfo, call *ast.CallExpr) []types.Type {
+func getInputTypes(pkgInfo *packages.Package, call *ast.CallExpr) []types.Type {
 	typs := make([]types.Type, len(call.Args))
 	for i, a := range call.Args {
-		typs[i] = pkgInfo.TypeOf(a)
+		typs[i] = pkgInfo.TypesInfo.TypeOf(a)
 	}
 	return typs
 }
 
-// HasUndefined returns whether the call has undefined arguments
+// HasUndefined returns whether the call has undefined arguments,
+// or, for a function that is used as a value, whether the expected function signature is unknown.
 func (c *call) HasUndefined() bool {
+	if c.Value && c.Args == nil {
+		return true
+	}
 	for i := range c.Args {
-		if c.Args[i] == nil {
+		if isInvalid(c.Args[i]) {
 			return true
 		}
-		if basic, ok := c.Args[i].(*types.Basic); ok {
-			if basic.Kind() == types.Invalid {
-				return true
-			}
-		}
-		if strings.Index(c.Args[i].String(), "invalid type") >= 0 {
+	}
+	return false
+}
+
+// isInvalid returns whether the type of an argument is unknown or contains an invalid type.
+func isInvalid(typ types.Type) bool {
+	if typ == nil {
+		return true
+	}
+	if basic, ok := typ.(*types.Basic); ok {
+		if basic.Kind() == types.Invalid {
 			return true
 		}
 	}
-	return false
+	return strings.Index(typ.String(), "invalid type") >= 0
 }
@@ -15,22 +15,102 @@
 package derive
 
 import (
+	"bytes"
+	"errors"
 	"fmt"
-	"go/ast"
 	"go/format"
+	"go/token"
 	"go/types"
+	"io"
 	"log"
-	"os"
 	"path/filepath"
+	"runtime"
 	"sort"
 	"strings"
+	"sync/atomic"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
 // Program is ready to generate code for a whole program.
 type Program interface {
 	Generate() error
+	// Check generates the code in memory, without touching the file system,
+	// and writes a unified diff to w for every file that is out of date.
+	// It returns the names of the files that would be created, updated or deleted by Generate.
+	Check(w io.Writer) ([]string, error)
+	// Result generates the code in memory, without touching the file system.
+	Result() (*Result, error)
+}
+
+// Config configures the generation of code, without touching the file system.
+// The goderive.json files of the module and of each package are applied on top of the config,
+// followed by the Override.
+type Config struct {
+	Plugins  []Plugin
+	Autoname bool
+	Dedup    bool
+	// Override takes precedence over the goderive.json files, for example for flags that are set on the command line.
+	Override *ConfigFile
+	// Tags are the build tags that are passed on to the go command when loading packages.
+	Tags []string
+	// Overlay replaces the contents of source files on disk, for example unsaved files in an editor.
+	// The keys are filenames and are made absolute using the working directory.
+	Overlay map[string][]byte
+	// Jobs is the number of packages that are generated concurrently.
+	// Zero means runtime.GOMAXPROCS(0).
+	Jobs int
+	// CacheDir is the directory, where the derived files of unchanged packages are cached between runs.
+	// The cache is disabled if the directory is empty.
+	CacheDir string
+	// ReportUnused reports the functions in the existing derived files, that are no longer used and are removed,
+	// and the functions that are declared by hand with the prefix of a plugin, see Result.Unused.
+	ReportUnused bool
+}
+
+// Generate loads the packages matching the patterns and generates their code in memory.
+// Nothing is written to disk, instead the derived files and the edits to source files are returned.
+func Generate(config Config, patterns ...string) (*Result, error) {
+	prog, err := newProgram(config, patterns)
+	if err != nil {
+		return nil, err
+	}
+	return prog.Result()
+}
+
+// newProgram validates the config and loads the packages matching the patterns.
+func newProgram(config Config, patterns []string) (*program, error) {
+	overlay := make(map[string][]byte, len(config.Overlay))
+	for filename, content := range config.Overlay {
+		abs, err := filepath.Abs(filename)
+		if err != nil {
+			return nil, err
+		}
+		overlay[abs] = content
+	}
+	if config.Override != nil {
+		if err := config.Override.Validate(config.Plugins); err != nil {
+			return nil, err
+		}
+	}
+	ps := &plugins{
+		plugins:  config.Plugins,
+		autoname: config.Autoname,
+		dedup:    config.Dedup,
+		override: config.Override,
+		tags:     config.Tags,
+		overlay:  overlay,
+		jobs:     config.Jobs,
+		cacheDir: config.CacheDir,
+
+		reportUnused: config.ReportUnused,
+	}
+	sortPlugins(ps.plugins)
+	prog, err := ps.Load(patterns)
+	if err != nil {
+		return nil, err
+	}
+	return prog.(*program), nil
 }
 
 // Plugins is a collection of plugins,
@@ -43,15 +123,25 @@ type plugins struct {
 	plugins  []Plugin
 	autoname bool
 	dedup    bool
+	override *ConfigFile
+	tags     []string
+	overlay  map[string][]byte
+	jobs     int
+	cacheDir string
+
+	reportUnused bool
 }
 
 // NewPlugins returns a collection of plugins that is ready to generate code.
-func NewPlugins(ps []Plugin, autoname bool, dedup bool) Plugins {
+// The build tags are passed on to the go command when loading packages.
+func NewPlugins(ps []Plugin, autoname bool, dedup bool, tags []string) Plugins {
 	sortPlugins(ps)
 	return &plugins{
 		plugins:  ps,
 		autoname: autoname,
 		dedup:    dedup,
+		override: &ConfigFile{Autoname: &autoname, Dedup: &dedup},
+		tags:     tags,
 	}
 }
 
@@ -70,11 +160,18 @@ type program struct {
 	plugins  []Plugin
 	autoname bool
 	dedup    bool
-	program  *loader.Program
+	override *ConfigFile
+	tags     []string
+	overlay  map[string][]byte
+	jobs     int
+	cacheDir string
+	pkgs     []*packages.Package
+
+	reportUnused bool
 }
 
 func (p *plugins) Load(paths []string) (Program, error) {
-	loaded, err := load(paths...)
+	loaded, err := load(p.tags, p.overlay, paths...)
 	if err != nil {
 		return nil, err
 	}
@@ -82,7 +179,14 @@ func (p *plugins) Load(paths []string) (Program, error) {
 		plugins:  p.plugins,
 		autoname: p.autoname,
 		dedup:    p.dedup,
-		program:  loaded,
+		override: p.override,
+		tags:     p.tags,
+		overlay:  p.overlay,
+		jobs:     p.jobs,
+		cacheDir: p.cacheDir,
+		pkgs:     loaded,
+
+		reportUnused: p.reportUnused,
 	}, nil
 }
 
@@ -93,8 +197,10 @@ func union(this, that map[string]struct{}) map[string]struct{} {
 	return this
 }
 
-func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []Plugin, autoname, dedup bool) (*pkg, error) {
-	fileInfos := newFileInfos(program, pkgInfo)
+func newPackage(pkgInfo *packages.Package, s *settings, files *files, logger *log.Logger) (*pkg, error) {
+	plugins, autoname, dedup := s.plugins, s.autoname, s.dedup
+	outputs := newOutputs(s.output)
+	fileInfos := newFileInfos(pkgInfo, outputs)
 	fullpath := ""
 	if len(fileInfos) > 0 {
 		abs, err := filepath.Abs(fileInfos[0].fullpath)
@@ -108,20 +214,60 @@ func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []
 		reserved = union(reserved, fileFuncs.funcNames)
 	}
 
-	printer := newPrinter(pkgInfo.Pkg.Name())
-	qual := newQualifier(printer, pkgInfo.Pkg)
+	idents := make(map[string]struct{})
+	for _, name := range pkgInfo.Types.Scope().Names() {
+		idents[name] = struct{}{}
+	}
+	printer := newPrinter(pkgInfo.Types.Name(), idents)
+	printer.SetHeader(s.header)
+	qual := newQualifier(printer, pkgInfo.Types)
+	g := newGraph(pkgInfo.Fset)
+	ambiguous := ambiguousNames(pkgInfo.Types)
 	typesmaps := make(map[string]TypesMap, len(plugins))
 	deps := make(map[string]Dependency, len(plugins))
 	for _, plugin := range plugins {
-		tm := newTypesMap(qual, plugin.GetPrefix(), reserved, autoname, dedup)
+		tm := newTypesMap(qual, plugin.GetPrefix(), reserved, ambiguous, autoname, dedup, plugin.Name(), g)
 		deps[plugin.Name()] = tm
 		typesmaps[plugin.Name()] = tm
 	}
 	generators := make(map[string]Generator, len(plugins))
 	for _, plugin := range plugins {
-		generators[plugin.Name()] = plugin.New(typesmaps[plugin.Name()], printer, deps)
+		generator := plugin.New(typesmaps[plugin.Name()], printer, deps)
+		if options, ok := s.options[plugin.Name()]; ok && len(options) > 0 {
+			configurable, ok := generator.(Configurable)
+			if !ok {
+				return nil, fmt.Errorf("plugin %s does not have any options", plugin.Name())
+			}
+			if err := configurable.Configure(options); err != nil {
+				return nil, fmt.Errorf("plugin %s: %v", plugin.Name(), err)
+			}
+		}
+		if s.safe {
+			if safe, ok := generator.(SafeGenerator); ok {
+				safe.SetSafe()
+			}
+		}
+		generators[plugin.Name()] = generator
 	}
-	pkg := &pkg{pkgInfo, plugins, generators, printer, nil, fullpath}
+	pkg := &pkg{
+		info:       pkgInfo,
+		plugins:    plugins,
+		generators: generators,
+		printer:    printer,
+		fullpath:   fullpath,
+		outputs:    outputs,
+		xtest:      isExternalTest(pkgInfo),
+		shared:     newSharedPackage(pkgInfo, s),
+		safe:       s.safe,
+		export:     s.export,
+		exports:    make(map[string]export),
+		positions:  make(map[string]token.Pos),
+		graph:      g,
+	}
+	// A function in the existing derived files keeps its name, when a call with the same name, but other argument types,
+	// is found first, by adding a call that matches the function, before the other call.
+	claimed := claims(pkgInfo, fileInfos)
+	added := make(map[*call]bool)
 	for _, fileInfo := range fileInfos {
 
 		changed := false
@@ -134,15 +280,27 @@ func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []
 				// an error, where a function could not be generated.
 				for _, plugin := range plugins {
 					if strings.HasPrefix(call.Name, plugin.GetPrefix()) {
-						pkg.undefined = append(pkg.undefined, call.Expr)
+						pkg.undefined = append(pkg.undefined, call)
 						break
 					}
 				}
 				continue
 			}
-			name, err := pkg.Add(call)
-			if err != nil {
-				return nil, err
+			if added[call] {
+				continue
+			}
+			if owner, ok := claimed[call.Name]; ok && !added[owner] && !matchesDerived(pkgInfo, call) {
+				added[owner] = true
+				if _, diag := pkg.Add(owner); diag != nil {
+					pkg.diagnostics = append(pkg.diagnostics, diag)
+				}
+			}
+			added[call] = true
+			name, diag := pkg.Add(call)
+			if diag != nil {
+				// Keep going, so that every call that is not supported is reported and not only the first.
+				pkg.diagnostics = append(pkg.diagnostics, diag)
+				continue
 			}
 			if len(name) == 0 {
 				// this call did not match any prefixes of any code generator and is undefined.
@@ -153,40 +311,103 @@ func newPackage(program *loader.Program, pkgInfo *loader.PackageInfo, plugins []
 					panic("unreachable: function names cannot be changed if it is not allowed by the user")
 				}
 				changed = true
-				log.Printf("changing function call name from %s to %s", call.Name, name)
-				call.Expr.Fun = ast.NewIdent(name)
+				logger.Printf("changing function call name from %s to %s", call.Name, name)
+				rename := Rename{Pos: pkgInfo.Fset.Position(call.Expr.Pos()), OldName: call.Name, NewName: name}
+				if err := files.Rename(fileInfo.fullpath, rename); err != nil {
+					return nil, err
+				}
+				call.Ident.Name = name
 			}
 		}
 
 		if changed {
-			info, err := os.Stat(fileInfo.fullpath)
-			if err != nil {
-				return nil, fmt.Errorf("stat %s: %v", fileInfo.fullpath, err)
-			}
-			f, err := os.OpenFile(fileInfo.fullpath, os.O_WRONLY, info.Mode())
-			if err != nil {
-				return nil, fmt.Errorf("opening %s: %v", fileInfo.fullpath, err)
-			}
-			defer f.Close()
-			if err := format.Node(f, program.Fset, fileInfo.astFile); err != nil {
+			buf := bytes.NewBuffer(nil)
+			if err := format.Node(buf, pkgInfo.Fset, fileInfo.astFile); err != nil {
 				return nil, fmt.Errorf("formatting %s: %v", fileInfo.fullpath, err)
 			}
+			if err := files.WriteFile(fileInfo.fullpath, buf.Bytes()); err != nil {
+				return nil, fmt.Errorf("writing %s: %v", fileInfo.fullpath, err)
+			}
 		}
 
 	}
+	// Methods are added after all the calls, so that the function names that are used in calls are taken first.
+	for _, fileInfo := range fileInfos {
+		for _, m := range fileInfo.methods {
+			for _, pluginName := range m.Plugins {
+				if diag := pkg.AddMethod(m, pluginName); diag != nil {
+					pkg.diagnostics = append(pkg.diagnostics, diag)
+				}
+			}
+		}
+	}
 	return pkg, nil
 }
 
+// claims returns a call for each function in the existing derived files, that matches the parameter types of the function.
+func claims(pkgInfo *packages.Package, fileInfos []*fileInfo) map[string]*call {
+	claimed := make(map[string]*call)
+	for _, fileInfo := range fileInfos {
+		for _, call := range fileInfo.derived {
+			if _, ok := claimed[call.Name]; !ok && matchesDerived(pkgInfo, call) {
+				claimed[call.Name] = call
+			}
+		}
+	}
+	return claimed
+}
+
+// matchesDerived returns whether the call is to a function in the existing derived files, that accepts the argument types of the call.
+func matchesDerived(pkgInfo *packages.Package, call *call) bool {
+	fn, ok := pkgInfo.TypesInfo.Uses[call.Ident].(*types.Func)
+	if !ok || call.HasUndefined() {
+		return false
+	}
+	params := fn.Type().(*types.Signature).Params()
+	typs := make([]types.Type, params.Len())
+	for i := range typs {
+		typs[i] = params.At(i).Type()
+	}
+	return eq(call.Args, typs)
+}
+
 type pkg struct {
-	info       *loader.PackageInfo
-	plugins    []Plugin
-	generators map[string]Generator
-	printer    Printer
-	undefined  []*ast.CallExpr
-	fullpath   string
+	info        *packages.Package
+	plugins     []Plugin
+	generators  map[string]Generator
+	printer     *printer
+	undefined   []*call
+	methods     []*method
+	diagnostics Diagnostics
+	fullpath    string
+	// outputs are the filenames of the derived files.
+	outputs outputs
+	// xtest is true for an external test package, which has its own derived file.
+	xtest bool
+	// parts are the generated functions and methods, in the order in which they were printed.
+	parts []part
+	// shared is the package, where functions for types from other modules are generated, or nil.
+	shared *sharedPackage
+	// safe is true if the generated code is not allowed to use the reflect and unsafe packages.
+	safe bool
+	// export is true if the generated functions are exported for other packages, see ConfigFile.Export.
+	export bool
+	// exports are the exported functions by the name of the generated function that they call.
+	exports map[string]export
+	// positions maps the plugin and function name to the position of the first call to the function.
+	positions map[string]token.Pos
+	graph     *graph
 }
 
-func (pkg *pkg) Add(call *call) (string, error) {
+// method is a method that is generated, because of a //goderive:methods directive.
+type method struct {
+	plugin    string
+	generator MethodGenerator
+	typ       *types.Named
+	funcName  string
+}
+
+func (pkg *pkg) Add(call *call) (string, *Diagnostic) {
 	for _, p := range pkg.plugins {
 		if !strings.HasPrefix(call.Name, p.GetPrefix()) {
 			continue
@@ -194,13 +415,77 @@ func (pkg *pkg) Add(call *call) (string, error) {
 		generator := pkg.generators[p.Name()]
 		name, err := generator.Add(call.Name, call.Args)
 		if err != nil {
-			return "", fmt.Errorf("Add Error: %s: %v", p.Name(), err)
+			pkg.graph.pending = nil
+			return "", newDiagnostic(pkg.info.Fset, call.Expr.Pos(), p.Name(), CodeInvalidCall, err)
+		}
+		key := p.Name() + "." + name
+		if _, ok := pkg.positions[key]; !ok {
+			pkg.positions[key] = call.Expr.Pos()
 		}
+		pkg.graph.call(p.Name(), name, call.Expr.Pos())
 		return name, nil
 	}
 	return "", nil
 }
 
+// AddMethod adds the method, that the plugin generates, for the type with the //goderive:methods directive.
+func (pkg *pkg) AddMethod(m *methods, pluginName string) *Diagnostic {
+	fail := func(format string, args ...interface{}) *Diagnostic {
+		return newDiagnostic(pkg.info.Fset, m.Pos, pluginName, CodeInvalidDirective, fmt.Errorf(format, args...))
+	}
+	generator, ok := pkg.generators[pluginName]
+	if !ok {
+		return fail("unknown plugin %s", pluginName)
+	}
+	methodGenerator, ok := generator.(MethodGenerator)
+	if !ok {
+		return fail("plugin %s does not generate methods", pluginName)
+	}
+	named, ok := m.Type.Type().(*types.Named)
+	if !ok || named.TypeParams().Len() > 0 {
+		return fail("methods can only be generated for named types without type parameters, but got %s", m.Type.Name())
+	}
+	switch named.Underlying().(type) {
+	case *types.Interface, *types.Pointer:
+		return fail("methods cannot be declared for %s, since it is an interface or pointer type", m.Type.Name())
+	}
+	methodName := methodGenerator.MethodName()
+	for i := 0; i < named.NumMethods(); i++ {
+		meth := named.Method(i)
+		if meth.Name() != methodName {
+			continue
+		}
+		if file := pkg.info.Fset.File(meth.Pos()); file != nil && pkg.outputs.contains(file.Name()) {
+			continue
+		}
+		return fail("%s already has a %s method", m.Type.Name(), methodName)
+	}
+	for _, other := range pkg.methods {
+		if other.typ == named && other.generator.MethodName() == methodName {
+			return nil
+		}
+	}
+	typs := methodGenerator.MethodTypes(named)
+	funcName := generator.GetFuncName(typs...)
+	key := pluginName + "." + funcName
+	if _, ok := pkg.positions[key]; !ok {
+		pkg.positions[key] = m.Pos
+	}
+	pkg.graph.call(pluginName, funcName, m.Pos)
+	pkg.methods = append(pkg.methods, &method{pluginName, methodGenerator, named, funcName})
+	return nil
+}
+
+// pluginName returns the name of the plugin that generates the function with the given name.
+func (pkg *pkg) pluginName(funcName string) string {
+	for _, p := range pkg.plugins {
+		if strings.HasPrefix(funcName, p.GetPrefix()) {
+			return p.Name()
+		}
+	}
+	return ""
+}
+
 func (pkg *pkg) Done() bool {
 	for _, g := range pkg.generators {
 		if !g.Done() {
@@ -210,111 +495,426 @@ func (pkg *pkg) Done() bool {
 	return true
 }
 
-func (pkg *pkg) HasContent() bool {
-	return pkg.printer.HasContent()
+// derivedFiles returns the generated functions and methods of each derived file that the package owns, by filename.
+// An external test package only owns its own derived file,
+// while any other package owns the derived file and the derived test file, which contains the functions that are only used by tests.
+func (pkg *pkg) derivedFiles() map[string][]part {
+	parts := reachable(pkg.printer.w.Bytes(), pkg.parts, pkg.graph)
+	if pkg.xtest {
+		return map[string][]part{pkg.outputs.xtest: parts}
+	}
+	main, test := splitTests(pkg.printer.w.Bytes(), parts, pkg.graph, pkg.info.Fset)
+	return map[string][]part{pkg.outputs.main: pkg.withExports(main), pkg.outputs.test: test}
 }
 
-func (pkg *pkg) Filename() string {
-	return filepath.Join(pkg.fullpath, derivedFilename)
+// Contents returns the content of each derived file that the package owns, by filename.
+// The content is nil, if the file does not contain any functions and should be removed.
+func (pkg *pkg) Contents() (map[string][]byte, error) {
+	return pkg.contents(pkg.derivedFiles())
 }
 
-func (pkg *pkg) Print() error {
-	f, err := os.Create(pkg.Filename())
-	if err != nil {
-		return err
-	}
-	if _, err := pkg.printer.WriteTo(f); err != nil {
-		return err
+func (pkg *pkg) contents(derivedFiles map[string][]part) (map[string][]byte, error) {
+	contents := make(map[string][]byte, len(derivedFiles))
+	for filename, parts := range derivedFiles {
+		if len(parts) == 0 {
+			contents[filename] = nil
+			continue
+		}
+		buf := bytes.NewBuffer(nil)
+		if _, err := pkg.printer.writeParts(buf, parts); err != nil {
+			return nil, err
+		}
+		contents[filename] = buf.Bytes()
 	}
-	return f.Close()
+	return contents, nil
 }
 
-func (pkg *pkg) Delete() error {
-	filename := pkg.Filename()
-	_, err := os.Stat(filename)
+// Print writes the derived files that the package owns and removes the ones without any functions.
+func (pkg *pkg) Print(files *files) error {
+	derivedFiles := pkg.derivedFiles()
+	contents, err := pkg.contents(derivedFiles)
 	if err != nil {
-		if os.IsNotExist(err) {
-			return nil
+		return err
+	}
+	tested := make(map[string]bool)
+	for _, p := range derivedFiles[pkg.outputs.test] {
+		tested[p.name] = true
+	}
+	for name, content := range contents {
+		filename := filepath.Join(pkg.fullpath, name)
+		var shared []*sharedFunc
+		for _, p := range derivedFiles[name] {
+			if p.shared != nil {
+				shared = append(shared, p.shared)
+			}
+		}
+		if err := files.SetShared(filename, pkg.shared, shared); err != nil {
+			return err
+		}
+		if content == nil {
+			if err := files.Remove(filename); err != nil {
+				return err
+			}
+			if err := files.SetFuncs(filename, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := files.WriteDerived(filename, content); err != nil {
+			return err
+		}
+		isTest := name == pkg.outputs.test
+		funcs := pkg.graph.Funcs(filename, func(funcName string) bool {
+			return tested[funcName] == isTest
+		})
+		if err := files.SetFuncs(filename, funcs); err != nil {
+			return err
 		}
-		return fmt.Errorf("stat %s: %v", filename, err)
 	}
-	return os.Remove(filename)
+	return nil
 }
 
-func (pkg *pkg) Generate() (bool, error) {
+// Generate generates all the functions that have been added, followed by the methods.
+// A function that fails to generate is reported at the position of the first call to it,
+// or without a position if it was only required by another generated function.
+func (pkg *pkg) Generate() bool {
 	generated := false
 	for !pkg.Done() {
 		for _, plugin := range pkg.plugins {
 			g := pkg.generators[plugin.Name()]
 			for _, typs := range g.ToGenerate() {
+				pkg.graph.paused = true
+				name := g.GetFuncName(typs...)
+				pkg.graph.paused = false
+				pkg.graph.generating(name)
+				pkg.graph.typed(plugin.Name(), name, typs, pkg.info.Types)
+				if p, ok := pkg.reuse(plugin, g, name, typs); ok {
+					pkg.parts = append(pkg.parts, p)
+					generated = true
+					continue
+				}
+				start := pkg.printer.mark()
 				if err := g.Generate(typs); err != nil {
-					return false, fmt.Errorf("Generator Error: %s:%v", plugin.Name(), err.Error())
+					// Keep going, so that every function that cannot be generated is reported and not only the first.
+					pos := pkg.positions[plugin.Name()+"."+name]
+					pkg.diagnostics = append(pkg.diagnostics, newDiagnostic(pkg.info.Fset, pos, plugin.Name(), CodeGenerate, err))
+					pkg.printer.discard(start)
+					g.Generating(typs...)
+					continue
 				}
+				p := part{name: name, start: start, end: pkg.printer.mark()}
+				if diag := pkg.checkSafe(plugin.Name(), name, p); diag != nil {
+					pkg.diagnostics = append(pkg.diagnostics, diag)
+					pkg.printer.discard(start)
+					continue
+				}
+				pkg.share(&p, plugin, typs)
+				pkg.addExport(p, plugin, typs)
+				pkg.parts = append(pkg.parts, p)
 				generated = true
 			}
 		}
 	}
-	return generated, nil
+	pkg.graph.generating("")
+	for _, m := range pkg.methods {
+		start := pkg.printer.mark()
+		if err := m.generator.GenerateMethod(m.typ, m.funcName); err != nil {
+			pos := pkg.positions[m.plugin+"."+m.funcName]
+			pkg.diagnostics = append(pkg.diagnostics, newDiagnostic(pkg.info.Fset, pos, m.plugin, CodeGenerate, err))
+			pkg.printer.discard(start)
+			continue
+		}
+		test := isTestFile(pkg.info.Fset.Position(m.typ.Obj().Pos()).Filename)
+		pkg.parts = append(pkg.parts, part{test: test, start: start, end: pkg.printer.mark()})
+	}
+	return generated
 }
 
-func (pg *program) Generate() error {
-	pkgInfos := pg.program.InitialPackages()
+// unsafeImports are the packages that generated code is not allowed to use in safe mode.
+var unsafeImports = []string{"reflect", "unsafe"}
 
-	// sort.Slice(pkgInfos, func(i, j int) bool {
-	// 	return pkgInfos[i].String() < pkgInfos[j].String()
-	// })
-	for i := range pkgInfos {
-		if err := pg.generatePackage(pkgInfos[i]); err != nil {
-			return err
+// checkSafe reports a generated function, that uses reflect or unsafe in safe mode.
+// This also catches plugins that do not implement SafeGenerator.
+func (pkg *pkg) checkSafe(plugin, name string, p part) *Diagnostic {
+	if !pkg.safe {
+		return nil
+	}
+	for _, path := range unsafeImports {
+		if pkg.printer.uses(p, path) {
+			pos := pkg.positions[plugin+"."+name]
+			err := fmt.Errorf("%s uses the %s package, which is not allowed in safe mode", name, path)
+			return newDiagnostic(pkg.info.Fset, pos, plugin, CodeGenerate, err)
 		}
 	}
 	return nil
 }
 
-func (pg *program) generatePackage(pkgInfo *loader.PackageInfo) error {
-	path := pkgInfo.Pkg.Path()
-	// ss := make([]string, len(pkgInfo.Files))
-	// for i := range pkgInfo.Files {
-	// 	ss[i] = pg.program.Fset.File(pkgInfo.Files[i].Pos()).Name()
+func (pg *program) Generate() error {
+	res, err := pg.Result()
+	if err != nil {
+		return err
+	}
+	return res.Write()
+}
+
+func (pg *program) Check(w io.Writer) ([]string, error) {
+	res, err := pg.Result()
+	if err != nil {
+		return nil, err
+	}
+	return res.Diff(w)
+}
+
+func (pg *program) Result() (*Result, error) {
+	files := newFiles(pg.overlay)
+	stats, err := pg.generate(files)
+	if err != nil {
+		return nil, err
+	}
+	res := files.Result()
+	res.Stats = stats
+	return res, nil
+}
+
+// generate generates the code for every package.
+// Diagnostics for all packages are returned together, while any other error stops the generation immediately.
+// Packages are generated concurrently, except for packages in the same directory, which share a derived file.
+// The log output of each directory is buffered and printed in order, so that the output is the same for every run.
+func (pg *program) generate(files *files) (Stats, error) {
+	var dirs [][]*packages.Package
+	var settings []*settings
+	for _, d := range groupByDir(pg.pkgs) {
+		s, err := pg.settings(d[0])
+		if err != nil {
+			return Stats{}, err
+		}
+		// The shared package is generated after all other packages, with the functions that they require.
+		if sp := newSharedPackage(d[0], s); sp != nil && sp.dir == dir(d[0]) {
+			continue
+		}
+		dirs = append(dirs, d)
+		settings = append(settings, s)
+	}
+	dirs, settings, waits := orderExports(dirs, settings)
+	var c *cache
+	if len(pg.cacheDir) > 0 {
+		var err error
+		c, err = newCache(pg.cacheDir, pg)
+		if err != nil {
+			return Stats{}, err
+		}
+	}
+	jobs := pg.jobs
+	if jobs <= 0 {
+		jobs = runtime.GOMAXPROCS(0)
+	}
+	if jobs > len(dirs) {
+		jobs = len(dirs)
+	}
+
+	type dirResult struct {
+		log         bytes.Buffer
+		diagnostics Diagnostics
+		err         error
+		done        chan struct{}
+	}
+	results := make([]*dirResult, len(dirs))
+	for i := range results {
+		results[i] = &dirResult{done: make(chan struct{})}
+	}
+	queue := make(chan int)
+	go func() {
+		for i := range dirs {
+			queue <- i
+		}
+		close(queue)
+	}()
+	var failed atomic.Bool
+	for w := 0; w < jobs; w++ {
+		go func() {
+			for i := range queue {
+				res := results[i]
+				// The directories, that export derived functions, which this directory could reuse, are generated first.
+				stale := false
+				for _, j := range waits[i] {
+					<-results[j].done
+					stale = stale || changedOutputs(files, dirs[j], settings[j])
+				}
+				if !failed.Load() {
+					logger := log.New(&res.log, log.Prefix(), log.Flags())
+					res.diagnostics, res.err = pg.generateDir(dirs[i], settings[i], files, c, stale, logger)
+					if res.err == nil && len(res.diagnostics) == 0 && pg.reportUnused {
+						res.err = findUnused(dirs[i], settings[i], files, pg.overlay)
+					}
+					if res.err != nil {
+						failed.Store(true)
+					}
+				}
+				close(res.done)
+			}
+		}()
+	}
+
+	var diagnostics Diagnostics
+	var err error
+	for _, res := range results {
+		<-res.done
+		if _, werr := log.Writer().Write(res.log.Bytes()); werr != nil && err == nil {
+			err = werr
+		}
+		if res.err != nil && err == nil {
+			err = res.err
+		}
+		diagnostics = append(diagnostics, res.diagnostics...)
+	}
+	if err != nil {
+		return Stats{}, err
+	}
+	if len(diagnostics) == 0 {
+		diagnostics, err = pg.generateShared(files, log.Default())
+		if err != nil {
+			return Stats{}, err
+		}
+	}
+	if len(diagnostics) > 0 {
+		sortDiagnostics(diagnostics)
+		return Stats{}, diagnostics
+	}
+	stats := Stats{Dirs: len(dirs)}
+	if c != nil {
+		stats.CacheHits, stats.CacheMisses = c.stats()
+	}
+	return stats, nil
+}
+
+// settings resolves the configuration of the package, from the config, the goderive.json files and the override.
+func (pg *program) settings(pkgInfo *packages.Package) (*settings, error) {
+	cs, err := configFiles(pkgInfo, pg.plugins, pg.overlay)
+	if err != nil {
+		return nil, err
+	}
+	return resolve(pg.plugins, pg.autoname, pg.dedup, cs, pg.override), nil
+}
+
+// groupByDir groups the packages by directory, keeping the order of the packages.
+func groupByDir(pkgs []*packages.Package) [][]*packages.Package {
+	var dirs [][]*packages.Package
+	index := make(map[string]int)
+	for _, pkg := range pkgs {
+		d := dir(pkg)
+		i, ok := index[d]
+		if !ok {
+			i = len(dirs)
+			index[d] = i
+			dirs = append(dirs, nil)
+		}
+		dirs[i] = append(dirs[i], pkg)
+	}
+	return dirs
+}
+
+// generateDir generates the code for the packages in a single directory, one after the other.
+// If the derived files of the directory are found in the cache, the packages are not generated at all.
+// Only derived files without diagnostics and without edits to source files are stored in the cache.
+// Stale is true if packages, that the directory imports, exported derived functions in this run, that are not on disk yet,
+// in which case the packages are reloaded with these functions and the cache is not used.
+func (pg *program) generateDir(pkgInfos []*packages.Package, s *settings, files *files, c *cache, stale bool, logger *log.Logger) (Diagnostics, error) {
+	d := dir(pkgInfos[0])
+	var key string
+	// The functions that the packages require from a shared package are not stored in the cache.
+	if len(s.shared) > 0 || stale {
+		c = nil
+	}
+	if c != nil {
+		key = c.key(pkgInfos, s)
+		if contents, ok := c.Get(key); ok {
+			for name, content := range contents {
+				filename := filepath.Join(d, name)
+				if content == nil {
+					if err := files.Remove(filename); err != nil {
+						return nil, err
+					}
+				} else if err := files.WriteDerived(filename, content); err != nil {
+					return nil, err
+				}
+			}
+			return nil, nil
+		}
+	}
+	r := newReloader(pg.tags)
+	var diagnostics Diagnostics
+	var sources []string
+	for _, pkgInfo := range pkgInfos {
+		if stale {
+			var err error
+			pkgInfo, err = r.reload(files.Overlay(), pkgInfo)
+			if err != nil {
+				return nil, err
+			}
+		}
+		ds, err := pg.generatePackage(pkgInfo, s, files, r, logger)
+		if err != nil {
+			return nil, err
+		}
+		diagnostics = append(diagnostics, ds...)
+		sources = append(sources, pkgInfo.CompiledGoFiles...)
+	}
+	if c == nil || len(diagnostics) > 0 || files.Edited(sources) {
+		return diagnostics, nil
+	}
+	// Only the derived files that were written or removed are stored,
+	// for example the derived file of an external test package is left alone, if the directory has no external tests.
+	contents := make(map[string][]byte)
+	for _, name := range newOutputs(s.output).all() {
+		if content, ok := files.Get(filepath.Join(d, name)); ok {
+			contents[name] = content
+		}
+	}
+	if err := c.Put(key, contents); err != nil {
+		// The cache is only an optimization, so goderive keeps going.
+		logger.Printf("could not write to cache: %v", err)
+	}
+	return nil, nil
+}
+
+// generatePackage generates the code for a single package,
+// reloading it until the argument types of all calls can be inferred.
+// The returned diagnostics are the problems with the calls in the package.
+func (pg *program) generatePackage(pkgInfo *packages.Package, s *settings, files *files, r *reloader, logger *log.Logger) (Diagnostics, error) {
+	// ss := make([]string, len(pkgInfo.Syntax))
+	// for i := range pkgInfo.Syntax {
+	// 	ss[i] = pkgInfo.Fset.File(pkgInfo.Syntax[i].Pos()).Name()
 	// }
-	// log.Printf("package: %s, files %d: %s", path, len(pkgInfo.Files), strings.Join(ss, ", "))
+	// log.Printf("package: %s, files %d: %s", pkgInfo.ID, len(pkgInfo.Syntax), strings.Join(ss, ", "))
 	generated := true
 	var undefined string
-	thisprogram := pg.program
+	var pkgGen *pkg
 	for generated {
-		pkgGen, err := newPackage(thisprogram, pkgInfo, pg.plugins, pg.autoname, pg.dedup)
+		var err error
+		pkgGen, err = newPackage(pkgInfo, s, files, logger)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
 		us := make([]string, len(pkgGen.undefined))
 		for i, u := range pkgGen.undefined {
-			us[i] = types.ExprString(u)
+			us[i] = types.ExprString(u.Expr)
 		}
 		sort.Strings(us)
 
 		for _, u := range us {
-			log.Printf("could not yet generate: %s", u)
+			logger.Printf("could not yet generate: %s", u)
 		}
 
-		generated, err = pkgGen.Generate()
-		if err != nil {
-			return err
-		}
+		generated = pkgGen.Generate()
 
-		if pkgGen.HasContent() {
-			if err := pkgGen.Print(); err != nil {
-				return err
-			}
-		} else {
-			// When the file has no content it should be removed.
-			if err := pkgGen.Delete(); err != nil {
-				return err
-			}
+		// Derived files without any content are removed.
+		if err := pkgGen.Print(files); err != nil {
+			return nil, err
 		}
 
 		if len(us) == 0 {
-			return nil
+			return pkgGen.diagnostics, nil
 		}
 
 		newundefined := strings.Join(us, ";")
@@ -323,16 +923,20 @@ func (pg *program) generatePackage(pkgInfo *loader.PackageInfo) error {
 		}
 		undefined = newundefined
 
-		// reload path with newly generated code, with the hope that some types are now inferable.
-		thisprogram, err = load(path)
+		// reload package with newly generated code, with the hope that some types are now inferable.
+		pkgInfo, err = r.reload(files.Overlay(), pkgInfo)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		pkgInfo = thisprogram.Package(path)
 	}
 
+	diagnostics := pkgGen.diagnostics
 	if len(undefined) > 0 && !generated {
-		return fmt.Errorf("cannot generate: %s", undefined)
+		stuck := newStuck(pkgGen)
+		for _, u := range pkgGen.undefined {
+			err := errors.New(stuck.explain(u))
+			diagnostics = append(diagnostics, newDiagnostic(pkgGen.info.Fset, u.Expr.Pos(), pkgGen.pluginName(u.Name), CodeUndefined, err))
+		}
 	}
-	return nil
+	return diagnostics, nil
 }
@@ -0,0 +1,106 @@
+package derive_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/equal"
+)
+
+const generateSource = `package generate
+
+type T struct {
+	A int
+}
+
+type U struct {
+	B string
+}
+
+func equalT(this, that *T) bool {
+	return deriveEqual(this, that)
+}
+
+func equalU(this, that *U) bool {
+	return deriveEqual(this, that)
+}
+`
+
+func TestGenerateDoesNotWrite(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "generate.go")
+	writeFile(t, filepath.Join(dir, "go.mod"), "module generate\n\ngo 1.21\n")
+	writeFile(t, source, generateSource)
+	t.Chdir(dir)
+
+	result, err := derive.Generate(derive.Config{Plugins: []derive.Plugin{equal.NewPlugin()}, Autoname: true}, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	derived := filepath.Join(dir, "derived.gen.go")
+	if len(result.Files) != 1 || result.Files[derived] == nil {
+		t.Fatalf("want only %s, but got %v", derived, filenames(result.Files))
+	}
+	content := string(result.Files[derived])
+	for _, want := range []string{"func deriveEqual(this, that *T) bool", "func deriveEqual_PtrTo_U(this, that *U) bool"} {
+		if !strings.Contains(content, want) {
+			t.Errorf("want %q in %s:\n%s", want, derived, content)
+		}
+	}
+	if len(result.Edits) != 1 || result.Edits[0].Filename != source {
+		t.Fatalf("want one edit of %s, but got %v", source, result.Edits)
+	}
+	edit := result.Edits[0]
+	if len(edit.Renames) != 1 || edit.Renames[0].OldName != "deriveEqual" || edit.Renames[0].NewName != "deriveEqual_PtrTo_U" {
+		t.Errorf("want deriveEqual renamed to deriveEqual_PtrTo_U, but got %v", edit.Renames)
+	}
+	if !strings.Contains(string(edit.Content), "return deriveEqual_PtrTo_U(this, that)") {
+		t.Errorf("want the renamed call in the edit:\n%s", edit.Content)
+	}
+
+	if _, err := os.Stat(derived); !os.IsNotExist(err) {
+		t.Fatalf("want %s not to exist before Write, but got %v", derived, err)
+	}
+	if got := readFile(t, source); got != generateSource {
+		t.Fatalf("want %s unchanged before Write, but got:\n%s", source, got)
+	}
+
+	if err := result.Write(); err != nil {
+		t.Fatal(err)
+	}
+	if got := readFile(t, derived); got != content {
+		t.Errorf("want the result in %s after Write, but got:\n%s", derived, got)
+	}
+	if got := readFile(t, source); !bytes.Equal([]byte(got), edit.Content) {
+		t.Errorf("want the edit in %s after Write, but got:\n%s", source, got)
+	}
+}
+
+func filenames(files map[string][]byte) []string {
+	var names []string
+	for name := range files {
+		names = append(names, name)
+	}
+	return names
+}
+
+func writeFile(t *testing.T, filename, content string) {
+	t.Helper()
+	if err := os.WriteFile(filename, []byte(content), 0666); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readFile(t *testing.T, filename string) string {
+	t.Helper()
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
@@ -0,0 +1,286 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/token"
+	"go/types"
+	"io"
+	"sort"
+	"strings"
+)
+
+// Graph describes why each derived function was generated.
+type Graph struct {
+	Funcs []*Func
+}
+
+// Func is a generated function, together with the calls and derived functions that required it.
+type Func struct {
+	// Name is the name of the function.
+	Name string
+	// Plugin is the name of the plugin that generated the function.
+	Plugin string
+	// Filename is the absolute filename of the derived file that contains the function.
+	Filename string
+	// Types are the input types, that the function was generated for, relative to the package of the derived file.
+	Types []string
+	// Calls are the positions of the calls in the source code that required the function,
+	// including the //goderive:methods directives.
+	Calls []token.Position
+	// Parents are the names of the derived functions in the same file that required the function.
+	Parents []string
+}
+
+func (g *Graph) sort() {
+	sort.Slice(g.Funcs, func(i, j int) bool {
+		if g.Funcs[i].Filename != g.Funcs[j].Filename {
+			return g.Funcs[i].Filename < g.Funcs[j].Filename
+		}
+		return g.Funcs[i].Name < g.Funcs[j].Name
+	})
+}
+
+type jsonPosition struct {
+	Filename string `json:"filename"`
+	Line     int    `json:"line"`
+	Column   int    `json:"column"`
+}
+
+type jsonFunc struct {
+	Name     string         `json:"name"`
+	Plugin   string         `json:"plugin"`
+	Filename string         `json:"filename"`
+	Types    []string       `json:"types,omitempty"`
+	Calls    []jsonPosition `json:"calls,omitempty"`
+	Parents  []string       `json:"parents,omitempty"`
+}
+
+// WriteJSON writes the graph as a JSON array of functions.
+func (g *Graph) WriteJSON(w io.Writer) error {
+	funcs := make([]jsonFunc, len(g.Funcs))
+	for i, f := range g.Funcs {
+		calls := make([]jsonPosition, len(f.Calls))
+		for j, c := range f.Calls {
+			calls[j] = jsonPosition{c.Filename, c.Line, c.Column}
+		}
+		funcs[i] = jsonFunc{f.Name, f.Plugin, f.Filename, f.Types, calls, f.Parents}
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "\t")
+	return enc.Encode(funcs)
+}
+
+// WriteDOT writes the graph in the graphviz dot format, with a cluster for each derived file.
+// Calls in the source code are boxes, with an edge to the function they require,
+// and each derived function has an edge to the functions it requires.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	ew := &errWriter{w: w}
+	ew.printf("digraph goderive {\n")
+	ew.printf("\trankdir=LR;\n")
+	byFile := make(map[string][]*Func)
+	var filenames []string
+	for _, f := range g.Funcs {
+		if _, ok := byFile[f.Filename]; !ok {
+			filenames = append(filenames, f.Filename)
+		}
+		byFile[f.Filename] = append(byFile[f.Filename], f)
+	}
+	for i, filename := range filenames {
+		name := relativeName(filename)
+		ew.printf("\tsubgraph cluster_%d {\n", i)
+		ew.printf("\t\tlabel=%q;\n", name)
+		for _, f := range byFile[filename] {
+			ew.printf("\t\t%q [label=%q];\n", name+":"+f.Name, f.Name+"\n"+f.Plugin)
+		}
+		ew.printf("\t}\n")
+		for _, f := range byFile[filename] {
+			for _, c := range f.Calls {
+				call := fmt.Sprintf("%s:%d:%d", relativeName(c.Filename), c.Line, c.Column)
+				ew.printf("\t%q [shape=box];\n", call)
+				ew.printf("\t%q -> %q;\n", call, name+":"+f.Name)
+			}
+			for _, parent := range f.Parents {
+				ew.printf("\t%q -> %q;\n", name+":"+parent, name+":"+f.Name)
+			}
+		}
+	}
+	ew.printf("}\n")
+	return ew.err
+}
+
+// Explain writes why the functions with the name were generated, in every derived file that contains such a function.
+// These are the types, the calls in the source code and the derived functions that required the function,
+// followed by the explanation of each of these derived functions, until only calls remain.
+// It returns false if there is no function with the name in the graph.
+func (g *Graph) Explain(w io.Writer, name string) (bool, error) {
+	ew := &errWriter{w: w}
+	found := false
+	for _, f := range g.Funcs {
+		if f.Name != name {
+			continue
+		}
+		found = true
+		g.explain(ew, f, make(map[string]bool))
+	}
+	return found, ew.err
+}
+
+func (g *Graph) explain(ew *errWriter, f *Func, explained map[string]bool) {
+	explained[f.Name] = true
+	ew.printf("%s(%s) in %s, generated by the %s plugin\n", f.Name, strings.Join(f.Types, ", "), relativeName(f.Filename), f.Plugin)
+	for _, c := range f.Calls {
+		ew.printf("\tcalled at %s:%d:%d\n", relativeName(c.Filename), c.Line, c.Column)
+	}
+	for _, parent := range f.Parents {
+		ew.printf("\trequired by %s\n", parent)
+	}
+	for _, parent := range f.Parents {
+		for _, p := range g.Funcs {
+			if p.Name == parent && p.Filename == f.Filename && !explained[p.Name] {
+				g.explain(ew, p, explained)
+			}
+		}
+	}
+}
+
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (ew *errWriter) printf(format string, args ...interface{}) {
+	if ew.err != nil {
+		return
+	}
+	_, ew.err = fmt.Fprintf(ew.w, format, args...)
+}
+
+// graph records the calls and the derived functions that require each function, while a package is generated.
+// The typesMaps of all plugins in a package share the same graph,
+// so that a function that is required by another plugin, through a Dependency, is also recorded.
+type graph struct {
+	fset  *token.FileSet
+	funcs map[string]*graphFunc
+	// current is the name of the function that is being generated.
+	current string
+	// pending are the names of the functions that were required, while a call was being added,
+	// before the name of the function for the call is known.
+	pending []string
+	// paused is true while goderive itself looks up function names, which should not be recorded.
+	paused bool
+}
+
+type graphFunc struct {
+	plugin  string
+	types   []string
+	calls   []token.Pos
+	parents map[string]struct{}
+}
+
+func newGraph(fset *token.FileSet) *graph {
+	return &graph{
+		fset:  fset,
+		funcs: make(map[string]*graphFunc),
+	}
+}
+
+func (g *graph) node(plugin, name string) *graphFunc {
+	f, ok := g.funcs[name]
+	if !ok {
+		f = &graphFunc{plugin: plugin, parents: make(map[string]struct{})}
+		g.funcs[name] = f
+	}
+	return f
+}
+
+// use records that the function is required by the function that is currently generated.
+func (g *graph) use(plugin, name string) {
+	if g.paused {
+		return
+	}
+	f := g.node(plugin, name)
+	if len(g.current) == 0 {
+		g.pending = append(g.pending, name)
+		return
+	}
+	if g.current != name {
+		f.parents[g.current] = struct{}{}
+	}
+}
+
+// call records a call to the function and that the functions which were required while adding the call, are required by this function.
+func (g *graph) call(plugin, name string, pos token.Pos) {
+	f := g.node(plugin, name)
+	if pos.IsValid() {
+		f.calls = append(f.calls, pos)
+	}
+	for _, p := range g.pending {
+		if p != name {
+			g.funcs[p].parents[name] = struct{}{}
+		}
+	}
+	g.pending = nil
+}
+
+// typed records the input types of the function.
+func (g *graph) typed(plugin, name string, typs []types.Type, pkg *types.Package) {
+	f := g.node(plugin, name)
+	f.types = make([]string, len(typs))
+	for i, typ := range typs {
+		f.types[i] = types.TypeString(typ, types.RelativeTo(pkg))
+	}
+}
+
+// generating records the name of the function that is being generated, until the next call to generating.
+func (g *graph) generating(name string) {
+	g.current = name
+}
+
+// Funcs returns the functions in the graph, that are in the given derived file.
+func (g *graph) Funcs(filename string, in func(name string) bool) []*Func {
+	funcs := make([]*Func, 0, len(g.funcs))
+	for name, f := range g.funcs {
+		if !in(name) {
+			continue
+		}
+		calls := make([]token.Position, len(f.calls))
+		for i, pos := range f.calls {
+			calls[i] = g.fset.Position(pos)
+		}
+		sort.Slice(calls, func(i, j int) bool {
+			if calls[i].Filename != calls[j].Filename {
+				return calls[i].Filename < calls[j].Filename
+			}
+			return calls[i].Offset < calls[j].Offset
+		})
+		var parents []string
+		for parent := range f.parents {
+			parents = append(parents, parent)
+		}
+		sort.Strings(parents)
+		funcs = append(funcs, &Func{
+			Name:     name,
+			Plugin:   f.plugin,
+			Filename: filename,
+			Types:    f.types,
+			Calls:    calls,
+			Parents:  parents,
+		})
+	}
+	return funcs
+}
@@ -0,0 +1,142 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"go/types"
+)
+
+// infer instantiates a generic function signature using the types of the arguments that are known.
+// The type checker does not infer the type arguments of a call, if one of its arguments is an undefined function,
+// for example slices.SortFunc(xs, deriveCompare), even though the type arguments could be inferred from xs.
+// Only the inference of type arguments from argument types and core types is supported.
+// Nil is returned if not all type arguments could be inferred.
+func infer(sig *types.Signature, args []types.Type) *types.Signature {
+	tparams := sig.TypeParams()
+	targs := make(map[*types.TypeParam]types.Type)
+	params := sig.Params()
+	for i, arg := range args {
+		if arg == nil || arg == types.Typ[types.Invalid] || i >= params.Len() {
+			continue
+		}
+		if sig.Variadic() && i == params.Len()-1 {
+			// Variadic arguments are not supported.
+			break
+		}
+		unify(params.At(i).Type(), arg, targs)
+	}
+	// Type parameters are also inferred from the core types of the constraints of type parameters that are already inferred,
+	// for example E from S ~[]E.
+	for changed := true; changed; {
+		changed = false
+		for i := 0; i < tparams.Len(); i++ {
+			tparam := tparams.At(i)
+			targ, ok := targs[tparam]
+			if !ok {
+				continue
+			}
+			if core := coreTerm(tparam); core != nil {
+				n := len(targs)
+				unify(core, targ.Underlying(), targs)
+				changed = changed || len(targs) != n
+			}
+		}
+	}
+	list := make([]types.Type, tparams.Len())
+	for i := range list {
+		targ, ok := targs[tparams.At(i)]
+		if !ok {
+			return nil
+		}
+		list[i] = targ
+	}
+	inst, err := types.Instantiate(nil, sig, list, true)
+	if err != nil {
+		return nil
+	}
+	return inst.(*types.Signature)
+}
+
+// coreTerm returns the type of the single term in the constraint of the type parameter, for example []E for S ~[]E.
+func coreTerm(tparam *types.TypeParam) types.Type {
+	iface, ok := tparam.Constraint().Underlying().(*types.Interface)
+	if !ok || iface.NumEmbeddeds() != 1 {
+		return nil
+	}
+	switch embedded := iface.EmbeddedType(0).(type) {
+	case *types.Union:
+		if embedded.Len() != 1 {
+			return nil
+		}
+		return embedded.Term(0).Type()
+	case *types.TypeParam:
+		return nil
+	default:
+		return embedded
+	}
+}
+
+// unify matches the parameter type with the argument type and records the type arguments of the type parameters that it finds.
+func unify(param, arg types.Type, targs map[*types.TypeParam]types.Type) {
+	switch p := param.(type) {
+	case *types.TypeParam:
+		if _, ok := targs[p]; !ok {
+			targs[p] = arg
+		}
+	case *types.Pointer:
+		if a, ok := arg.Underlying().(*types.Pointer); ok {
+			unify(p.Elem(), a.Elem(), targs)
+		}
+	case *types.Slice:
+		if a, ok := arg.Underlying().(*types.Slice); ok {
+			unify(p.Elem(), a.Elem(), targs)
+		}
+	case *types.Array:
+		if a, ok := arg.Underlying().(*types.Array); ok {
+			unify(p.Elem(), a.Elem(), targs)
+		}
+	case *types.Chan:
+		if a, ok := arg.Underlying().(*types.Chan); ok {
+			unify(p.Elem(), a.Elem(), targs)
+		}
+	case *types.Map:
+		if a, ok := arg.Underlying().(*types.Map); ok {
+			unify(p.Key(), a.Key(), targs)
+			unify(p.Elem(), a.Elem(), targs)
+		}
+	case *types.Signature:
+		if a, ok := arg.Underlying().(*types.Signature); ok {
+			unifyTuple(p.Params(), a.Params(), targs)
+			unifyTuple(p.Results(), a.Results(), targs)
+		}
+	case *types.Named:
+		a, ok := types.Unalias(arg).(*types.Named)
+		if !ok || a.Origin() != p.Origin() || a.TypeArgs().Len() != p.TypeArgs().Len() {
+			return
+		}
+		for i := 0; i < p.TypeArgs().Len(); i++ {
+			unify(p.TypeArgs().At(i), a.TypeArgs().At(i), targs)
+		}
+	}
+}
+
+func unifyTuple(params, args *types.Tuple, targs map[*types.TypeParam]types.Type) {
+	if params.Len() != args.Len() {
+		return
+	}
+	for i := 0; i < params.Len(); i++ {
+		unify(params.At(i).Type(), args.At(i).Type(), targs)
+	}
+}
@@ -0,0 +1,39 @@
+package derive_test
+
+import (
+	"bytes"
+	"testing"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/std"
+)
+
+// TestJobsAreDeterministic tests that generating several packages concurrently results in the same files and edits,
+// as generating them one at a time.
+func TestJobsAreDeterministic(t *testing.T) {
+	patterns := []string{"../test/normal", "../example/plugin/..."}
+	generate := func(jobs int) *derive.Result {
+		t.Helper()
+		result, err := derive.Generate(derive.Config{Plugins: std.Plugins(), Autoname: true, Dedup: true, Jobs: jobs}, patterns...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return result
+	}
+	want := generate(1)
+	if len(want.Files) < 2 {
+		t.Fatalf("want several derived files, but got %d", len(want.Files))
+	}
+	got := generate(8)
+	if len(got.Files) != len(want.Files) {
+		t.Fatalf("want %d derived files with 8 jobs, but got %d", len(want.Files), len(got.Files))
+	}
+	for filename, content := range want.Files {
+		if !bytes.Equal(got.Files[filename], content) {
+			t.Errorf("want the same %s with 1 and 8 jobs", filename)
+		}
+	}
+	if len(got.Edits) != len(want.Edits) {
+		t.Errorf("want %d edits with 8 jobs, but got %d", len(want.Edits), len(got.Edits))
+	}
+}
@@ -15,31 +15,162 @@
 package derive
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"go/ast"
 	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
-	"golang.org/x/tools/go/loader"
+	"golang.org/x/tools/go/packages"
 )
 
-func load(paths ...string) (*loader.Program, error) {
-	conf := loader.Config{
-		ParserMode:  parser.ParseComments,
-		AllowErrors: true,
+const loadMode = packages.NeedName |
+	packages.NeedFiles |
+	packages.NeedCompiledGoFiles |
+	packages.NeedImports |
+	packages.NeedDeps |
+	packages.NeedTypes |
+	packages.NeedSyntax |
+	packages.NeedTypesInfo |
+	packages.NeedForTest |
+	packages.NeedModule
+
+// load loads and type checks the packages matching the patterns, including their test files.
+// The go command resolves the patterns, which means that modules, go.work workspaces,
+// replace directives, GOFLAGS and the given build tags are all taken into account.
+// The overlay replaces the contents of files on disk, for code that was generated in memory.
+// Type errors are ignored, since undefined derive functions are expected.
+func load(tags []string, overlay map[string][]byte, patterns ...string) ([]*packages.Package, error) {
+	return loadConfig(&packages.Config{Tests: true}, tags, overlay, patterns...)
+}
+
+func loadConfig(conf *packages.Config, tags []string, overlay map[string][]byte, patterns ...string) ([]*packages.Package, error) {
+	conf.Mode = loadMode
+	conf.Overlay = overlay
+	if len(tags) > 0 {
+		conf.BuildFlags = []string{"-tags=" + strings.Join(tags, ",")}
 	}
-	conf.TypeChecker.Error = func(err error) {}
-	rest, err := conf.FromArgs(paths, true)
+	pkgs, err := packages.Load(conf, patterns...)
 	if err != nil {
-		return nil, fmt.Errorf("could not parse arguments: %s", err)
+		return nil, fmt.Errorf("could not load packages %v: %v", patterns, err)
+	}
+	return initialPackages(pkgs), nil
+}
+
+// initialPackages removes the packages that are superseded by their test variant,
+// since the test variant contains the same files plus the in package test files.
+// Generated test mains are also removed.
+func initialPackages(pkgs []*packages.Package) []*packages.Package {
+	tested := make(map[string]bool)
+	for _, pkg := range pkgs {
+		if pkg.ForTest == pkg.PkgPath {
+			tested[pkg.PkgPath] = true
+		}
+	}
+	initial := make([]*packages.Package, 0, len(pkgs))
+	for _, pkg := range pkgs {
+		if len(pkg.ForTest) == 0 && tested[pkg.PkgPath] {
+			continue
+		}
+		if pkg.Name == "main" && strings.HasSuffix(pkg.ID, ".test") {
+			continue
+		}
+		if len(pkg.Syntax) == 0 {
+			continue
+		}
+		initial = append(initial, pkg)
+	}
+	// External test packages share a directory and so also a derived.gen.go file with the package they are testing.
+	// They are generated first, so that the package under test has the final say over the contents of the file.
+	sort.SliceStable(initial, func(i, j int) bool {
+		return isExternalTest(initial[i]) && !isExternalTest(initial[j])
+	})
+	return initial
+}
+
+func isExternalTest(pkg *packages.Package) bool {
+	return len(pkg.ForTest) > 0 && pkg.ForTest != pkg.PkgPath
+}
+
+// dir returns the directory of the package, which is also the directory of its derived.gen.go file.
+func dir(pkg *packages.Package) string {
+	// The compiled go files of packages that use cgo are in the build cache.
+	if len(pkg.GoFiles) > 0 {
+		return filepath.Dir(pkg.GoFiles[0])
+	}
+	if len(pkg.CompiledGoFiles) > 0 {
+		return filepath.Dir(pkg.CompiledGoFiles[0])
+	}
+	return pkg.ID
+}
+
+// reloader reloads the packages of a single directory.
+// Files are parsed only once for every content and reused in following reloads.
+// A reloader is not shared between packages that are generated concurrently,
+// since the syntax trees of the package that is generated are changed when calls are renamed.
+type reloader struct {
+	tags   []string
+	fset   *token.FileSet
+	mu     sync.Mutex
+	parsed map[string]*parsedFile
+}
+
+type parsedFile struct {
+	hash [sha256.Size]byte
+	file *ast.File
+	err  error
+}
+
+func newReloader(tags []string) *reloader {
+	return &reloader{
+		tags:   tags,
+		fset:   token.NewFileSet(),
+		parsed: make(map[string]*parsedFile),
+	}
+}
+
+// parseFile is called concurrently by the go/packages loader.
+func (r *reloader) parseFile(fset *token.FileSet, filename string, src []byte) (*ast.File, error) {
+	hash := sha256.Sum256(src)
+	r.mu.Lock()
+	p, ok := r.parsed[filename]
+	r.mu.Unlock()
+	if ok && p.hash == hash {
+		return p.file, p.err
+	}
+	const mode = parser.AllErrors | parser.ParseComments | parser.SkipObjectResolution
+	file, err := parser.ParseFile(fset, filename, src, mode)
+	r.mu.Lock()
+	r.parsed[filename] = &parsedFile{hash, file, err}
+	r.mu.Unlock()
+	return file, err
+}
+
+// reload loads the package again, with the hope that newly generated code
+// has made some of the types inferable.
+// Only the package itself is loaded, together with its test variants, if the package is a test variant.
+func (r *reloader) reload(overlay map[string][]byte, pkg *packages.Package) (*packages.Package, error) {
+	pattern := pkg.PkgPath
+	if len(pkg.ForTest) > 0 {
+		pattern = pkg.ForTest
 	}
-	if len(rest) > 0 {
-		return nil, fmt.Errorf("unhandled extra arguments: %v", rest)
+	conf := &packages.Config{
+		Tests:     len(pkg.ForTest) > 0,
+		Fset:      r.fset,
+		ParseFile: r.parseFile,
 	}
-	p, err := conf.Load()
+	pkgs, err := loadConfig(conf, r.tags, overlay, pattern)
 	if err != nil {
 		return nil, err
 	}
-	if p.Fset == nil {
-		return nil, fmt.Errorf("program == nil")
+	for _, p := range pkgs {
+		if p.ID == pkg.ID {
+			return p, nil
+		}
 	}
-	return p, nil
+	return nil, fmt.Errorf("could not reload package %s", pkg.ID)
 }
@@ -0,0 +1,419 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/tools/go/analysis/unitchecker"
+)
+
+const usage = `usage: goderive [command] [flags] [packages]
+
+The commands are:
+
+	gen      generate the derived files, which is the default command
+	check    check that the derived files are up to date, without writing any files
+	clean    remove the derived files, including the derived test files
+	list     print the plugins, with their prefixes and supported signatures
+	explain  print the calls and types, that the derived function was generated for:
+	         goderive explain [flags] name [packages]
+
+The flags are:
+`
+
+// commands are the subcommands, which can be given as the first argument.
+var commands = map[string]bool{
+	"gen":     true,
+	"check":   true,
+	"clean":   true,
+	"list":    true,
+	"explain": true,
+}
+
+// command is a run of the goderive command, with its parsed flags.
+type command struct {
+	plugins []Plugin
+
+	autoname     *bool
+	dedup        *bool
+	prefix       *string
+	pluginprefix *string
+	output       *string
+	shared       *string
+	header       *string
+	build        *string
+	generate     *string
+	metadata     *bool
+	safe         *bool
+	export       *bool
+	tags         *string
+	json         *bool
+	jobs         *int
+	nocache      *bool
+	cachedir     *string
+	verbose      *bool
+	graph        *string
+	reportUnused *bool
+	check        *bool
+	watch        *bool
+}
+
+// Main runs the goderive command with the given plugins and exits if there is an error.
+// It parses the subcommand and the flags from the command line, reads the goderive.json files and generates the code.
+// When the binary is run by go vet -vettool, it runs the Analyzer instead.
+// This allows a custom goderive binary, which adds its own plugins to the standard plugins:
+//
+//	func main() {
+//		derive.Main(append(std.Plugins(), myplugin.NewPlugin()))
+//	}
+//
+// Main exits with an error if two plugins have the same name or the same prefix.
+func Main(plugins []Plugin) {
+	log.SetFlags(0)
+	if err := checkDuplicates(plugins); err != nil {
+		log.Fatal(err)
+	}
+	if isVetTool(os.Args[1:]) {
+		// The analysis driver registers its own flags, some of which have the same names as the goderive flags.
+		flag.CommandLine = flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+		unitchecker.Main(NewAnalyzer(plugins))
+	}
+	fs := flag.NewFlagSet(os.Args[0], flag.ExitOnError)
+	c := newCommand(fs, plugins)
+	fs.Usage = func() {
+		fmt.Fprint(fs.Output(), usage)
+		fs.PrintDefaults()
+	}
+	name, args := "gen", os.Args[1:]
+	if len(args) > 0 && commands[args[0]] {
+		name, args = args[0], args[1:]
+	}
+	fs.Parse(args)
+	if *c.check {
+		name = "check"
+	}
+	if err := c.run(fs, name); err != nil {
+		var diagnostics Diagnostics
+		if errors.As(err, &diagnostics) {
+			if *c.json {
+				if err := diagnostics.WriteJSON(os.Stdout); err != nil {
+					log.Fatal(err)
+				}
+				os.Exit(1)
+			}
+			log.Fatalf("%v\nfound %d problems", diagnostics, len(diagnostics))
+		}
+		log.Fatal(err)
+	}
+}
+
+// checkDuplicates returns an error if two plugins have the same name or the same prefix,
+// for example if a plugin is passed to Main twice.
+func checkDuplicates(plugins []Plugin) error {
+	names := make(map[string]bool, len(plugins))
+	prefixes := make(map[string]string, len(plugins))
+	for _, p := range plugins {
+		if names[p.Name()] {
+			return fmt.Errorf("plugin %s is passed more than once", p.Name())
+		}
+		names[p.Name()] = true
+		if other, ok := prefixes[p.GetPrefix()]; ok {
+			return fmt.Errorf("plugins %s and %s have the same prefix %s", other, p.Name(), p.GetPrefix())
+		}
+		prefixes[p.GetPrefix()] = p.Name()
+	}
+	return nil
+}
+
+// newCommand registers the standard flags of goderive with the flag set.
+func newCommand(fs *flag.FlagSet, plugins []Plugin) *command {
+	return &command{
+		plugins: plugins,
+
+		autoname:     fs.Bool("autoname", false, "rename functions that are conflicting with other functions"),
+		dedup:        fs.Bool("dedup", false, "rename functions to functions that are duplicates"),
+		prefix:       fs.String("prefix", "derive", "prefix of all functions"),
+		pluginprefix: fs.String("pluginprefix", "", "used to override function prefixes.  The input is a comma separated list of function are prefix pairs.  For example equal=deriveEqual,copyto=copyTo,fmap=fmap,"),
+		output:       fs.String("output", derivedFilename, "the filename of the generated file.  Functions that are only called from tests are generated in a test file with the same name and a _test suffix, for example derived.gen_test.go"),
+		shared:       fs.String("shared", "", "the directory of a package, relative to the root directory of the module, where the functions for types from the standard library and other modules are generated, instead of in every package that uses them.  For example internal/derived"),
+		header:       fs.String("header", "", "the filename of a file with a header, for example a license, that is written as a comment at the top of the generated files"),
+		build:        fs.String("build", "", "a build constraint expression, that is written as a //go:build line in the generated files"),
+		generate:     fs.String("generate", "", "a command, that is written as a //go:generate line in the generated files, for example \"goderive .\""),
+		metadata:     fs.Bool("metadata", false, "write a comment with the version of goderive and the plugins in the generated files"),
+		safe:         fs.Bool("safe", false, "never use the reflect and unsafe packages to access the private fields of structs in external packages.  The exported methods of these types are used instead, for example Equal, and otherwise the private field is reported"),
+		export:       fs.Bool("export", false, "add an exported function for every derived function with an exported type of the package as argument, for example DeriveEqual_PtrTo_User, which the derived files of other packages call, instead of generating their own copy"),
+		tags:         fs.String("tags", "", "a comma separated list of build tags to consider satisfied while loading packages"),
+		json:         fs.Bool("json", false, "print the diagnostics of calls, for which code could not be generated, as JSON lines to stdout"),
+		jobs:         fs.Int("j", 0, "the number of packages that are generated concurrently.  Zero means the number of CPUs that can run at the same time"),
+		nocache:      fs.Bool("nocache", false, "do not use the cache, which skips the generation of packages that have not changed since the previous run"),
+		cachedir:     fs.String("cachedir", "", "the directory of the cache, which is goderive inside the user's cache directory by default"),
+		verbose:      fs.Bool("v", false, "print statistics, like the number of cache hits and misses"),
+		graph:        fs.String("graph", "", "print the graph of the generated functions, with the calls and derived functions that required them, to stdout.  The format is either dot or json.  The cache is not used, so that the graph is complete"),
+		reportUnused: fs.Bool("report-unused", false, "print the functions in the existing derived files, that are no longer used by any call or derived function and are removed, and the functions that are declared by hand with the prefix of a plugin"),
+		check:        fs.Bool("check", false, "check that the generated code is up to date, without writing any files.  Prints a diff of every out of date file and exits with a non zero exit code"),
+		watch:        fs.Bool("watch", false, "generate the code and then keep polling the files of the packages, generating the packages with changed files again, until interrupted"),
+	}
+}
+
+// run runs the subcommand with the parsed flags.
+func (c *command) run(fs *flag.FlagSet, name string) error {
+	switch name {
+	case "list":
+		return c.list(os.Stdout)
+	case "explain":
+		if fs.NArg() == 0 {
+			return errors.New("explain requires the name of a derived function: goderive explain [flags] name [packages]")
+		}
+	}
+	config, err := c.config(fs)
+	if err != nil {
+		return err
+	}
+	switch name {
+	case "clean":
+		res, err := Clean(config, ImportPaths(fs.Args())...)
+		if err != nil {
+			return err
+		}
+		if *c.verbose {
+			log.Printf("removing %d derived files, in %d directories", len(res.Files), res.Stats.Dirs)
+		}
+		return res.Write()
+	case "explain":
+		// The cache is not used, so that the graph is complete.
+		config.CacheDir = ""
+		funcName := fs.Arg(0)
+		res, err := Generate(config, ImportPaths(fs.Args()[1:])...)
+		if err != nil {
+			return err
+		}
+		found, err := res.Graph.Explain(os.Stdout, funcName)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("could not find the derived function %s", funcName)
+		}
+		return nil
+	}
+	if *c.watch {
+		if name != "gen" || len(*c.graph) > 0 {
+			return errors.New("the -watch flag can only be used with the gen command and without the -graph flag")
+		}
+		return Watch(config, watchInterval, nil, c.report, ImportPaths(fs.Args())...)
+	}
+	if len(*c.graph) > 0 {
+		config.CacheDir = ""
+	}
+	if *c.graph != "" && *c.graph != "dot" && *c.graph != "json" {
+		return fmt.Errorf("unknown graph format <%s>, expected dot or json", *c.graph)
+	}
+	res, err := Generate(config, ImportPaths(fs.Args())...)
+	if err != nil {
+		return err
+	}
+	if *c.verbose {
+		log.Printf("cache: %d hits, %d misses, for %d directories", res.Stats.CacheHits, res.Stats.CacheMisses, res.Stats.Dirs)
+	}
+	switch *c.graph {
+	case "dot":
+		if err := res.Graph.WriteDOT(os.Stdout); err != nil {
+			return err
+		}
+	case "json":
+		if err := res.Graph.WriteJSON(os.Stdout); err != nil {
+			return err
+		}
+	}
+	for _, u := range res.Unused {
+		fmt.Println(u)
+	}
+	if name == "check" {
+		changed, err := res.Diff(os.Stdout)
+		if err != nil {
+			return err
+		}
+		if len(changed) > 0 {
+			return fmt.Errorf("found %d out of date files, please run goderive", len(changed))
+		}
+		return nil
+	}
+	return res.Write()
+}
+
+// watchInterval is the time between polls of the files of the watched packages.
+const watchInterval = 500 * time.Millisecond
+
+// report prints the diagnostics of a run in watch mode.
+// If stderr is a terminal, the report replaces the report of the previous run.
+func (c *command) report(res *Result, err error) {
+	if isTerminal(os.Stderr) {
+		fmt.Fprint(os.Stderr, "\033[H\033[2J")
+	}
+	if err != nil {
+		var diagnostics Diagnostics
+		if errors.As(err, &diagnostics) {
+			if *c.json {
+				if err := diagnostics.WriteJSON(os.Stdout); err != nil {
+					log.Print(err)
+				}
+				return
+			}
+			log.Printf("%v\nfound %d problems", diagnostics, len(diagnostics))
+			return
+		}
+		log.Print(err)
+		return
+	}
+	if *c.verbose {
+		log.Printf("cache: %d hits, %d misses, for %d directories", res.Stats.CacheHits, res.Stats.CacheMisses, res.Stats.Dirs)
+	}
+}
+
+// config returns the config for the plugins, from the flags.
+func (c *command) config(fs *flag.FlagSet) (Config, error) {
+	// Flags that are set explicitly take precedence over the goderive.json files.
+	override := &ConfigFile{}
+	var err error
+	fs.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "prefix":
+			override.Prefix = c.prefix
+		case "pluginprefix":
+			var perr error
+			override.Prefixes, perr = parsePluginPrefixes(*c.pluginprefix)
+			if perr != nil {
+				err = perr
+			}
+		case "autoname":
+			override.Autoname = c.autoname
+		case "dedup":
+			override.Dedup = c.dedup
+		case "output":
+			override.Output = *c.output
+		case "shared":
+			override.Shared = *c.shared
+		case "header":
+			data, herr := os.ReadFile(*c.header)
+			if herr != nil {
+				err = herr
+			}
+			override.Header = string(data)
+		case "build":
+			override.Build = *c.build
+		case "generate":
+			override.Generate = *c.generate
+		case "metadata":
+			override.Metadata = c.metadata
+		case "safe":
+			override.Safe = c.safe
+		case "export":
+			override.Export = c.export
+		}
+	})
+	if err != nil {
+		return Config{}, err
+	}
+	var buildTags []string
+	if len(*c.tags) > 0 {
+		buildTags = strings.Split(*c.tags, ",")
+	}
+	cacheDir := *c.cachedir
+	if len(cacheDir) == 0 && !*c.nocache {
+		dir, err := DefaultCacheDir()
+		if err != nil && *c.verbose {
+			log.Printf("cache disabled: %v", err)
+		}
+		cacheDir = dir
+	}
+	if *c.nocache {
+		cacheDir = ""
+	}
+	return Config{
+		Plugins:  c.plugins,
+		Override: override,
+		Tags:     buildTags,
+		Jobs:     *c.jobs,
+		CacheDir: cacheDir,
+
+		ReportUnused: *c.reportUnused,
+	}, nil
+}
+
+// list prints the name, the default prefix and the supported signatures of every plugin, sorted by name.
+// The signatures are those that were registered for a plugin with the same name, see Register.
+func (c *command) list(w io.Writer) error {
+	plugins := append([]Plugin(nil), c.plugins...)
+	sort.Slice(plugins, func(i, j int) bool {
+		return plugins[i].Name() < plugins[j].Name()
+	})
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, p := range plugins {
+		sigs := signatures(p.Name())
+		if len(sigs) == 0 {
+			fmt.Fprintf(tw, "%s\t%s\t\n", p.Name(), p.GetPrefix())
+		}
+		for i, sig := range sigs {
+			if i == 0 {
+				fmt.Fprintf(tw, "%s\t%s\t%s%s\n", p.Name(), p.GetPrefix(), p.GetPrefix(), sig)
+			} else {
+				fmt.Fprintf(tw, "\t\t%s%s\n", p.GetPrefix(), sig)
+			}
+		}
+	}
+	return tw.Flush()
+}
+
+// parsePluginPrefixes parses a comma separated list of plugin and prefix pairs, for example equal=deriveEqual,fmap=fmap
+func parsePluginPrefixes(s string) (map[string]string, error) {
+	prefixes := make(map[string]string)
+	if len(s) == 0 {
+		return prefixes, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		ss := strings.Split(pair, "=")
+		if len(ss) != 2 {
+			return nil, fmt.Errorf("invalid syntax for plugin prefix <%s>", pair)
+		}
+		prefixes[ss[0]] = ss[1]
+	}
+	return prefixes, nil
+}
+
+// isTerminal returns whether the file is a terminal, for example stderr when goderive is run by hand.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// isVetTool returns whether goderive is run by go vet -vettool,
+// which first asks for the version and flags and then passes a single configuration file per package.
+func isVetTool(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+	last := args[len(args)-1]
+	return last == "-V=full" || last == "-flags" || strings.HasSuffix(last, ".cfg")
+}
@@ -14,9 +14,12 @@
 
 package derive
 
-import "github.com/kisielk/gotool"
-
-// ImportPaths returns the import paths to use for the given command line.
+// ImportPaths returns the package patterns to use for the given command line.
+// Patterns, like ./..., are expanded by the go command when the packages are loaded,
+// so that module boundaries and go.work workspaces are respected.
 func ImportPaths(args []string) []string {
-	return gotool.ImportPaths(args)
+	if len(args) == 0 {
+		return []string{"."}
+	}
+	return args
 }
@@ -33,6 +33,24 @@ type Generator interface {
 	Generate(typs []types.Type) error
 }
 
+// MethodGenerator is implemented by a Generator that can also generate a method,
+// for a named type with a //goderive:methods directive.
+type MethodGenerator interface {
+	// MethodName returns the name of the generated method, for example Equal.
+	MethodName() string
+	// MethodTypes returns the input types of the derived function, that is called by the method of the named type.
+	MethodTypes(typ *types.Named) []types.Type
+	// GenerateMethod generates the method for the named type, which calls the derived function with the given name.
+	GenerateMethod(typ *types.Named, funcName string) error
+}
+
+// SafeGenerator is implemented by a Generator that supports safe mode, see ConfigFile.Safe.
+type SafeGenerator interface {
+	// SetSafe is called, before any functions are added, if safe mode is enabled.
+	// In safe mode the generator never uses the reflect and unsafe packages to access the private fields of structs in external packages.
+	SetSafe()
+}
+
 // Dependency is used by other plugins to generate more functions.
 type Dependency interface {
 	GetFuncName(typs ...types.Type) string
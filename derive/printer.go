@@ -17,12 +17,73 @@ package derive
 import (
 	"bytes"
 	"fmt"
+	"go/token"
+	"go/types"
 	"io"
+	pathpkg "path"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 )
 
+// generatedComment marks every derived file as generated, see https://golang.org/s/generatedcode
+const generatedComment = "// Code generated by goderive DO NOT EDIT."
+
+// isDerived returns whether the content is a file that was generated by goderive,
+// which is a file with the generated comment in the comments above the package clause.
+func isDerived(content []byte) bool {
+	for _, line := range bytes.Split(content, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if string(line) == generatedComment {
+			return true
+		}
+		if len(line) > 0 && !bytes.HasPrefix(line, []byte("//")) {
+			return false
+		}
+	}
+	return false
+}
+
+// Header are the comments at the top of a derived file.
+type Header struct {
+	// License is written above all other comments, where every line that is not a comment yet, becomes a comment.
+	License string
+	// Build is a build constraint expression, that is written as a //go:build line.
+	Build string
+	// Generate is a command, that is written as a //go:generate line.
+	Generate string
+	// Metadata is written as a comment below the generated comment,
+	// for example the version of goderive and the plugins that generated the file.
+	Metadata string
+}
+
+// WriteTo writes the header, followed by the generated comment.
+func (h Header) WriteTo(w io.Writer) (int64, error) {
+	buf := bytes.NewBuffer(nil)
+	if len(h.License) > 0 {
+		for _, line := range strings.Split(strings.TrimRight(h.License, "\n"), "\n") {
+			if !strings.HasPrefix(line, "//") {
+				line = strings.TrimRight("// "+line, " ")
+			}
+			buf.WriteString(line + "\n")
+		}
+		buf.WriteString("\n")
+	}
+	if len(h.Build) > 0 {
+		// A build constraint has to be followed by an empty line.
+		buf.WriteString("//go:build " + h.Build + "\n\n")
+	}
+	if len(h.Generate) > 0 {
+		buf.WriteString("//go:generate " + h.Generate + "\n\n")
+	}
+	buf.WriteString(generatedComment + "\n")
+	if len(h.Metadata) > 0 {
+		buf.WriteString("// " + h.Metadata + "\n")
+	}
+	return buf.WriteTo(w)
+}
+
 // Printer is used to print the generated code to a file.
 type Printer interface {
 	P(format string, a ...interface{})
@@ -32,18 +93,32 @@ type Printer interface {
 
 	NewImport(name, path string) Import
 	HasContent() bool
+	// SetHeader sets the comments that are written at the top of the file.
+	SetHeader(header Header)
 }
 
 type printer struct {
-	pkgName    string
-	w          *bytes.Buffer
-	indent     string
-	imports    map[string]string
+	pkgName string
+	w       *bytes.Buffer
+	indent  string
+	// imports maps each alias to its import path.
+	imports map[string]string
+	// aliases maps each import path to its alias.
+	aliases map[string]string
+	// reserved are the identifiers declared in the package, which cannot be used as aliases.
+	reserved   map[string]struct{}
 	hasContent bool
+	header     Header
 }
 
-func newPrinter(pkgName string) Printer {
-	return &printer{pkgName, bytes.NewBuffer(nil), "", make(map[string]string), false}
+func newPrinter(pkgName string, reserved map[string]struct{}) *printer {
+	return &printer{
+		pkgName:  pkgName,
+		w:        bytes.NewBuffer(nil),
+		imports:  make(map[string]string),
+		aliases:  make(map[string]string),
+		reserved: reserved,
+	}
 }
 
 func badToUnderscore(r rune) rune {
@@ -74,34 +149,73 @@ func makeFullpath(path string) string {
 	return strings.Map(badToUnderscore, path)
 }
 
-func makeAlias(path string) string {
-	// create import alias
-	fullpath := strings.Map(badToUnderscore, path)
-	fullpaths := strings.Split(fullpath, "_")
-	return fullpaths[len(fullpaths)-1]
-}
-
 func (p *printer) NewImport(name, path string) Import {
 	return func() string {
 		path = unvendor(path)
-		fullpath := makeFullpath(path)
-		alias := name
+		if alias, ok := p.aliases[path]; ok {
+			return alias
+		}
+		alias := p.newAlias(name, path)
+		p.imports[alias] = path
+		p.aliases[path] = alias
+		return alias
+	}
+}
 
-		if _, ok := p.imports[alias]; !ok {
-			p.imports[alias] = path
+// newAlias returns a short alias for the import path, that is not used yet.
+// The alias is the package name, if it is available, otherwise the package name prefixed with its parent directory,
+// for example barbaz for github.com/foo/bar/v2/baz, and otherwise the package name followed by the first available number, starting from 2.
+// Imports are added in the order that the code is generated, so the aliases are the same for every run.
+func (p *printer) newAlias(name, path string) string {
+	if len(name) == 0 {
+		name = pathpkg.Base(path)
+	}
+	name = strings.Map(badToUnderscore, name)
+	if unicode.IsDigit(rune(name[0])) {
+		name = "_" + name
+	}
+	if p.available(name) {
+		return name
+	}
+	if parent := parentDir(path); len(parent) > 0 {
+		if alias := parent + name; p.available(alias) {
 			return alias
 		}
-		if p.imports[alias] == path {
+	}
+	for i := 2; ; i++ {
+		if alias := name + strconv.Itoa(i); p.available(alias) {
 			return alias
 		}
-		if path2, ok := p.imports[fullpath]; ok {
-			if path2 != path {
-				panic("non unique fullpath: " + path2 + " != " + path)
-			}
+	}
+}
+
+func (p *printer) available(alias string) bool {
+	if _, ok := p.imports[alias]; ok {
+		return false
+	}
+	if _, ok := p.reserved[alias]; ok {
+		return false
+	}
+	return alias != "_" && !token.IsKeyword(alias) && types.Universe.Lookup(alias) == nil
+}
+
+// parentDir returns the directory above the package in the import path, without major version suffixes, like v2,
+// and with only the letters and digits, since it is used as part of an alias.
+func parentDir(path string) string {
+	elems := strings.Split(path, "/")
+	for i := len(elems) - 2; i >= 0; i-- {
+		elem := elems[i]
+		if len(elem) > 1 && elem[0] == 'v' && strings.Trim(elem[1:], "0123456789") == "" {
+			continue
 		}
-		p.imports[fullpath] = path
-		return fullpath
+		return strings.Map(func(r rune) rune {
+			if unicode.IsLetter(r) || unicode.IsDigit(r) {
+				return unicode.ToLower(r)
+			}
+			return -1
+		}, elem)
 	}
+	return ""
 }
 
 func (p *printer) P(format string, a ...interface{}) {
@@ -125,18 +239,78 @@ func (p *printer) HasContent() bool {
 	return p.hasContent
 }
 
+func (p *printer) SetHeader(header Header) {
+	p.header = header
+}
+
 func (p *printer) WriteTo(file io.Writer) (int64, error) {
+	return p.writeFile(file, p.imports, p.w.Bytes())
+}
+
+// mark returns the length of the code that has been printed, which is the start or end of a part.
+func (p *printer) mark() int {
+	return p.w.Len()
+}
+
+// discard removes the code that has been printed after the mark, for example a function that could not be generated.
+func (p *printer) discard(mark int) {
+	p.w.Truncate(mark)
+	p.indent = ""
+}
+
+// uses returns whether the code of the part uses the import with the path.
+func (p *printer) uses(part part, path string) bool {
+	alias, ok := p.aliases[path]
+	if !ok {
+		return false
+	}
+	code := p.w.Bytes()[part.start:part.end]
+	for _, loc := range identRegexp.FindAllIndex(code, -1) {
+		if string(code[loc[0]:loc[1]]) == alias && loc[1] < len(code) && code[loc[1]] == '.' {
+			return true
+		}
+	}
+	return false
+}
+
+// writeParts writes a file with the given parts of the printed code, in order, and only the imports that these parts use.
+func (p *printer) writeParts(file io.Writer, parts []part) (int64, error) {
+	code := p.w.Bytes()
+	body := bytes.NewBuffer(nil)
+	for _, part := range parts {
+		body.Write(code[part.start:part.end])
+	}
+	used := make(map[string]bool)
+	for _, ident := range identRegexp.FindAllString(body.String(), -1) {
+		used[ident] = true
+	}
+	imports := make(map[string]string, len(p.imports))
+	for qual, path := range p.imports {
+		name := qual
+		if qual == path {
+			name = pathpkg.Base(path)
+		}
+		if used[name] {
+			imports[qual] = path
+		}
+	}
+	return p.writeFile(file, imports, body.Bytes())
+}
+
+func (p *printer) writeFile(file io.Writer, imports map[string]string, body []byte) (int64, error) {
 	top := bytes.NewBuffer(nil)
 	// conform to golang standard https://golang.org/s/generatedcode
-	top.WriteString("// Code generated by goderive DO NOT EDIT.\n")
+	if _, err := p.header.WriteTo(top); err != nil {
+		return 0, err
+	}
 	top.WriteString("\n")
 	top.WriteString("package " + p.pkgName + "\n")
-	if len(p.imports) > 0 {
+	if len(imports) > 0 {
 		top.WriteString("\n")
 		top.WriteString("import (\n")
-		paths := make([]string, 0, len(p.imports))
-		pathToQual := make(map[string]string, len(p.imports))
-		for qual, path := range p.imports {
+		paths := make([]string, 0, len(imports))
+		pathToQual := make(map[string]string, len(imports))
+		for qual, path := range imports {
 			pathToQual[path] = qual
 			paths = append(paths, path)
 		}
@@ -155,6 +329,6 @@ func (p *printer) WriteTo(file io.Writer) (int64, error) {
 	if err != nil {
 		return n1, err
 	}
-	n2, err := p.w.WriteTo(file)
+	n2, err := bytes.NewReader(body).WriteTo(file)
 	return n1 + n2, err
 }
@@ -0,0 +1,49 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"sync"
+)
+
+var registry = struct {
+	mu         sync.Mutex
+	signatures map[string][]string
+}{signatures: make(map[string][]string)}
+
+// Register records the supported signatures of a plugin, which the list and explain subcommands print.
+// It is meant to be called from the init function of the package of the plugin.
+// Registering a plugin does not add it to the plugins that the goderive command generates code with,
+// these are the plugins that are passed to Main.
+// The signatures are the supported signatures of the derived function, without the prefix, for example (T, T) bool,
+// as they are written in the doc comment of the package of the plugin.
+// Register panics if a plugin with the same name has already been registered.
+func Register(newPlugin func() Plugin, signatures ...string) {
+	name := newPlugin().Name()
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	if _, ok := registry.signatures[name]; ok {
+		panic(fmt.Sprintf("derive: plugin %s is registered twice", name))
+	}
+	registry.signatures[name] = signatures
+}
+
+// signatures returns the signatures of the registered plugin with the name.
+func signatures(name string) []string {
+	registry.mu.Lock()
+	defer registry.mu.Unlock()
+	return registry.signatures[name]
+}
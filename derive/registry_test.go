@@ -0,0 +1,56 @@
+package derive_test
+
+import (
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/std"
+)
+
+// TestSignaturesAreDocumented tests that every signature, that a standard plugin registers,
+// is also on a line of the doc comment of the package of the plugin.
+func TestSignaturesAreDocumented(t *testing.T) {
+	for _, p := range std.Plugins() {
+		doc := packageDoc(t, filepath.Join("..", "plugin", p.Name()))
+		documented := make(map[string]bool)
+		for _, line := range strings.Split(doc, "\n") {
+			line = strings.TrimSpace(line)
+			if strings.HasPrefix(line, p.GetPrefix()+"(") {
+				documented[strings.TrimPrefix(line, p.GetPrefix())] = true
+			}
+		}
+		for _, sig := range derive.Signatures(p.Name()) {
+			if !documented[sig] {
+				t.Errorf("%s registers the signature %s%s, which is not in its package doc", p.Name(), p.GetPrefix(), sig)
+			}
+		}
+	}
+}
+
+// packageDoc returns the doc comment of the package in the directory.
+func packageDoc(t *testing.T, dir string) string {
+	t.Helper()
+	fset := token.NewFileSet()
+	matches, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, filename := range matches {
+		if strings.HasSuffix(filename, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filename, nil, parser.PackageClauseOnly|parser.ParseComments)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if f.Doc != nil {
+			return f.Doc.Text()
+		}
+	}
+	t.Fatalf("no package doc in %s", dir)
+	return ""
+}
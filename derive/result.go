@@ -0,0 +1,180 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// Result is the code generated for a whole program, which has not been written to disk yet.
+type Result struct {
+	// Files maps the absolute filename of each derived file to its generated content.
+	// A nil content means that the derived file is no longer needed and should be removed.
+	Files map[string][]byte
+	// Edits are the changes to source files that are proposed by the autoname and dedup options.
+	Edits []*Edit
+	// Stats are the statistics of the run that produced the result.
+	Stats Stats
+	// Graph describes why each function in the derived files was generated.
+	// Derived files that were found in the cache are not part of the graph.
+	Graph *Graph
+	// Unused are the functions in the existing derived files, that are removed, since they are no longer used,
+	// and the functions that are declared by hand with the prefix of a plugin.
+	// Unused is only set if Config.ReportUnused is true.
+	Unused []*Unused
+}
+
+// Edit is a proposed change to a source file.
+type Edit struct {
+	// Filename is the absolute filename of the source file.
+	Filename string
+	// Content is the new content of the whole source file.
+	Content []byte
+	// Renames are the function calls that were renamed in the source file.
+	Renames []Rename
+}
+
+// Rename describes a function call that was renamed.
+type Rename struct {
+	Pos     token.Position
+	OldName string
+	NewName string
+}
+
+// Write writes the derived files and the source edits to disk and removes derived files that are no longer needed.
+func (r *Result) Write() error {
+	for _, edit := range r.Edits {
+		if err := writeFile(edit.Filename, edit.Content); err != nil {
+			return err
+		}
+	}
+	for _, filename := range r.filenames() {
+		content := r.Files[filename]
+		if content == nil {
+			if err := removeFile(filename); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := writeFile(filename, content); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Diff writes a unified diff to w for every file that would be created, updated or deleted on disk by Write.
+// It returns the names of these files.
+func (r *Result) Diff(w io.Writer) ([]string, error) {
+	var changed []string
+	for _, edit := range r.Edits {
+		ok, err := diffFile(w, edit.Filename, edit.Content)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			changed = append(changed, edit.Filename)
+		}
+	}
+	for _, filename := range r.filenames() {
+		ok, err := diffFile(w, filename, r.Files[filename])
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			changed = append(changed, filename)
+		}
+	}
+	return changed, nil
+}
+
+func (r *Result) filenames() []string {
+	filenames := make([]string, 0, len(r.Files))
+	for filename := range r.Files {
+		filenames = append(filenames, filename)
+	}
+	sort.Strings(filenames)
+	return filenames
+}
+
+// diffFile writes a unified diff between the file on disk and the content to w.
+// A nil content represents a removed file.
+// It returns whether the file is different.
+func diffFile(w io.Writer, filename string, content []byte) (bool, error) {
+	old, err := os.ReadFile(filename)
+	exists := err == nil
+	if err != nil && !os.IsNotExist(err) {
+		return false, err
+	}
+	name := relativeName(filename)
+	switch {
+	case !exists && content == nil:
+		return false, nil
+	case !exists:
+		fmt.Fprintf(w, "would create %s\n", name)
+		return true, unifiedDiff(w, "/dev/null", "b/"+name, nil, content)
+	case content == nil:
+		fmt.Fprintf(w, "would delete %s\n", name)
+		return true, unifiedDiff(w, "a/"+name, "/dev/null", old, nil)
+	case string(old) != string(content):
+		fmt.Fprintf(w, "would update %s\n", name)
+		return true, unifiedDiff(w, "a/"+name, "b/"+name, old, content)
+	}
+	return false, nil
+}
+
+// relativeName returns the filename relative to the working directory, if possible.
+func relativeName(filename string) string {
+	wd, err := os.Getwd()
+	if err != nil {
+		return filename
+	}
+	rel, err := filepath.Rel(wd, filename)
+	if err != nil {
+		return filename
+	}
+	return filepath.ToSlash(rel)
+}
+
+func writeFile(filename string, content []byte) error {
+	perm := os.FileMode(0666)
+	if info, err := os.Stat(filename); err == nil {
+		perm = info.Mode()
+	}
+	// The directory of a shared package could be new.
+	if err := os.MkdirAll(filepath.Dir(filename), 0777); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filename, content, perm); err != nil {
+		return fmt.Errorf("writing %s: %v", filename, err)
+	}
+	return nil
+}
+
+func removeFile(filename string) error {
+	_, err := os.Stat(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("stat %s: %v", filename, err)
+	}
+	return os.Remove(filename)
+}
@@ -0,0 +1,496 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	pathpkg "path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// sharedFilename is the name of the file, that only exists in the overlay, with the calls to the functions
+// that the derived files of the module require from the shared package.
+const sharedFilename = "goderive_shared.go"
+
+// sharedPackage is a package inside the module, where the functions for types from the standard library and other modules are generated,
+// so that the packages of the module do not each generate their own copy of the same function.
+// The derived file of a package calls the exported function in the shared package, from a function with the usual name.
+type sharedPackage struct {
+	// path is the import path of the shared package.
+	path string
+	// dir is the absolute directory of the shared package.
+	dir string
+	// name is the package name of the shared package.
+	name string
+	// module is the path of the module.
+	module string
+	// moduleDir is the root directory of the module.
+	moduleDir string
+	// settings are the settings of the shared package, where the prefixes of the plugins are exported.
+	settings *settings
+}
+
+// newSharedPackage returns the shared package of the module of the package, or nil if there is no shared package.
+func newSharedPackage(pkgInfo *packages.Package, s *settings) *sharedPackage {
+	if len(s.shared) == 0 || pkgInfo.Module == nil || len(pkgInfo.Module.Path) == 0 || len(pkgInfo.Module.Dir) == 0 {
+		return nil
+	}
+	path := pathpkg.Join(pkgInfo.Module.Path, s.shared)
+	name := strings.Map(badToUnderscore, pathpkg.Base(path))
+	if name[0] >= '0' && name[0] <= '9' {
+		name = "_" + name
+	}
+	shared := &settings{output: s.output, header: s.header, metadata: s.metadata, safe: s.safe, export: s.export, options: s.options}
+	for _, p := range s.plugins {
+		if prefix := p.GetPrefix(); len(prefix) > 0 {
+			shared.plugins = append(shared.plugins, &configuredPlugin{p, exported(prefix)})
+		}
+	}
+	sortPlugins(shared.plugins)
+	return &sharedPackage{
+		path:      path,
+		dir:       filepath.Join(pkgInfo.Module.Dir, filepath.FromSlash(s.shared)),
+		name:      name,
+		module:    pkgInfo.Module.Path,
+		moduleDir: pkgInfo.Module.Dir,
+		settings:  shared,
+	}
+}
+
+// sharedFunc is a function in the shared package, that is required by a derived file.
+type sharedFunc struct {
+	plugin string
+	name   string
+	// args are the types of the arguments, as they are written in the shared package.
+	args []string
+	// imports are the aliases of the packages, that the arguments refer to, by import path.
+	imports map[string]string
+}
+
+func (f *sharedFunc) String() string {
+	return f.name + "(" + strings.Join(f.args, ", ") + ")"
+}
+
+// sharedFunc returns the function in the shared package for the argument types of a call,
+// if the types refer to at least one named type and all named types are exported types from packages outside of the module.
+// Packages inside the module could import the shared package, which would be an import cycle.
+func (sp *sharedPackage) sharedFunc(plugin Plugin, typs []types.Type) (*sharedFunc, bool) {
+	prefix := plugin.GetPrefix()
+	if len(prefix) == 0 {
+		return nil, false
+	}
+	named := 0
+	for _, typ := range typs {
+		if !sp.importable(typ, &named) {
+			return nil, false
+		}
+	}
+	if named == 0 {
+		return nil, false
+	}
+	f := &sharedFunc{plugin: plugin.Name(), imports: make(map[string]string)}
+	qual := func(p *types.Package) string {
+		alias := makeFullpath(p.Path())
+		f.imports[p.Path()] = alias
+		return alias
+	}
+	for _, typ := range typs {
+		f.args = append(f.args, types.TypeString(typ, qual))
+	}
+	key, _ := typesKey(typs, packageName)
+	f.name = exported(prefix) + "_" + key
+	return f, true
+}
+
+// importable returns whether the type can be written in the shared package and counts the named types.
+func (sp *sharedPackage) importable(typ types.Type, named *int) bool {
+	switch t := typ.(type) {
+	case *types.Basic:
+		return t.Kind() != types.Invalid && t.Info()&types.IsUntyped == 0
+	case *types.Named:
+		if !sp.importableObj(t.Obj(), named) {
+			return false
+		}
+		args := t.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			if !sp.importable(args.At(i), named) {
+				return false
+			}
+		}
+		return true
+	case *types.Alias:
+		return sp.importableObj(t.Obj(), named)
+	case *types.Pointer:
+		return sp.importable(t.Elem(), named)
+	case *types.Slice:
+		return sp.importable(t.Elem(), named)
+	case *types.Array:
+		return sp.importable(t.Elem(), named)
+	case *types.Chan:
+		return sp.importable(t.Elem(), named)
+	case *types.Map:
+		return sp.importable(t.Key(), named) && sp.importable(t.Elem(), named)
+	case *types.Signature:
+		if t.TypeParams().Len() > 0 {
+			return false
+		}
+		for _, tuple := range []*types.Tuple{t.Params(), t.Results()} {
+			for i := 0; i < tuple.Len(); i++ {
+				if !sp.importable(tuple.At(i).Type(), named) {
+					return false
+				}
+			}
+		}
+		return true
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if !t.Field(i).Exported() || !sp.importable(t.Field(i).Type(), named) {
+				return false
+			}
+		}
+		return true
+	case *types.Interface:
+		for i := 0; i < t.NumExplicitMethods(); i++ {
+			if !t.ExplicitMethod(i).Exported() || !sp.importable(t.ExplicitMethod(i).Type(), named) {
+				return false
+			}
+		}
+		for i := 0; i < t.NumEmbeddeds(); i++ {
+			if !sp.importable(t.EmbeddedType(i), named) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (sp *sharedPackage) importableObj(obj *types.TypeName, named *int) bool {
+	pkg := obj.Pkg()
+	if pkg == nil {
+		// the error type
+		return true
+	}
+	path := pkg.Path()
+	if !obj.Exported() || pkg.Name() == "main" || path == sp.module || strings.HasPrefix(path, sp.module+"/") {
+		return false
+	}
+	// Internal packages of other modules cannot be imported.
+	if strings.Contains("/"+path+"/", "/internal/") {
+		return false
+	}
+	*named++
+	return true
+}
+
+// typesKey returns a name for the argument types of a function, where arguments of the same type are only named once,
+// for example time_Time for DeriveEqual_time_Time, and whether the name is readable, see typeKey.
+func typesKey(typs []types.Type, qual types.Qualifier) (string, bool) {
+	var keys []string
+	readable := len(typs) > 0
+	for _, typ := range typs {
+		key, ok := typeKey(types.Default(typ), qual)
+		readable = readable && ok
+		if len(keys) == 0 || keys[len(keys)-1] != key {
+			keys = append(keys, key)
+		}
+	}
+	return strings.Join(keys, "_"), readable
+}
+
+// exported returns the prefix of a plugin, starting with an upper case letter, for example DeriveEqual.
+func exported(prefix string) string {
+	return strings.ToUpper(prefix[:1]) + prefix[1:]
+}
+
+// typeKey returns a name for the type, that is used as part of the name of a derived function,
+// and whether the name is readable, which is not the case for types, like func and struct types, that are spelled out with underscores.
+// The qualifier returns the name that is written in front of the types of a package, where an empty name is left out.
+func typeKey(typ types.Type, qual types.Qualifier) (string, bool) {
+	switch t := typ.(type) {
+	case *types.Named:
+		name := qualifiedName(t.Obj(), qual)
+		readable := true
+		args := t.TypeArgs()
+		for i := 0; i < args.Len(); i++ {
+			key, ok := typeKey(args.At(i), qual)
+			name += "_" + key
+			readable = readable && ok
+		}
+		return name, readable
+	case *types.Alias:
+		return qualifiedName(t.Obj(), qual), true
+	case *types.Basic:
+		return t.Name(), true
+	case *types.Pointer:
+		key, ok := typeKey(t.Elem(), qual)
+		return "PtrTo_" + key, ok
+	case *types.Slice:
+		key, ok := typeKey(t.Elem(), qual)
+		return "SliceOf_" + key, ok
+	case *types.Array:
+		key, ok := typeKey(t.Elem(), qual)
+		return "ArrayOf" + strconv.FormatInt(t.Len(), 10) + "_" + key, ok
+	case *types.Map:
+		key, kok := typeKey(t.Key(), qual)
+		elem, eok := typeKey(t.Elem(), qual)
+		return "MapOf_" + key + "_To_" + elem, kok && eok
+	}
+	return strings.Map(badToUnderscore, types.TypeString(typ, qual)), false
+}
+
+func qualifiedName(obj *types.TypeName, qual types.Qualifier) string {
+	if obj.Pkg() == nil {
+		return obj.Name()
+	}
+	if q := qual(obj.Pkg()); len(q) > 0 {
+		return q + "_" + obj.Name()
+	}
+	return obj.Name()
+}
+
+// packageName qualifies the types in the names of functions in the shared package with the name of their package.
+func packageName(p *types.Package) string {
+	return p.Name()
+}
+
+// share replaces the generated code of a function with a call to the function in the shared package, if the function can be shared.
+// The generated code stays in the printer, but is no longer part of the derived file,
+// so the functions that only the generated code required are left out as well.
+func (pkg *pkg) share(p *part, plugin Plugin, typs []types.Type) {
+	if pkg.shared == nil {
+		return
+	}
+	f, ok := pkg.shared.sharedFunc(plugin, typs)
+	if !ok {
+		return
+	}
+	alias := pkg.printer.NewImport(pkg.shared.name, pkg.shared.path)()
+	code, ok := wrap(pkg.printer.w.Bytes()[p.start:p.end], p.name, alias+"."+f.name)
+	if !ok {
+		return
+	}
+	p.start = pkg.printer.mark()
+	pkg.printer.w.Write(code)
+	p.end = pkg.printer.mark()
+	p.shared = f
+}
+
+// wrap returns the code of the function with a body that only calls the shared function.
+// The doc comment and signature of the function are kept.
+// Functions with type parameters or parameters without a name cannot be wrapped.
+func wrap(code []byte, name string, shared string) ([]byte, bool) {
+	const header = "package p\n"
+	src := append([]byte(header), code...)
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments|parser.SkipObjectResolution)
+	if err != nil {
+		return nil, false
+	}
+	for _, decl := range file.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name.Name != name || fn.Body == nil {
+			continue
+		}
+		if fn.Type.TypeParams != nil {
+			return nil, false
+		}
+		var args []string
+		for _, field := range fn.Type.Params.List {
+			if len(field.Names) == 0 {
+				return nil, false
+			}
+			for _, n := range field.Names {
+				if n.Name == "_" {
+					return nil, false
+				}
+				arg := n.Name
+				if _, ok := field.Type.(*ast.Ellipsis); ok {
+					arg += "..."
+				}
+				args = append(args, arg)
+			}
+		}
+		call := shared + "(" + strings.Join(args, ", ") + ")"
+		if fn.Type.Results != nil && len(fn.Type.Results.List) > 0 {
+			call = "return " + call
+		}
+		lbrace := fset.Position(fn.Body.Lbrace).Offset
+		rbrace := fset.Position(fn.Body.Rbrace).Offset
+		w := bytes.NewBuffer(nil)
+		w.Write(src[len(header) : lbrace+1])
+		w.WriteString("\n\t" + call + "\n}")
+		w.Write(src[rbrace+1:])
+		return w.Bytes(), true
+	}
+	return nil, false
+}
+
+// sharedFuncs are the functions that the derived files require from a shared package.
+type sharedFuncs struct {
+	pkg   *sharedPackage
+	funcs map[string]*sharedFunc
+}
+
+// add adds the function, unless a function with the same name is already required for other types.
+func (s *sharedFuncs) add(f *sharedFunc) error {
+	if other, ok := s.funcs[f.name]; ok {
+		if strings.Join(other.args, ", ") != strings.Join(f.args, ", ") {
+			return fmt.Errorf("shared functions %s and %s have the same name in %s", other, f, s.pkg.path)
+		}
+		return nil
+	}
+	s.funcs[f.name] = f
+	return nil
+}
+
+// existingSharedFuncs returns the functions in the existing derived file of the shared package, that were required by derived files,
+// so that these are not removed when only a part of the module is generated.
+// These are the functions, with the name that the shared package gives to the types of their parameters,
+// while the functions that these functions require have other names.
+func (sp *sharedPackage) existingSharedFuncs(pkgInfo *packages.Package) []*sharedFunc {
+	var shared []*sharedFunc
+	for _, file := range pkgInfo.Syntax {
+		tokFile := pkgInfo.Fset.File(file.FileStart)
+		if tokFile == nil || filepath.Base(tokFile.Name()) != sp.settings.output {
+			continue
+		}
+		for _, fn := range funcs(file) {
+			obj, ok := pkgInfo.TypesInfo.Defs[fn.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+			sig := obj.Signature()
+			if sig.Variadic() {
+				// The number of arguments of the original call is not known.
+				continue
+			}
+			typs := make([]types.Type, sig.Params().Len())
+			for i := range typs {
+				typs[i] = sig.Params().At(i).Type()
+			}
+			for _, plugin := range sp.settings.plugins {
+				if !strings.HasPrefix(fn.Name.Name, plugin.GetPrefix()) {
+					continue
+				}
+				if f, ok := sp.sharedFunc(plugin, typs); ok && f.name == fn.Name.Name {
+					shared = append(shared, f)
+				}
+				break
+			}
+		}
+	}
+	return shared
+}
+
+// requests returns the source of a file in the shared package, that calls each function with zero values of its argument types.
+func (s *sharedFuncs) requests() []byte {
+	names := make([]string, 0, len(s.funcs))
+	aliases := make(map[string]string)
+	for name, f := range s.funcs {
+		names = append(names, name)
+		for path, alias := range f.imports {
+			aliases[alias] = path
+		}
+	}
+	sort.Strings(names)
+	w := bytes.NewBuffer(nil)
+	fmt.Fprintf(w, "package %s\n\n", s.pkg.name)
+	if len(aliases) > 0 {
+		sorted := make([]string, 0, len(aliases))
+		for alias := range aliases {
+			sorted = append(sorted, alias)
+		}
+		sort.Strings(sorted)
+		fmt.Fprintf(w, "import (\n")
+		for _, alias := range sorted {
+			fmt.Fprintf(w, "\t%s %q\n", alias, aliases[alias])
+		}
+		fmt.Fprintf(w, ")\n\n")
+	}
+	fmt.Fprintf(w, "func _() {\n")
+	for _, name := range names {
+		f := s.funcs[name]
+		args := make([]string, len(f.args))
+		for i, arg := range f.args {
+			args[i] = "*new(" + arg + ")"
+		}
+		fmt.Fprintf(w, "\t%s(%s)\n", name, strings.Join(args, ", "))
+	}
+	fmt.Fprintf(w, "}\n")
+	return w.Bytes()
+}
+
+// generateShared generates the derived file of each shared package, with the functions that the derived files of the module require.
+// The functions that are already in the derived file of a shared package are kept,
+// so that generating only a part of the module does not remove functions that other packages require.
+// To remove the functions that are no longer required, remove the derived file of the shared package and generate the whole module.
+func (pg *program) generateShared(files *files, logger *log.Logger) (Diagnostics, error) {
+	var diagnostics Diagnostics
+	shared, err := files.Shared()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range shared {
+		if files.exists(filepath.Join(s.pkg.dir, s.pkg.settings.output)) {
+			pkgInfo, err := pg.loadShared(s.pkg, files)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range s.pkg.existingSharedFuncs(pkgInfo) {
+				if _, ok := s.funcs[f.name]; !ok {
+					s.funcs[f.name] = f
+				}
+			}
+		}
+		files.AddOverlay(filepath.Join(s.pkg.dir, sharedFilename), s.requests())
+		pkgInfo, err := pg.loadShared(s.pkg, files)
+		if err != nil {
+			return nil, err
+		}
+		ds, err := pg.generatePackage(pkgInfo, s.pkg.settings, files, newReloader(pg.tags), logger)
+		if err != nil {
+			return nil, err
+		}
+		diagnostics = append(diagnostics, ds...)
+	}
+	return diagnostics, nil
+}
+
+// loadShared loads the shared package, which could only exist in the overlay.
+func (pg *program) loadShared(sp *sharedPackage, files *files) (*packages.Package, error) {
+	conf := &packages.Config{Dir: sp.moduleDir}
+	pkgs, err := loadConfig(conf, pg.tags, files.Overlay(), sp.path)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range pkgs {
+		if p.PkgPath == sp.path && len(p.ForTest) == 0 {
+			return p, nil
+		}
+	}
+	return nil, fmt.Errorf("could not load shared package %s", sp.path)
+}
@@ -0,0 +1,152 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// outputs are the filenames of the derived files in a directory.
+// Functions that are only called from test files are generated in a test file,
+// so that they are not compiled into the package when it is not tested.
+// External test packages get their own test file, since it has a different package name.
+type outputs struct {
+	// main is the derived file of the package, for example derived.gen.go.
+	main string
+	// test is the derived file for the in package tests, for example derived.gen_test.go.
+	test string
+	// xtest is the derived file for the external test package, for example derived.gen_xtest_test.go.
+	xtest string
+}
+
+func newOutputs(output string) outputs {
+	base := strings.TrimSuffix(output, ".go")
+	return outputs{
+		main:  output,
+		test:  base + "_test.go",
+		xtest: base + "_xtest_test.go",
+	}
+}
+
+// all returns the filenames of all the derived files.
+func (o outputs) all() []string {
+	return []string{o.main, o.test, o.xtest}
+}
+
+// contains returns whether the filename is one of the derived files.
+func (o outputs) contains(filename string) bool {
+	base := filepath.Base(filename)
+	return base == o.main || base == o.test || base == o.xtest
+}
+
+func isTestFile(filename string) bool {
+	return strings.HasSuffix(filename, "_test.go")
+}
+
+// part is the generated code of a single function or method, which is a range in the printer's buffer.
+type part struct {
+	// name is the name of the function, or empty for a method.
+	name string
+	// test is true for a method of a type that is declared in a test file.
+	test       bool
+	start, end int
+	// shared is the function in the shared package that the function calls, if the function is shared.
+	shared *sharedFunc
+}
+
+var identRegexp = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// reachable returns the parts that are reachable from a call in the source code or from a method.
+// Functions are only unreachable, when they were required by the generated code of a function that was replaced by a call to a shared package.
+func reachable(code []byte, parts []part, g *graph) []part {
+	return filter(parts, closure(code, parts, func(p part) bool {
+		if len(p.name) == 0 {
+			return true
+		}
+		f, ok := g.funcs[p.name]
+		return !ok || len(f.calls) > 0
+	}), true)
+}
+
+// splitTests splits the generated functions and methods into the parts for the package and the parts for the tests.
+// The package contains the functions that are called from files that are not test files,
+// the methods of types that are not declared in test files and all the functions that are referenced by these,
+// so that the package compiles without the tests.
+// All other functions are only used by tests.
+func splitTests(code []byte, parts []part, g *graph, fset *token.FileSet) (main, test []part) {
+	inMain := closure(code, parts, func(p part) bool {
+		if len(p.name) == 0 {
+			return !p.test
+		}
+		f, ok := g.funcs[p.name]
+		if !ok {
+			// Functions that are unknown to the graph are kept in the package, to be safe.
+			return true
+		}
+		for _, pos := range f.calls {
+			if !isTestFile(fset.Position(pos).Filename) {
+				return true
+			}
+		}
+		return false
+	})
+	return filter(parts, inMain, true), filter(parts, inMain, false)
+}
+
+// closure returns which parts are roots or are referenced by the code of another part in the closure.
+func closure(code []byte, parts []part, root func(p part) bool) []bool {
+	byName := make(map[string]int, len(parts))
+	for i, p := range parts {
+		if len(p.name) > 0 {
+			byName[p.name] = i
+		}
+	}
+	in := make([]bool, len(parts))
+	var queue []int
+	add := func(i int) {
+		if !in[i] {
+			in[i] = true
+			queue = append(queue, i)
+		}
+	}
+	for i, p := range parts {
+		if root(p) {
+			add(i)
+		}
+	}
+	for len(queue) > 0 {
+		p := parts[queue[0]]
+		queue = queue[1:]
+		for _, ident := range identRegexp.FindAllString(string(code[p.start:p.end]), -1) {
+			if i, ok := byName[ident]; ok {
+				add(i)
+			}
+		}
+	}
+	return in
+}
+
+func filter(parts []part, in []bool, want bool) []part {
+	var filtered []part
+	for i, p := range parts {
+		if in[i] == want {
+			filtered = append(filtered, p)
+		}
+	}
+	return filtered
+}
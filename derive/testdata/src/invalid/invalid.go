@@ -0,0 +1,9 @@
+package invalid
+
+type T struct {
+	A int
+}
+
+func filter(t *T) *T {
+	return deriveFilter(func(t *T) bool { return t.A > 0 }, t) // want "filter: deriveFilter, the second argument, \\*T, is not of type slice"
+}
@@ -0,0 +1,9 @@
+package missing
+
+type T struct {
+	A int
+}
+
+func (this *T) Equal(that *T) bool {
+	return deriveEqual(this, that) // want "equal: deriveEqual has not been generated yet, please run goderive"
+}
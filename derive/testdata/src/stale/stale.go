@@ -0,0 +1,11 @@
+package stale
+
+type T struct {
+	A int
+	B string
+	C []byte
+}
+
+func (this *T) Equal(that *T) bool {
+	return deriveEqual(this, that)
+}
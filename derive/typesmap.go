@@ -16,10 +16,13 @@ package derive
 
 import (
 	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"go/format"
 	"go/types"
 	"strconv"
+	"strings"
 )
 
 // TypesMap is a map of input types to function names.
@@ -48,11 +51,14 @@ type typesMap struct {
 	funcToTyps map[string][]types.Type
 	typss      [][]types.Type
 	reserved   map[string]struct{}
+	ambiguous  map[string]bool
 	autoname   bool
 	dedup      bool
+	plugin     string
+	graph      *graph
 }
 
-func newTypesMap(qual types.Qualifier, prefix string, reserved map[string]struct{}, autoname bool, dedup bool) TypesMap {
+func newTypesMap(qual types.Qualifier, prefix string, reserved map[string]struct{}, ambiguous map[string]bool, autoname bool, dedup bool, plugin string, g *graph) TypesMap {
 	return &typesMap{
 		qual:       qual,
 		prefix:     prefix,
@@ -60,8 +66,11 @@ func newTypesMap(qual types.Qualifier, prefix string, reserved map[string]struct
 		funcToTyps: make(map[string][]types.Type),
 		typss:      nil,
 		reserved:   reserved,
+		ambiguous:  ambiguous,
 		autoname:   autoname,
 		dedup:      dedup,
+		plugin:     plugin,
+		graph:      g,
 	}
 }
 
@@ -128,39 +137,82 @@ func (tm *typesMap) GetFuncName(typs ...types.Type) string {
 		tm.SetFuncName(name, typs...)
 	}
 	// log.Printf("GotFuncName: %s(%v)", name, typs)
+	tm.graph.use(tm.plugin, name)
 	return name
 }
 
+// newName returns a name for a function with the argument types, which does not depend on the order in which functions are named,
+// so that adding a call does not rename existing functions and rewrite their call sites.
+// The name is made of the prefix and the argument types, for example deriveEqual_PtrTo_User,
+// or a short hash of the argument types, for types without a readable name, like func and struct types.
 func (tm *typesMap) newName(typs []types.Type) string {
-	name := ""
-	if len(typs) > 0 {
-		switch t := typs[0].(type) {
-		case *types.Named:
-			name = t.Obj().Name()
-		case *types.Basic:
-			switch t.Kind() {
-			case types.Bool, types.Int, types.Int8, types.Int16, types.Int32, types.Int64,
-				types.Uint, types.Uint8, types.Uint16, types.Uint32, types.Uint64,
-				types.Float32, types.Float64, types.String:
-				name = tm.TypeString(t)
-			}
+	// Types from the package itself are not qualified, while the names of other packages are used instead of their import aliases,
+	// since aliases change when other imports are added.
+	ambiguous := false
+	qual := func(p *types.Package) string {
+		if len(tm.qual(p)) == 0 {
+			return ""
 		}
+		ambiguous = ambiguous || tm.ambiguous[p.Name()]
+		return p.Name()
 	}
-	i := 0
-	funcName := tm.prefix
+	key, readable := typesKey(typs, qual)
+	hash := typesHash(typs)
+	funcName := tm.prefix + "_" + hash
+	if readable {
+		funcName = tm.prefix + "_" + key
+		// Types with the same name in packages with the same name, would have the same name,
+		// so the hash is added to the names of all of them, whichever is named first.
+		if ambiguous {
+			funcName += "_" + hash
+		}
+	}
+	name := funcName
+	for i := 1; tm.taken(name); i++ {
+		name = funcName + "_" + strconv.Itoa(i)
+	}
+	return name
+}
+
+// taken returns whether the function name is already used for other types or is reserved.
+func (tm *typesMap) taken(funcName string) bool {
 	_, exists := tm.funcToTyps[funcName]
-	_, isreserved := tm.reserved[funcName]
-	for exists || isreserved {
-		if i > len(name) {
-			funcName = tm.prefix + "_" + name + strconv.Itoa(i)
-		} else {
-			funcName = tm.prefix + "_" + name[:i]
+	_, reserved := tm.reserved[funcName]
+	return exists || reserved
+}
+
+// ambiguousNames returns the names of the packages, that are shared by more than one package,
+// which is imported by the package, directly or indirectly.
+func ambiguousNames(pkg *types.Package) map[string]bool {
+	paths := make(map[string]string)
+	ambiguous := make(map[string]bool)
+	seen := make(map[*types.Package]bool)
+	var visit func(p *types.Package)
+	visit = func(p *types.Package) {
+		if seen[p] {
+			return
+		}
+		seen[p] = true
+		if path, ok := paths[p.Name()]; ok && path != p.Path() {
+			ambiguous[p.Name()] = true
+		}
+		paths[p.Name()] = p.Path()
+		for _, imp := range p.Imports() {
+			visit(imp)
 		}
-		i++
-		_, exists = tm.funcToTyps[funcName]
-		_, isreserved = tm.reserved[funcName]
 	}
-	return funcName
+	visit(pkg)
+	return ambiguous
+}
+
+// typesHash returns a short hash of the argument types, including the full paths of their packages.
+func typesHash(typs []types.Type) string {
+	ss := make([]string, len(typs))
+	for i, typ := range typs {
+		ss[i] = types.TypeString(types.Default(typ), nil)
+	}
+	sum := sha256.Sum256([]byte(strings.Join(ss, ", ")))
+	return hex.EncodeToString(sum[:4])
 }
 
 func eq(this, that []types.Type) bool {
@@ -177,12 +229,7 @@ func eq(this, that []types.Type) bool {
 
 func (tm *typesMap) nameOf(typs []types.Type) (string, bool) {
 	for _, t := range typs {
-		if n, ok := t.(*types.Named); ok {
-			pkg := n.Obj().Pkg()
-			if pkg != nil {
-				tm.qual(pkg)
-			}
-		}
+		tm.qualify(t)
 	}
 	for name, ts := range tm.funcToTyps {
 		if eq(typs, ts) {
@@ -192,6 +239,22 @@ func (tm *typesMap) nameOf(typs []types.Type) (string, bool) {
 	return "", false
 }
 
+// qualify makes sure that the packages of a named type and its type arguments are known to the qualifier.
+func (tm *typesMap) qualify(typ types.Type) {
+	n, ok := typ.(*types.Named)
+	if !ok {
+		return
+	}
+	pkg := n.Obj().Pkg()
+	if pkg != nil {
+		tm.qual(pkg)
+	}
+	args := n.TypeArgs()
+	for i := 0; i < args.Len(); i++ {
+		tm.qualify(args.At(i))
+	}
+}
+
 func (tm *typesMap) Generating(typs ...types.Type) {
 	name, ok := tm.nameOf(typs)
 	if !ok {
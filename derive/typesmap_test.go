@@ -0,0 +1,106 @@
+package derive_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/equal"
+)
+
+const namesSource = `package names
+
+import (
+	xv1 "names/x/v1"
+)
+
+type T struct {
+	A int
+}
+
+func equalT(this, that *T) bool {
+	return deriveEqual(this, that)
+}
+
+func equalX(this, that *xv1.T) bool {
+	return deriveEqual(this, that)
+}
+`
+
+const namesCall = `package names
+
+import (
+	yv1 "names/y/v1"
+)
+
+func equalY(this, that *yv1.T) bool {
+	return deriveEqual(this, that)
+}
+`
+
+// TestNamesAreStable tests that adding a call does not rename the functions of existing calls,
+// even if the types of the new call have the same name as the types of an existing call.
+func TestNamesAreStable(t *testing.T) {
+	dir := t.TempDir()
+	writeFile(t, filepath.Join(dir, "go.mod"), "module names\n\ngo 1.21\n")
+	for _, sub := range []string{"x", "y"} {
+		if err := os.MkdirAll(filepath.Join(dir, sub, "v1"), 0777); err != nil {
+			t.Fatal(err)
+		}
+		writeFile(t, filepath.Join(dir, sub, "v1", "v1.go"), "package v1\n\ntype T struct {\n\tA int\n}\n")
+	}
+	writeFile(t, filepath.Join(dir, "names.go"), namesSource)
+	t.Chdir(dir)
+	config := derive.Config{Plugins: []derive.Plugin{equal.NewPlugin()}, Autoname: true}
+
+	first := generate(t, config)
+	xName := renamed(t, first, "func equalX(this, that *xv1.T) bool {")
+	if !strings.HasPrefix(xName, "deriveEqual_PtrTo_v1_T") {
+		t.Fatalf("want deriveEqual_PtrTo_v1_T, but got %s", xName)
+	}
+
+	writeFile(t, filepath.Join(dir, "call.go"), namesCall)
+	second := generate(t, config)
+	if len(second.Edits) != 1 || filepath.Base(second.Edits[0].Filename) != "call.go" {
+		t.Fatalf("want only call.go to be edited, but got %v", second.Edits)
+	}
+	yName := renamed(t, second, "func equalY(this, that *yv1.T) bool {")
+	if !strings.HasPrefix(yName, "deriveEqual_PtrTo_v1_T_") {
+		t.Fatalf("want deriveEqual_PtrTo_v1_T with a hash, but got %s", yName)
+	}
+	content := readFile(t, filepath.Join(dir, "derived.gen.go"))
+	for _, name := range []string{"deriveEqual", xName, yName} {
+		if !strings.Contains(content, "func "+name+"(") {
+			t.Errorf("want %s in derived.gen.go:\n%s", name, content)
+		}
+	}
+}
+
+// generate generates the package in the working directory and writes the result.
+func generate(t *testing.T, config derive.Config) *derive.Result {
+	t.Helper()
+	result, err := derive.Generate(config, ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := result.Write(); err != nil {
+		t.Fatal(err)
+	}
+	return result
+}
+
+// renamed returns the new name of the call in the function, that was renamed by autoname.
+func renamed(t *testing.T, result *derive.Result, funcDecl string) string {
+	t.Helper()
+	for _, edit := range result.Edits {
+		for _, rename := range edit.Renames {
+			if strings.Contains(string(edit.Content), funcDecl+"\n\treturn "+rename.NewName+"(this, that)") {
+				return rename.NewName
+			}
+		}
+	}
+	t.Fatalf("want the call in %s to be renamed, but got %v", funcDecl, result.Edits)
+	return ""
+}
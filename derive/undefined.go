@@ -0,0 +1,166 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/types"
+	"strings"
+)
+
+// stuck explains why the argument types of the undefined calls, that were left after the last reload, could not be inferred.
+type stuck struct {
+	pkg *pkg
+	// calls are the undefined calls by the identifier of the called function.
+	calls map[*ast.Ident]*call
+	// enclosing maps the identifier of a function, that is used as a value,
+	// to the undefined call that the function is passed to, which determines the expected function type.
+	enclosing map[*ast.Ident]*call
+}
+
+func newStuck(pkg *pkg) *stuck {
+	s := &stuck{
+		pkg:       pkg,
+		calls:     make(map[*ast.Ident]*call, len(pkg.undefined)),
+		enclosing: make(map[*ast.Ident]*call),
+	}
+	for _, c := range pkg.undefined {
+		s.calls[c.Ident] = c
+	}
+	for _, c := range pkg.undefined {
+		for _, arg := range c.argExprs() {
+			if ident, ok := arg.(*ast.Ident); ok {
+				if v, ok := s.calls[ident]; ok && v.Value {
+					s.enclosing[ident] = c
+				}
+			}
+		}
+	}
+	return s
+}
+
+// explain returns a report of the call, with the arguments that have an invalid type,
+// and for each of these arguments, the chain of undefined calls that the argument depends on.
+// A cycle in this chain is reported explicitly, since such calls can never be generated.
+func (s *stuck) explain(c *call) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "cannot generate: %s", types.ExprString(c.Expr))
+	s.write(buf, c, nil, "\n\t")
+	return buf.String()
+}
+
+func (s *stuck) write(buf *bytes.Buffer, c *call, path []*call, indent string) {
+	path = append(path, c)
+	if c.Value {
+		if c.Args != nil {
+			fmt.Fprintf(buf, "%s%s is used as a value, but the expected function type has invalid parameters", indent, c.Name)
+			return
+		}
+		outer, ok := s.enclosing[c.Ident]
+		if !ok {
+			fmt.Fprintf(buf, "%s%s is used as a value, but the expected function type is unknown", indent, c.Name)
+			return
+		}
+		fmt.Fprintf(buf, "%s%s is used as a value, so its type depends on the parameters of %s at %s", indent, c.Name, outer.Name, s.position(outer))
+		s.follow(buf, outer, path, indent+"\t")
+		return
+	}
+	for i, arg := range c.argExprs() {
+		if i >= len(c.Args) || !isInvalid(c.Args[i]) {
+			continue
+		}
+		fmt.Fprintf(buf, "%sargument %d, %s, has an invalid type", indent, i+1, types.ExprString(arg))
+		for _, ident := range s.undefinedIdents(arg) {
+			if d, ok := s.calls[ident]; ok {
+				fmt.Fprintf(buf, "%s\tdepends on %s at %s", indent, d.Name, s.position(d))
+				s.follow(buf, d, path, indent+"\t\t")
+				continue
+			}
+			if len(s.pkg.pluginName(ident.Name)) == 0 {
+				fmt.Fprintf(buf, "%s\t%s is not defined and does not start with the prefix of any plugin", indent, ident.Name)
+				continue
+			}
+			fmt.Fprintf(buf, "%s\t%s is not defined", indent, ident.Name)
+		}
+	}
+}
+
+// follow writes the report of the call d, that is required by the last call in the path, unless d is already in the path.
+func (s *stuck) follow(buf *bytes.Buffer, d *call, path []*call, indent string) {
+	for i, c := range path {
+		if c != d {
+			continue
+		}
+		names := make([]string, 0, len(path)-i+1)
+		for _, p := range path[i:] {
+			names = append(names, p.Name)
+		}
+		names = append(names, d.Name)
+		fmt.Fprintf(buf, "%scycle: %s", indent, strings.Join(names, " -> "))
+		return
+	}
+	s.write(buf, d, path, indent)
+}
+
+func (s *stuck) position(c *call) string {
+	return s.pkg.info.Fset.Position(c.Expr.Pos()).String()
+}
+
+// argExprs returns the argument expressions of the call, or nothing for a function that is used as a value.
+func (c *call) argExprs() []ast.Expr {
+	if callExpr, ok := c.Expr.(*ast.CallExpr); ok {
+		return callExpr.Args
+	}
+	return nil
+}
+
+// undefinedIdents returns the identifiers in the expression, that do not refer to any declaration,
+// except for the selectors of fields and methods and the keys of composite literals.
+// The arguments of undefined calls are left out, since these are part of the report of that call.
+func (s *stuck) undefinedIdents(expr ast.Expr) []*ast.Ident {
+	info := s.pkg.info.TypesInfo
+	var idents []*ast.Ident
+	ast.Inspect(expr, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.CallExpr:
+			if ident, ok := n.Fun.(*ast.Ident); ok {
+				if _, ok := s.calls[ident]; ok {
+					idents = append(idents, ident)
+					return false
+				}
+			}
+		case *ast.SelectorExpr:
+			idents = append(idents, s.undefinedIdents(n.X)...)
+			return false
+		case *ast.KeyValueExpr:
+			idents = append(idents, s.undefinedIdents(n.Value)...)
+			return false
+		case *ast.Ident:
+			if _, ok := info.Uses[n]; ok {
+				return false
+			}
+			if _, ok := info.Defs[n]; ok {
+				return false
+			}
+			if n.Name != "_" {
+				idents = append(idents, n)
+			}
+		}
+		return true
+	})
+	return idents
+}
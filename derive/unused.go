@@ -0,0 +1,136 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Unused is a function in an existing derived file, that is no longer reachable from any call in the source code
+// or from another derived function, and is removed when the derived file is written.
+// Unused is also a function that is declared by hand with the prefix of a plugin,
+// which shadows the function that the plugin would generate.
+type Unused struct {
+	// Name is the name of the function.
+	Name string
+	// Plugin is the name of the plugin with the prefix of the function.
+	Plugin string
+	// Pos is the position of the declaration of the function.
+	Pos token.Position
+	// Shadow is true for a function that is declared by hand, outside of the derived files.
+	Shadow bool
+}
+
+func (u *Unused) String() string {
+	if u.Shadow {
+		return fmt.Sprintf("%v: %s is declared by hand, but has the prefix of the %s plugin", u.Pos, u.Name, u.Plugin)
+	}
+	return fmt.Sprintf("%v: %s is not used by any call or derived function", u.Pos, u.Name)
+}
+
+// findUnused compares the functions in the existing derived files of the directory with the functions that were generated,
+// and finds the functions that are declared by hand with the prefix of a plugin.
+// Derived files that were not written or removed, are left out, since their functions are not changed.
+func findUnused(pkgInfos []*packages.Package, s *settings, files *files, overlay map[string][]byte) error {
+	d := dir(pkgInfos[0])
+	outputs := newOutputs(s.output)
+	var existingFuncs []*Unused
+	generated := make(map[string]bool)
+	for _, name := range outputs.all() {
+		filename := filepath.Join(d, name)
+		content, ok := files.Get(filename)
+		if !ok {
+			continue
+		}
+		decls, err := funcDecls(filename, content)
+		if err != nil {
+			return err
+		}
+		for funcName := range decls {
+			generated[funcName] = true
+		}
+		existing, ok := overlay[filename]
+		if !ok {
+			existing, err = os.ReadFile(filename)
+			if os.IsNotExist(err) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+		}
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, filename, existing, parser.SkipObjectResolution)
+		if err != nil {
+			// A derived file that cannot be parsed is replaced as a whole.
+			continue
+		}
+		for _, fn := range funcs(f) {
+			existingFuncs = append(existingFuncs, &Unused{Name: fn.Name.Name, Pos: fset.Position(fn.Pos())})
+		}
+	}
+	var unused []*Unused
+	for _, u := range existingFuncs {
+		if !generated[u.Name] {
+			u.Plugin = pluginOf(s.plugins, u.Name)
+			unused = append(unused, u)
+		}
+	}
+	for _, pkgInfo := range pkgInfos {
+		for _, f := range pkgInfo.Syntax {
+			file := pkgInfo.Fset.File(f.FileStart)
+			if file == nil || outputs.contains(file.Name()) {
+				continue
+			}
+			for _, fn := range funcs(f) {
+				if plugin := pluginOf(s.plugins, fn.Name.Name); len(plugin) > 0 {
+					unused = append(unused, &Unused{Name: fn.Name.Name, Plugin: plugin, Pos: pkgInfo.Fset.Position(fn.Pos()), Shadow: true})
+				}
+			}
+		}
+	}
+	files.AddUnused(unused)
+	return nil
+}
+
+// funcs returns the declarations of the functions in the file, without the methods.
+func funcs(f *ast.File) []*ast.FuncDecl {
+	var fns []*ast.FuncDecl
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok && fn.Recv == nil {
+			fns = append(fns, fn)
+		}
+	}
+	return fns
+}
+
+// pluginOf returns the name of the plugin with the prefix of the function name, or empty if there is no such plugin.
+// The plugins are sorted from the longest to the shortest prefix.
+func pluginOf(plugins []Plugin, funcName string) string {
+	for _, p := range plugins {
+		if len(p.GetPrefix()) > 0 && strings.HasPrefix(funcName, p.GetPrefix()) {
+			return p.Name()
+		}
+	}
+	return ""
+}
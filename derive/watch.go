@@ -0,0 +1,229 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package derive
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/tools/go/packages"
+)
+
+// Watch generates the code for the packages matching the patterns and writes it to disk, like Generate and Result.Write,
+// and then polls the files of the loaded packages every interval, until stop is closed.
+// When files change, only the packages in their directories, and the loaded packages that import them, are generated again.
+// The files that Watch writes itself, including the source files that are rewritten by autoname and dedup,
+// do not cause the packages to be generated again.
+// The report function is called after every run, with either the result, that was written, or the error.
+// The error is Diagnostics, if code could not be generated for some calls, and Watch keeps polling after any error.
+func Watch(config Config, interval time.Duration, stop <-chan struct{}, report func(*Result, error), patterns ...string) error {
+	prog, err := newProgram(config, patterns)
+	if err != nil {
+		return err
+	}
+	w := &watcher{
+		pkgs:  make(map[string][]*packages.Package),
+		files: make(map[string]fileState),
+	}
+	w.replace(nil, prog.pkgs)
+	w.poll()
+	report(w.generate(prog))
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return nil
+		case <-ticker.C:
+		}
+		changed := w.poll()
+		if len(changed) == 0 {
+			continue
+		}
+		affected := w.affected(changed)
+		prog, err := newProgram(config, affected)
+		if err != nil {
+			report(nil, err)
+			continue
+		}
+		w.replace(affected, prog.pkgs)
+		report(w.generate(prog))
+	}
+}
+
+// watcher keeps track of the packages that are watched and of the state of their files on disk.
+type watcher struct {
+	// pkgs are the loaded packages, by the pattern that loads them again.
+	pkgs map[string][]*packages.Package
+	// files is the state of every file, after it was last polled or written by the watcher itself.
+	files map[string]fileState
+}
+
+// fileState is the state of a file on disk, which is compared between polls.
+type fileState struct {
+	exists  bool
+	size    int64
+	modTime time.Time
+}
+
+func statFile(filename string) fileState {
+	info, err := os.Stat(filename)
+	if err != nil {
+		return fileState{}
+	}
+	return fileState{exists: true, size: info.Size(), modTime: info.ModTime()}
+}
+
+func (s fileState) equal(that fileState) bool {
+	return s.exists == that.exists && s.size == that.size && s.modTime.Equal(that.modTime)
+}
+
+// pattern returns the pattern that loads the package again, together with its test variants.
+func pattern(pkg *packages.Package) string {
+	if len(pkg.ForTest) > 0 {
+		return pkg.ForTest
+	}
+	return pkg.PkgPath
+}
+
+// replace replaces the packages of the patterns, that were generated again, with the newly loaded packages.
+func (w *watcher) replace(patterns []string, pkgs []*packages.Package) {
+	for _, p := range patterns {
+		delete(w.pkgs, p)
+	}
+	for _, pkg := range pkgs {
+		p := pattern(pkg)
+		w.pkgs[p] = append(w.pkgs[p], pkg)
+	}
+}
+
+// generate generates the code for the program and writes it to disk.
+// The state of the written files is recorded, so that the watcher does not trigger itself.
+func (w *watcher) generate(prog *program) (*Result, error) {
+	res, err := prog.Result()
+	if err != nil {
+		return nil, err
+	}
+	if err := res.Write(); err != nil {
+		return nil, err
+	}
+	for _, edit := range res.Edits {
+		w.files[edit.Filename] = statFile(edit.Filename)
+	}
+	for filename := range res.Files {
+		w.files[filename] = statFile(filename)
+	}
+	return res, nil
+}
+
+// poll returns the sorted patterns of the packages, of which files were changed, created or removed since the previous poll.
+// The files are those that the loader resolved for the packages, together with their goderive.json files
+// and any new go files in the directories of the packages.
+func (w *watcher) poll() []string {
+	owners := make(map[string][]string)
+	dirs := make(map[string][]string)
+	for p, pkgs := range w.pkgs {
+		for _, pkg := range pkgs {
+			filenames := append(append(configFilenames(pkg), pkg.GoFiles...), pkg.OtherFiles...)
+			for _, filename := range filenames {
+				owners[filename] = append(owners[filename], p)
+			}
+			if d := dir(pkg); filepath.IsAbs(d) {
+				dirs[d] = append(dirs[d], p)
+			}
+		}
+	}
+	changed := make(map[string]bool)
+	update := func(filename string, patterns []string) {
+		state := statFile(filename)
+		if state.equal(w.files[filename]) {
+			return
+		}
+		w.files[filename] = state
+		for _, p := range patterns {
+			changed[p] = true
+		}
+	}
+	for filename, patterns := range owners {
+		update(filename, patterns)
+	}
+	for d, patterns := range dirs {
+		entries, err := os.ReadDir(d)
+		if err != nil {
+			// The directory was removed, which is already noticed by its files.
+			continue
+		}
+		for _, entry := range entries {
+			filename := filepath.Join(d, entry.Name())
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".go") {
+				continue
+			}
+			if _, ok := owners[filename]; ok {
+				continue
+			}
+			update(filename, patterns)
+		}
+	}
+	return sortedKeys(changed)
+}
+
+// affected returns the sorted patterns of the changed packages, together with the watched packages that import them.
+func (w *watcher) affected(changed []string) []string {
+	paths := make(map[string]bool)
+	for _, p := range changed {
+		for _, pkg := range w.pkgs[p] {
+			paths[pkg.PkgPath] = true
+		}
+	}
+	imports := make(map[string]bool)
+	var importsChanged func(pkg *packages.Package) bool
+	importsChanged = func(pkg *packages.Package) bool {
+		if v, ok := imports[pkg.ID]; ok {
+			return v
+		}
+		imports[pkg.ID] = false
+		for _, imp := range pkg.Imports {
+			if paths[imp.PkgPath] || importsChanged(imp) {
+				imports[pkg.ID] = true
+				break
+			}
+		}
+		return imports[pkg.ID]
+	}
+	affected := make(map[string]bool)
+	for _, p := range changed {
+		affected[p] = true
+	}
+	for p, pkgs := range w.pkgs {
+		for _, pkg := range pkgs {
+			if importsChanged(pkg) {
+				affected[p] = true
+			}
+		}
+	}
+	return sortedKeys(affected)
+}
+
+func sortedKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -20,20 +20,20 @@ func deriveCompare(this, that *MyStruct) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_1(this.StringPtr, that.StringPtr); c != 0 {
+	if c := deriveCompare_PtrTo_string(this.StringPtr, that.StringPtr); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompare_ returns:
+// deriveCompare_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_(this, that int64) int {
+func deriveCompare_int64(this, that int64) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -44,11 +44,11 @@ func deriveCompare_(this, that int64) int {
 	return 0
 }
 
-// deriveCompare_1 returns:
+// deriveCompare_PtrTo_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_1(this, that *string) int {
+func deriveCompare_PtrTo_string(this, that *string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -58,13 +58,13 @@ func deriveCompare_1(this, that *string) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_s(*this, *that)
+	return deriveCompare_string(*this, *that)
 }
 
-// deriveCompare_s returns:
+// deriveCompare_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_s(this, that string) int {
+func deriveCompare_string(this, that string) int {
 	return strings.Compare(this, that)
 }
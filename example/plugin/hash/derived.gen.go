@@ -8,13 +8,13 @@ func deriveHash(object *Entry) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.Name)
-	h = 31*h + deriveHash_1(object.Numbers)
+	h = 31*h + deriveHash_string(object.Name)
+	h = 31*h + deriveHash_SliceOf_int(object.Numbers)
 	return h
 }
 
-// deriveHash_ returns the hash of the object.
-func deriveHash_(object string) uint64 {
+// deriveHash_string returns the hash of the object.
+func deriveHash_string(object string) uint64 {
 	var h uint64
 	for _, c := range object {
 		h = 31*h + uint64(c)
@@ -22,8 +22,8 @@ func deriveHash_(object string) uint64 {
 	return h
 }
 
-// deriveHash_1 returns the hash of the object.
-func deriveHash_1(object []int) uint64 {
+// deriveHash_SliceOf_int returns the hash of the object.
+func deriveHash_SliceOf_int(object []int) uint64 {
 	if object == nil {
 		return 0
 	}
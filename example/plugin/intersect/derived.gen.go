@@ -7,19 +7,19 @@ package intersect
 //
 // Deprecated: In favour of generics.
 func deriveIntersect(this, that []int) []int {
-	intersect := make([]int, 0, deriveMin(len(this), len(that)))
+	intersect := make([]int, 0, deriveMin_int(len(this), len(that)))
 	for i, v := range this {
-		if deriveContains(that, v) {
+		if deriveContains_SliceOf_int(that, v) {
 			intersect = append(intersect, this[i])
 		}
 	}
 	return intersect
 }
 
-// deriveContains returns whether the item is contained in the list.
+// deriveContains_SliceOf_int returns whether the item is contained in the list.
 //
 // Deprecated: In favour of generics.
-func deriveContains(list []int, item int) bool {
+func deriveContains_SliceOf_int(list []int, item int) bool {
 	for _, v := range list {
 		if v == item {
 			return true
@@ -28,10 +28,10 @@ func deriveContains(list []int, item int) bool {
 	return false
 }
 
-// deriveMin returns the minimum of the two input values.
+// deriveMin_int returns the minimum of the two input values.
 //
 // Deprecated: In favour of generics.
-func deriveMin(a, b int) int {
+func deriveMin_int(a, b int) int {
 	if a < b {
 		return a
 	}
@@ -38,26 +38,26 @@ func deriveMins(list []boat, def boat) boat {
 	m := list[0]
 	list = list[1:]
 	for i, v := range list {
-		if deriveCompare(v, m) < 0 {
+		if deriveCompare_boat(v, m) < 0 {
 			m = list[i]
 		}
 	}
 	return m
 }
 
-// deriveCompare returns:
+// deriveCompare_boat returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare(this, that boat) int {
-	return deriveCompare_(&this, &that)
+func deriveCompare_boat(this, that boat) int {
+	return deriveCompare_PtrTo_boat(&this, &that)
 }
 
-// deriveCompare_ returns:
+// deriveCompare_PtrTo_boat returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_(this, that *boat) int {
+func deriveCompare_PtrTo_boat(this, that *boat) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -67,17 +67,17 @@ func deriveCompare_(this, that *boat) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_i(this.length, that.length); c != 0 {
+	if c := deriveCompare_int(this.length, that.length); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompare_i returns:
+// deriveCompare_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_i(this, that int) int {
+func deriveCompare_int(this, that int) int {
 	if this != that {
 		if this < that {
 			return -1
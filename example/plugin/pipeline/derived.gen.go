@@ -10,12 +10,12 @@ import (
 func derivePipeline(f func(lines []string) <-chan string, g func(line string) <-chan int) func([]string) <-chan int {
 	return func(a []string) <-chan int {
 		b := f(a)
-		return deriveJoin(deriveFmap(g, b))
+		return deriveJoin_1416a500(deriveFmap_75c48b5c(g, b))
 	}
 }
 
-// deriveJoin listens on all channels resulting from the input channel and sends all their results on the output channel.
-func deriveJoin(in <-chan (<-chan int)) <-chan int {
+// deriveJoin_1416a500 listens on all channels resulting from the input channel and sends all their results on the output channel.
+func deriveJoin_1416a500(in <-chan (<-chan int)) <-chan int {
 	out := make(chan int)
 	go func() {
 		wait := sync.WaitGroup{}
@@ -35,8 +35,8 @@ func deriveJoin(in <-chan (<-chan int)) <-chan int {
 	return out
 }
 
-// deriveFmap returns an output channel where the items are the result of the input function being applied to the items on the input channel.
-func deriveFmap(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
+// deriveFmap_75c48b5c returns an output channel where the items are the result of the input function being applied to the items on the input channel.
+func deriveFmap_75c48b5c(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
 	out := make(chan (<-chan int), cap(in))
 	go func() {
 		for a := range in {
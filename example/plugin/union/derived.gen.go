@@ -14,10 +14,10 @@ func deriveUnique(list []*Person) []*Person {
 	u := 0
 	for i := 0; i < len(list); i++ {
 		contains := false
-		hash := deriveHash(list[i])
+		hash := deriveHash_PtrTo_Person(list[i])
 		indexes := table[hash]
 		for _, index := range indexes {
-			if deriveEqual(list[index], list[i]) {
+			if deriveEqual_PtrTo_Person(list[index], list[i]) {
 				contains = true
 				break
 			}
@@ -56,46 +56,46 @@ func deriveFilter(predicate func(*Person) bool, list []*Person) []*Person {
 // Deprecated: In favour of generics.
 func deriveUnion(this, that []*Person) []*Person {
 	for i, v := range that {
-		if !deriveContains(this, v) {
+		if !deriveContains_SliceOf_PtrTo_Person(this, v) {
 			this = append(this, that[i])
 		}
 	}
 	return this
 }
 
-// deriveEqual returns whether this and that are equal.
-func deriveEqual(this, that *Person) bool {
+// deriveEqual_PtrTo_Person returns whether this and that are equal.
+func deriveEqual_PtrTo_Person(this, that *Person) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Name == that.Name &&
 			((this.Vote == nil && that.Vote == nil) || (this.Vote != nil && that.Vote != nil && *(this.Vote) == *(that.Vote)))
 }
 
-// deriveHash returns the hash of the object.
-func deriveHash(object *Person) uint64 {
+// deriveHash_PtrTo_Person returns the hash of the object.
+func deriveHash_PtrTo_Person(object *Person) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.Name)
-	h = 31*h + deriveHash_1(object.Vote)
+	h = 31*h + deriveHash_string(object.Name)
+	h = 31*h + deriveHash_PtrTo_string(object.Vote)
 	return h
 }
 
-// deriveContains returns whether the item is contained in the list.
+// deriveContains_SliceOf_PtrTo_Person returns whether the item is contained in the list.
 //
 // Deprecated: In favour of generics.
-func deriveContains(list []*Person, item *Person) bool {
+func deriveContains_SliceOf_PtrTo_Person(list []*Person, item *Person) bool {
 	for _, v := range list {
-		if deriveEqual(v, item) {
+		if deriveEqual_PtrTo_Person(v, item) {
 			return true
 		}
 	}
 	return false
 }
 
-// deriveHash_ returns the hash of the object.
-func deriveHash_(object string) uint64 {
+// deriveHash_string returns the hash of the object.
+func deriveHash_string(object string) uint64 {
 	var h uint64
 	for _, c := range object {
 		h = 31*h + uint64(c)
@@ -103,10 +103,10 @@ func deriveHash_(object string) uint64 {
 	return h
 }
 
-// deriveHash_1 returns the hash of the object.
-func deriveHash_1(object *string) uint64 {
+// deriveHash_PtrTo_string returns the hash of the object.
+func deriveHash_PtrTo_string(object *string) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_(*object)
+	return (31 * 17) + deriveHash_string(*object)
 }
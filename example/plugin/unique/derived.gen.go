@@ -14,10 +14,10 @@ func deriveUnique(list []*Visitor) []*Visitor {
 	u := 0
 	for i := 0; i < len(list); i++ {
 		contains := false
-		hash := deriveHash(list[i])
+		hash := deriveHash_PtrTo_Visitor(list[i])
 		indexes := table[hash]
 		for _, index := range indexes {
-			if deriveEqual(list[index], list[i]) {
+			if deriveEqual_PtrTo_Visitor(list[index], list[i]) {
 				contains = true
 				break
 			}
@@ -34,35 +34,35 @@ func deriveUnique(list []*Visitor) []*Visitor {
 	return list[:u]
 }
 
-// deriveEqual returns whether this and that are equal.
-func deriveEqual(this, that *Visitor) bool {
+// deriveEqual_PtrTo_Visitor returns whether this and that are equal.
+func deriveEqual_PtrTo_Visitor(this, that *Visitor) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			((this.UserName == nil && that.UserName == nil) || (this.UserName != nil && that.UserName != nil && *(this.UserName) == *(that.UserName))) &&
 			this.RemoteAddr == that.RemoteAddr
 }
 
-// deriveHash returns the hash of the object.
-func deriveHash(object *Visitor) uint64 {
+// deriveHash_PtrTo_Visitor returns the hash of the object.
+func deriveHash_PtrTo_Visitor(object *Visitor) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.UserName)
-	h = 31*h + deriveHash_s(object.RemoteAddr)
+	h = 31*h + deriveHash_PtrTo_string(object.UserName)
+	h = 31*h + deriveHash_string(object.RemoteAddr)
 	return h
 }
 
-// deriveHash_ returns the hash of the object.
-func deriveHash_(object *string) uint64 {
+// deriveHash_PtrTo_string returns the hash of the object.
+func deriveHash_PtrTo_string(object *string) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_s(*object)
+	return (31 * 17) + deriveHash_string(*object)
 }
 
-// deriveHash_s returns the hash of the object.
-func deriveHash_s(object string) uint64 {
+// deriveHash_string returns the hash of the object.
+func deriveHash_string(object string) uint64 {
 	var h uint64
 	for _, c := range object {
 		h = 31*h + uint64(c)
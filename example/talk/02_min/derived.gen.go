@@ -10,17 +10,17 @@ import (
 //
 // Deprecated: In favour of generics.
 func Min(a, b *Person) *Person {
-	if Compare(a, b) < 0 {
+	if Compare_PtrTo_Person(a, b) < 0 {
 		return a
 	}
 	return b
 }
 
-// Compare returns:
+// Compare_PtrTo_Person returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func Compare(this, that *Person) int {
+func Compare_PtrTo_Person(this, that *Person) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -33,17 +33,17 @@ func Compare(this, that *Person) int {
 	if c := strings.Compare(this.name, that.name); c != 0 {
 		return c
 	}
-	if c := Compare_(this.age, that.age); c != 0 {
+	if c := Compare_int(this.age, that.age); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// Compare_ returns:
+// Compare_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func Compare_(this, that int) int {
+func Compare_int(this, that int) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -11,23 +11,23 @@ import (
 //
 // Deprecated: In favour of generics.
 func Sort(list []Person) []Person {
-	sort.Slice(list, func(i, j int) bool { return Compare(list[i], list[j]) < 0 })
+	sort.Slice(list, func(i, j int) bool { return Compare_Person(list[i], list[j]) < 0 })
 	return list
 }
 
-// Compare returns:
+// Compare_Person returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func Compare(this, that Person) int {
-	return Compare_(&this, &that)
+func Compare_Person(this, that Person) int {
+	return Compare_PtrTo_Person(&this, &that)
 }
 
-// Compare_ returns:
+// Compare_PtrTo_Person returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func Compare_(this, that *Person) int {
+func Compare_PtrTo_Person(this, that *Person) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -40,17 +40,17 @@ func Compare_(this, that *Person) int {
 	if c := strings.Compare(this.name, that.name); c != 0 {
 		return c
 	}
-	if c := Compare_i(this.age, that.age); c != 0 {
+	if c := Compare_int(this.age, that.age); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// Compare_i returns:
+// Compare_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func Compare_i(this, that int) int {
+func Compare_int(this, that int) int {
 	if this != that {
 		if this < that {
 			return -1
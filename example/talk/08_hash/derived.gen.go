@@ -15,11 +15,11 @@ func deriveMem(f func(firstname string, age *int) *string) func(firstname string
 	m := make(map[uint64][]mem)
 	return func(param0 string, param1 *int) *string {
 		in := input{param0, param1}
-		h := deriveHash(in)
+		h := deriveHash_4d604971(in)
 		vs, ok := m[h]
 		if ok {
 			for _, v := range vs {
-				if deriveEqual(v.in, in) {
+				if deriveEqual_7537f65b(v.in, in) {
 					return v.out
 				}
 			}
@@ -30,8 +30,8 @@ func deriveMem(f func(firstname string, age *int) *string) func(firstname string
 	}
 }
 
-// deriveEqual returns whether this and that are equal.
-func deriveEqual(this, that struct {
+// deriveEqual_7537f65b returns whether this and that are equal.
+func deriveEqual_7537f65b(this, that struct {
 	Param0 string
 	Param1 *int
 }) bool {
@@ -39,19 +39,19 @@ func deriveEqual(this, that struct {
 		((this.Param1 == nil && that.Param1 == nil) || (this.Param1 != nil && that.Param1 != nil && *(this.Param1) == *(that.Param1)))
 }
 
-// deriveHash returns the hash of the object.
-func deriveHash(object struct {
+// deriveHash_4d604971 returns the hash of the object.
+func deriveHash_4d604971(object struct {
 	Param0 string
 	Param1 *int
 }) uint64 {
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.Param0)
-	h = 31*h + deriveHash_1(object.Param1)
+	h = 31*h + deriveHash_string(object.Param0)
+	h = 31*h + deriveHash_PtrTo_int(object.Param1)
 	return h
 }
 
-// deriveHash_ returns the hash of the object.
-func deriveHash_(object string) uint64 {
+// deriveHash_string returns the hash of the object.
+func deriveHash_string(object string) uint64 {
 	var h uint64
 	for _, c := range object {
 		h = 31*h + uint64(c)
@@ -59,8 +59,8 @@ func deriveHash_(object string) uint64 {
 	return h
 }
 
-// deriveHash_1 returns the hash of the object.
-func deriveHash_1(object *int) uint64 {
+// deriveHash_PtrTo_int returns the hash of the object.
+func deriveHash_PtrTo_int(object *int) uint64 {
 	if object == nil {
 		return 0
 	}
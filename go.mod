@@ -2,9 +2,9 @@ module awalterschulze.org/go/goderive
 
 go 1.26.3
 
+require golang.org/x/tools v0.47.0
+
 require (
-	github.com/kisielk/gotool v1.0.0
-	golang.org/x/tools v0.6.0
+	golang.org/x/mod v0.37.0 // indirect
+	golang.org/x/sync v0.21.0 // indirect
 )
-
-require golang.org/x/sys v0.5.0 // indirect
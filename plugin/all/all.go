@@ -16,7 +16,7 @@
 //
 // The deriveAll function applies a predicate to each element of a list, returning a whether all items matched the predicate.
 //
-//	func deriveAll(func (T) bool, []T) bool
+//	deriveAll(func(T) bool, []T) bool
 package all
 
 import (
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("all", "deriveAll", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(T) bool, []T) bool")
+}
+
 // New is a constructor for the all code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
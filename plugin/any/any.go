@@ -16,7 +16,7 @@
 //
 // The deriveAny function applies a predicate to each element of a list, returning a whether any of the items matched the predicate.
 //
-//	func deriveAny(func (T) bool, []T) bool
+//	deriveAny(func(T) bool, []T) bool
 package any
 
 import (
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("any", "deriveAny", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(T) bool, []T) bool")
+}
+
 // New is a constructor for the any code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -16,7 +16,7 @@
 //
 // The deriveApply function applies the given argument to a given function and returns a function which requires filling in the other arguments.
 //
-//	deriveApply(f func(...A, B) C, B) func(...A) C
+//	deriveApply(func(A..., B) C, B) func(A...) C
 package apply
 
 import (
@@ -33,6 +33,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("apply", "deriveApply", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A..., B) C, B) func(A...) C")
+}
+
 // New is a constructor for the apply code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
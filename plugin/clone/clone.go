@@ -16,17 +16,19 @@
 //
 // The deriveClone function is a maintainable and fast way to implement fast"ish" clone functions.
 //
-//	func deriveClone(T) T
+//	deriveClone(T) T
 //
 // I say fast"ish", since deriveClone creates a totally new copy of the value, whereas deepcopy reuses as much as of the memory that has been allocated by the destintation value.
 //
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
-//   - private fields of structs in external packages (using reflect and unsafe)
+//   - private fields of structs in external packages (using reflect and unsafe),
+//     except in safe mode, where the DeepCopy method of the external type is used instead
 //   - and many more
 //
 // Unsupported types:
@@ -34,6 +36,13 @@
 //   - interface
 //   - function
 //   - unnamed structs, which are not comparable with the == operator
+//
+// Fields with the struct tag `derive:"-"`, `derive:"clone=-"` or `derive:"deepcopy=-"` are not cloned and are left as the zero value,
+// since the clone is created using deriveDeepCopy.
+// Types with fields that are only skipped by clone, are copied by deepcopy functions, that clone generates itself,
+// so that deriveDeepCopy still copies these fields.
+//
+// A type declaration with the comment //goderive:methods clone generates a Clone method, which calls deriveClone, for the type.
 package clone
 
 import (
@@ -41,6 +50,7 @@ import (
 	"go/types"
 
 	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/deepcopy"
 )
 
 // NewPlugin creates a new clone plugin.
@@ -49,6 +59,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("clone", "deriveClone", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(T) T")
+}
+
 // New is a constructor for the clone code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -56,6 +70,7 @@ func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Depe
 		TypesMap: typesMap,
 		printer:  p,
 		deepcopy: deps["deepcopy"],
+		copy:     deepcopy.NewSkipping(copyTypesMap{typesMap}, p, "deepcopy", "clone"),
 	}
 }
 
@@ -63,6 +78,27 @@ type gen struct {
 	derive.TypesMap
 	printer  derive.Printer
 	deepcopy derive.Dependency
+	// copy generates the deepcopy functions, that skip the fields with the struct tag `derive:"clone=-"`.
+	copy derive.Generator
+}
+
+// copyTypesMap names the deepcopy functions of clone by the destination and source types,
+// which cannot be confused with the deriveClone functions, that only have one argument.
+type copyTypesMap struct {
+	derive.TypesMap
+}
+
+func (m copyTypesMap) GetFuncName(typs ...types.Type) string {
+	return m.TypesMap.GetFuncName(typs[0], typs[0])
+}
+
+func (m copyTypesMap) Generating(typs ...types.Type) {
+	m.TypesMap.Generating(typs[0], typs[0])
+}
+
+// SetSafe makes the deepcopy functions of clone use the DeepCopy method of an external type, instead of reflect and unsafe.
+func (g *gen) SetSafe() {
+	g.copy.(derive.SafeGenerator).SetSafe()
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -73,9 +109,73 @@ func (g *gen) Add(name string, typs []types.Type) (string, error) {
 }
 
 func (g *gen) Generate(typs []types.Type) error {
+	if len(typs) == 2 {
+		return g.copy.Generate(typs[:1])
+	}
 	return g.genFuncFor(typs[0])
 }
 
+// copyFuncName returns the name of the function, that deep copies the type,
+// which is generated by clone, if a field that is only skipped by clone can be reached from the type.
+func (g *gen) copyFuncName(typ types.Type) string {
+	if skipsClone(typ, make(map[types.Type]bool)) {
+		return g.GetFuncName(typ, typ)
+	}
+	return g.deepcopy.GetFuncName(typ)
+}
+
+// skipsClone returns whether a struct field with the struct tag `derive:"clone=-"`, that is not skipped by deepcopy, can be reached from the type.
+func skipsClone(typ types.Type, seen map[types.Type]bool) bool {
+	if seen[typ] {
+		return false
+	}
+	seen[typ] = true
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		return skipsClone(t.Elem(), seen)
+	case *types.Slice:
+		return skipsClone(t.Elem(), seen)
+	case *types.Array:
+		return skipsClone(t.Elem(), seen)
+	case *types.Map:
+		return skipsClone(t.Key(), seen) || skipsClone(t.Elem(), seen)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			if derive.SkipTag(t.Tag(i), "clone") && !derive.SkipTag(t.Tag(i), "deepcopy") {
+				return true
+			}
+			if skipsClone(t.Field(i).Type(), seen) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "Clone"
+}
+
+// MethodTypes returns the input types of the deriveClone function that is called by the Clone method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	return []types.Type{types.NewPointer(typ)}
+}
+
+// GenerateMethod generates a Clone method that calls the deriveClone function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	typeStr := g.TypeString(types.NewPointer(typ))
+	p.P("")
+	p.P("// Clone returns a clone of this.")
+	p.P("func (this %s) Clone() %s {", typeStr, typeStr)
+	p.In()
+	p.P("return %s(this)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genFuncFor(in types.Type) error {
 	p := g.printer
 	g.Generating(in)
@@ -92,7 +192,7 @@ func (g *gen) genFuncFor(in types.Type) error {
 		p.Out()
 		p.P("}")
 		p.P("dst := new(%s)", g.TypeString(ttyp.Elem()))
-		p.P("%s(dst, src)", g.deepcopy.GetFuncName(in))
+		p.P("%s(dst, src)", g.copyFuncName(in))
 		p.P("return dst")
 	case *types.Slice:
 		p.P("if src == nil {")
@@ -101,7 +201,7 @@ func (g *gen) genFuncFor(in types.Type) error {
 		p.Out()
 		p.P("}")
 		p.P("dst := make(%s, len(src))", g.TypeString(in))
-		p.P("%s(dst, src)", g.deepcopy.GetFuncName(in))
+		p.P("%s(dst, src)", g.copyFuncName(in))
 		p.P("return dst")
 	case *types.Map:
 		p.P("if src == nil {")
@@ -110,11 +210,11 @@ func (g *gen) genFuncFor(in types.Type) error {
 		p.Out()
 		p.P("}")
 		p.P("dst := make(%s)", g.TypeString(in))
-		p.P("%s(dst, src)", g.deepcopy.GetFuncName(in))
+		p.P("%s(dst, src)", g.copyFuncName(in))
 		p.P("return dst")
 	default:
 		p.P("dst := new(%s)", inStr)
-		p.P("%s(dst, &src)", g.deepcopy.GetFuncName(types.NewPointer(in)))
+		p.P("%s(dst, &src)", g.copyFuncName(types.NewPointer(in)))
 		p.P("return *dst")
 	}
 	p.Out()
@@ -16,8 +16,8 @@
 //
 // The deriveCompare function is a maintainable and fast way to implement fast Less functions.
 //
-//	deriveCompare(T, T) bool
-//	deriveCompare(T) func(T) bool
+//	deriveCompare(T, T) int
+//	deriveCompare(T) func(T) int
 //
 // When goderive walks over your code it is looking for a function that:
 //   - was not implemented (or was previously derived) and
@@ -45,10 +45,12 @@
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
-//   - private fields of structs in external packages (using reflect and unsafe)
+//   - private fields of structs in external packages (using reflect and unsafe),
+//     except in safe mode, where the Compare method of the external type is used instead
 //   - and many more
 //
 // Unsupported types:
@@ -57,6 +59,10 @@
 //   - function
 //   - unnamed structs, which are not comparable with the == operator
 //
+// Fields with the struct tag `derive:"-"` or `derive:"compare=-"` are not compared.
+//
+// A type declaration with the comment //goderive:methods compare generates a Compare method, which calls deriveCompare, for the type.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/main/example/plugin/equal
 //
@@ -77,6 +83,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("compare", "deriveCompare", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(T, T) int",
+		"(T) func(T) int",
+	)
+}
+
 // New is a constructor for the compare code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -99,10 +112,16 @@ type gen struct {
 	stringsPkg derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
+	safe       bool
 	keys       derive.Dependency
 	sort       derive.Dependency
 }
 
+// SetSafe makes the generator use the Compare method of an external type, instead of reflect and unsafe to access its private fields.
+func (g *gen) SetSafe() {
+	g.safe = true
+}
+
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
 	if len(typs) != 1 && len(typs) != 2 {
 		return "", fmt.Errorf("%s does not have one or two arguments", name)
@@ -123,6 +142,34 @@ func (g *gen) Generate(typs []types.Type) error {
 	return g.genFunc(typs)
 }
 
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "Compare"
+}
+
+// MethodTypes returns the input types of the deriveCompare function that is called by the Compare method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	ptr := types.NewPointer(typ)
+	return []types.Type{ptr, ptr}
+}
+
+// GenerateMethod generates a Compare method that calls the deriveCompare function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	typeStr := g.TypeString(types.NewPointer(typ))
+	p.P("")
+	p.P("// Compare returns:")
+	p.P("//   - 0 if this and that are equal,")
+	p.P("//   - -1 is this is smaller and")
+	p.P("//   - +1 is this is bigger.")
+	p.P("func (this %s) Compare(that %s) int {", typeStr, typeStr)
+	p.In()
+	p.P("return %s(this, that)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func compareMethodInputParam(typ *types.Named) *types.Type {
 	for i := 0; i < typ.NumMethods(); i++ {
 		meth := typ.Method(i)
@@ -223,7 +270,21 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			return nil
 		}
 		external := g.TypesMap.IsExternal(named)
-		fields := derive.Fields(g.TypesMap, strct, external)
+		fields := derive.Fields(g.TypesMap, strct, external).For("compare")
+		for _, field := range fields.Skipped {
+			p.P(field.SkipComment())
+		}
+		if fields.Reflect && g.safe {
+			if compareMethodInputParam(named) == nil {
+				return fields.PrivateExternal().SafeError(g.TypeString(named))
+			}
+			fieldStr, err := g.field("*"+this, "*"+that, named)
+			if err != nil {
+				return err
+			}
+			p.P("return %s", fieldStr)
+			return nil
+		}
 		if fields.Reflect {
 			p.P(`thisv := ` + g.reflectPkg() + `.Indirect(` + g.reflectPkg() + `.ValueOf(` + this + `))`)
 			p.P(`thatv := ` + g.reflectPkg() + `.Indirect(` + g.reflectPkg() + `.ValueOf(` + that + `))`)
@@ -40,6 +40,15 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("compose", "deriveCompose", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(func() (A, error), func(A) (B, error)) func() (B, error)",
+		"(func(A) (B, error), func(B) (C, error)) func(A) (C, error)",
+		"(func(A...) (B..., error), func(B...) (C..., error)) func(A...) (C..., error)",
+		"(func(A...) (B..., error), ..., func(C...) (D..., error)) func(A...) (D..., error)",
+	)
+}
+
 // New is a constructor for the compose code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
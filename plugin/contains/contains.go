@@ -16,7 +16,7 @@
 //
 // The deriveContains function returns whether a value is contained in a slice.
 //
-//	func deriveContains([]T, T) bool
+//	deriveContains([]T, T) bool
 //
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/contains
 package contains
@@ -34,6 +34,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("contains", "deriveContains", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "([]T, T) bool")
+}
+
 // New is a constructor for the contains code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
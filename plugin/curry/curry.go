@@ -16,7 +16,7 @@
 //
 // The deriveCurry function curries the first two parameters of the input function.
 //
-//	deriveCurry(f func(A, B, ...) T) func(A) func(B, ...) T
+//	deriveCurry(func(A, B, ...) T) func(A) func(B, ...) T
 package curry
 
 import (
@@ -33,6 +33,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("curry", "deriveCurry", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A, B, ...) T) func(A) func(B, ...) T")
+}
+
 // New is a constructor for the curry code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
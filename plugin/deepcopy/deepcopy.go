@@ -16,6 +16,8 @@
 //
 // The deriveDeepCopy function is a maintainable and fast way to implement fast copy functions.
 //
+//	deriveDeepCopy(dst, src T)
+//
 // When goderive walks over your code it is looking for a function that:
 //   - was not implemented (or was previously derived) and
 //   - has a predefined prefix.
@@ -53,10 +55,12 @@
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
-//   - private fields of structs in external packages (using reflect and unsafe)
+//   - private fields of structs in external packages (using reflect and unsafe),
+//     except in safe mode, where the DeepCopy method of the external type is used instead
 //   - and many more
 //
 // Unsupported types:
@@ -65,6 +69,10 @@
 //   - function
 //   - unnamed structs, which are not comparable with the == operator
 //
+// Fields with the struct tag `derive:"-"` or `derive:"deepcopy=-"` are not copied and keep their value in the destination.
+//
+// A type declaration with the comment //goderive:methods deepcopy generates a DeepCopy method, which calls deriveDeepCopy, for the type.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/main/example/plugin/deepcopy
 //
@@ -85,12 +93,23 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("deepcopy", "deriveDeepCopy", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(dst, src T)")
+}
+
 // New is a constructor for the deepcopy code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return NewSkipping(typesMap, p, "deepcopy")
+}
+
+// NewSkipping is a constructor for a deepcopy code generator, that skips the fields with the struct tags of the plugins,
+// for example `derive:"deepcopy=-"` and `derive:"clone=-"` for the deepcopy functions that the clone plugin generates.
+func NewSkipping(typesMap derive.TypesMap, p derive.Printer, plugins ...string) derive.Generator {
 	return &gen{
 		TypesMap:   typesMap,
 		printer:    p,
+		plugins:    plugins,
 		bytesPkg:   p.NewImport("bytes", "bytes"),
 		reflectPkg: p.NewImport("reflect", "reflect"),
 		unsafePkg:  p.NewImport("unsafe", "unsafe"),
@@ -100,9 +119,16 @@ func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Depe
 type gen struct {
 	derive.TypesMap
 	printer    derive.Printer
+	plugins    []string
 	bytesPkg   derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
+	safe       bool
+}
+
+// SetSafe makes the generator use the DeepCopy method of an external type, instead of reflect and unsafe to access its private fields.
+func (g *gen) SetSafe() {
+	g.safe = true
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -120,6 +146,30 @@ func (g *gen) Generate(typs []types.Type) error {
 	return g.genFunc(typs[0])
 }
 
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "DeepCopy"
+}
+
+// MethodTypes returns the input types of the deriveDeepCopy function that is called by the DeepCopy method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	return []types.Type{types.NewPointer(typ)}
+}
+
+// GenerateMethod generates a DeepCopy method that calls the deriveDeepCopy function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	typeStr := g.TypeString(types.NewPointer(typ))
+	p.P("")
+	p.P("// DeepCopy recursively copies the contents of this into that.")
+	p.P("func (this %s) DeepCopy(that %s) {", typeStr, typeStr)
+	p.In()
+	p.P("%s(that, this)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genFunc(typ types.Type) error {
 	p := g.printer
 	g.Generating(typ)
@@ -138,7 +188,7 @@ func (g *gen) genFunc(typ types.Type) error {
 
 func (g *gen) genStatement(typ types.Type, this, that string) error {
 	p := g.printer
-	if canCopy(typ) {
+	if g.canCopy(typ) {
 		p.P("%s = %s", that, this)
 		return nil
 	}
@@ -166,6 +216,19 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		} else if isNamed || isAlias {
 			external := g.TypesMap.IsExternal(objGetter)
 			fields := derive.Fields(g.TypesMap, strct, external)
+			for _, plugin := range g.plugins {
+				fields = fields.For(plugin)
+			}
+			for _, field := range fields.Skipped {
+				p.P(field.SkipComment())
+			}
+			if fields.Reflect && g.safe {
+				if !hasDeepCopyMethod(reftyp) {
+					return fields.PrivateExternal().SafeError(g.TypeString(reftyp))
+				}
+				p.P("%s.DeepCopy(%s)", this, that)
+				return nil
+			}
 			if len(fields.Fields) > 0 {
 				thisv := prepend(this, "v")
 				thatv := prepend(that, "v")
@@ -190,7 +253,7 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		}
 	case *types.Slice:
 		elmType := ttyp.Elem()
-		if canCopy(elmType) {
+		if g.canCopy(elmType) {
 			p.P("copy(%s, %s)", that, this)
 			return nil
 		}
@@ -223,7 +286,7 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		p.P("for %s, %s := range %s {", thiskey, thisvalue, this)
 		p.In()
 		thatkey := thiskey
-		if !canCopy(keyType) {
+		if !g.canCopy(keyType) {
 			if err := g.genField(keyType, thatkey, thiskey); err != nil {
 				return err
 			}
@@ -278,22 +341,36 @@ func prepend(before, after string) string {
 	return b + "_" + after
 }
 
-func canCopy(tt types.Type) bool {
+func (g *gen) canCopy(tt types.Type) bool {
 	t := tt.Underlying()
 	switch typ := t.(type) {
 	case *types.Basic:
 		return typ.Kind() != types.UntypedNil
 	case *types.Struct:
 		for i := 0; i < typ.NumFields(); i++ {
+			if g.skip(typ.Tag(i)) {
+				// assignment would also copy the skipped field.
+				return false
+			}
 			f := typ.Field(i)
 			ft := f.Type()
-			if !canCopy(ft) {
+			if !g.canCopy(ft) {
 				return false
 			}
 		}
 		return true
 	case *types.Array:
-		return canCopy(typ.Elem())
+		return g.canCopy(typ.Elem())
+	}
+	return false
+}
+
+// skip returns whether a struct field with the given struct tag is skipped.
+func (g *gen) skip(tag string) bool {
+	for _, plugin := range g.plugins {
+		if derive.SkipTag(tag, plugin) {
+			return true
+		}
 	}
 	return false
 }
@@ -347,7 +424,7 @@ func hasDeepCopyMethod(input types.Type) bool {
 
 func (g *gen) genField(fieldType types.Type, thisField, thatField string) error {
 	p := g.printer
-	if canCopy(fieldType) {
+	if g.canCopy(fieldType) {
 		p.P("%s = %s", thatField, thisField)
 		return nil
 	}
@@ -363,7 +440,7 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 		p.P("%s = new(%s)", thatField, g.TypeString(typ.Elem()))
 		if hasDeepCopyMethod(ref) {
 			p.P("%s.DeepCopy(%s)", wrap(thisField), thatField)
-		} else if canCopy(typ.Elem()) {
+		} else if g.canCopy(typ.Elem()) {
 			p.P("*%s = *%s", thatField, thisField)
 		} else {
 			p.P("%s(%s, %s)", g.GetFuncName(typ), thatField, thisField)
@@ -408,7 +485,7 @@ func (g *gen) genField(fieldType types.Type, thisField, thatField string) error
 		p.P("}") // not nil
 		if hasDeepCopyMethod(fieldType) {
 			p.P("%s.DeepCopy(%s)", wrap(thisField), thatField)
-		} else if canCopy(typ.Elem()) {
+		} else if g.canCopy(typ.Elem()) {
 			p.P("copy(%s, %s)", thatField, thisField)
 		} else {
 			p.P("%s(%s, %s)", g.GetFuncName(typ), thatField, thisField)
@@ -16,7 +16,7 @@
 //
 // The deriveDo function executes a list of functions concurrently and returns their results.
 //
-//	deriveDo(func() (A, error), func (B, error)) (A, B, error)
+//	deriveDo(func() (A, error), func() (B, error)) (A, B, error)
 //
 // Each function is executed in a go routine and the first error is returned.
 // It waits for all functions to complete.
@@ -100,6 +100,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("do", "deriveDo", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func() (A, error), func() (B, error)) (A, B, error)")
+}
+
 // New is a constructor for the do code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
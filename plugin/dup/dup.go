@@ -14,9 +14,9 @@
 
 // Package dup contains the implementation of the dup plugin, which generates the deriveDup function.
 //
-// The deriveDup duplicates messages received on c to both c1 and c2.
+// The deriveDup function duplicates the messages received on a channel to two new channels.
 //
-//	deriveDup(c <-chan T) (c1, c2 <-chan T)
+//	deriveDup(<-chan T) (<-chan T, <-chan T)
 package dup
 
 import (
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("dup", "deriveDup", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(<-chan T) (<-chan T, <-chan T)")
+}
+
 // New is a constructor for the dup code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
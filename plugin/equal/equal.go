@@ -51,10 +51,12 @@
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
-//   - private fields of structs in external packages (using reflect and unsafe)
+//   - private fields of structs in external packages (using reflect and unsafe),
+//     except in safe mode, where the Equal method of the external type is used instead
 //   - and many more
 //
 // Unsupported types:
@@ -63,6 +65,10 @@
 //   - function
 //   - unnamed structs, which are not comparable with the == operator
 //
+// Fields with the struct tag `derive:"-"` or `derive:"equal=-"` are not compared.
+//
+// A type declaration with the comment //goderive:methods equal generates an Equal method, which calls deriveEqual, for the type.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/main/example/plugin/equal
 //
@@ -83,6 +89,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("equal", "deriveEqual", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(T, T) bool",
+		"(T) func(T) bool",
+	)
+}
+
 // New is a constructor for the equal code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -101,6 +114,12 @@ type gen struct {
 	bytesPkg   derive.Import
 	reflectPkg derive.Import
 	unsafePkg  derive.Import
+	safe       bool
+}
+
+// SetSafe makes the generator use the Equal method of an external type, instead of reflect and unsafe to access its private fields.
+func (g *gen) SetSafe() {
+	g.safe = true
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -123,6 +142,31 @@ func (g *gen) Generate(typs []types.Type) error {
 	return g.genFunc(typs)
 }
 
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "Equal"
+}
+
+// MethodTypes returns the input types of the deriveEqual function that is called by the Equal method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	ptr := types.NewPointer(typ)
+	return []types.Type{ptr, ptr}
+}
+
+// GenerateMethod generates an Equal method that calls the deriveEqual function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	typeStr := g.TypeString(types.NewPointer(typ))
+	p.P("")
+	p.P("// Equal returns whether this and that are equal.")
+	p.P("func (this %s) Equal(that %s) bool {", typeStr, typeStr)
+	p.In()
+	p.P("return %s(this, that)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genCurriedFunc(typ types.Type) error {
 	p := g.printer
 	g.Generating(typ)
@@ -135,7 +179,7 @@ func (g *gen) genCurriedFunc(typ types.Type) error {
 	p.P("return func(that %s) bool {", typeStr)
 	p.In()
 	if err := g.genStatement(typ, "this", "that"); err != nil {
-		return nil
+		return err
 	}
 	p.Out()
 	p.P("}")
@@ -169,7 +213,7 @@ func (g *gen) genFunc(typs []types.Type) error {
 	}
 	p.In()
 	if err := g.genStatement(typs[0], "this", "that"); err != nil {
-		return nil
+		return err
 	}
 	p.Out()
 	p.P("}")
@@ -209,11 +253,25 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 		}
 		if isNamed {
 			external := g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("equal")
+			for _, field := range fields.Skipped {
+				p.P(field.SkipComment())
+			}
 			if len(fields.Fields) == 0 {
 				p.P("return (%s == nil && %s == nil) || (%s != nil) && (%s != nil)", this, that, this, that)
 				return nil
 			}
+			if fields.Reflect && g.safe {
+				if equalMethodInputParam(named) == nil {
+					return fields.PrivateExternal().SafeError(g.TypeString(named))
+				}
+				fieldStr, err := g.field(this, that, typ)
+				if err != nil {
+					return err
+				}
+				p.P("return " + fieldStr)
+				return nil
+			}
 			if fields.Reflect {
 				p.P(`thisv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, this)
 				p.P(`thatv := `+g.reflectPkg()+`.Indirect(`+g.reflectPkg()+`.ValueOf(%s))`, that)
@@ -260,7 +318,14 @@ func (g *gen) genStatement(typ types.Type, this, that string) error {
 			return nil
 		}
 
-		fields := derive.Fields(g.TypesMap, ttyp, false)
+		fields := derive.Fields(g.TypesMap, ttyp, false).For("equal")
+		for _, field := range fields.Skipped {
+			p.P(field.SkipComment())
+		}
+		if len(fields.Fields) == 0 {
+			p.P("return true")
+			return nil
+		}
 		for i, field := range fields.Fields {
 			fieldType := field.Type
 			thisField, thatField := field.Name(this, nil), field.Name(that, nil)
@@ -381,6 +446,10 @@ func canEqual(tt types.Type) bool {
 		return typ.Kind() != types.UntypedNil
 	case *types.Struct:
 		for i := 0; i < typ.NumFields(); i++ {
+			if derive.SkipTag(typ.Tag(i), "equal") {
+				// == would also compare the skipped field.
+				return false
+			}
 			f := typ.Field(i)
 			ft := f.Type()
 			if !canEqual(ft) {
@@ -16,7 +16,7 @@
 //
 // The deriveFilter function applies a predicate to each element of a list, returning a list of filtered results in the same order.
 //
-//	func deriveFilter(func (T) bool, []T) []T
+//	deriveFilter(func(T) bool, []T) []T
 package filter
 
 import (
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("filter", "deriveFilter", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(T) bool, []T) []T")
+}
+
 // New is a constructor for the filter code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
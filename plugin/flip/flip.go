@@ -16,7 +16,7 @@
 //
 // The deriveFlip function flips the first two parameters of the input function.
 //
-//	deriveFlip(f func(A, B, ...) T) func(B, A, ...) T
+//	deriveFlip(func(A, B, ...) T) func(B, A, ...) T
 package flip
 
 import (
@@ -33,6 +33,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("flip", "deriveFlip", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A, B, ...) T) func(B, A, ...) T")
+}
+
 // New is a constructor for the flip code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -49,6 +49,18 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("fmap", "deriveFmap", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(func(A) B, []A) []B",
+		"(func(rune) B, string) []B",
+		"(func(A) B, func() (A, error)) (B, error)",
+		"(func(A) (B, error), func() (A, error)) (func() (B, error), error)",
+		"(func(A), func() (A, error)) error",
+		"(func(A) (B, c, d, ...), func() (A, error)) (func() (B, c, d, ...), error)",
+		"(func(A) B, <-chan A) <-chan B",
+	)
+}
+
 // New is a constructor for the fmap code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
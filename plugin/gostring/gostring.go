@@ -17,6 +17,8 @@
 // The deriveGoString function returns a string that reproduces the argument's value in valid go syntax.
 // The deriveGoString function does a recursive print, even printing pointer values, unlike the default %#v operand.
 //
+//	deriveGoString(T) string
+//
 // When goderive walks over your code it is looking for a function that:
 //   - was not implemented (or was previously derived) and
 //   - has a predefined prefix.
@@ -47,6 +49,7 @@
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
@@ -59,6 +62,10 @@
 //   - private fields
 //   - unnamed structs
 //
+// Fields with the struct tag `derive:"-"` or `derive:"gostring=-"` are left out of the generated Go syntax.
+//
+// A type declaration with the comment //goderive:methods gostring generates a GoString method, which calls deriveGoString, for the type.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/main/example/plugin/gostring
 //
@@ -78,6 +85,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("gostring", "deriveGoString", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(T) string")
+}
+
 // New is a constructor for the gostring code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -109,6 +120,29 @@ func (g *gen) Generate(typs []types.Type) error {
 	return g.genFunc(typs[0])
 }
 
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "GoString"
+}
+
+// MethodTypes returns the input types of the deriveGoString function that is called by the GoString method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	return []types.Type{types.NewPointer(typ)}
+}
+
+// GenerateMethod generates a GoString method that calls the deriveGoString function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	p.P("")
+	p.P("// GoString returns a recursive representation of this as a valid go string.")
+	p.P("func (this %s) GoString() string {", g.TypesMap.TypeString(types.NewPointer(typ)))
+	p.In()
+	p.P("return %s(this)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) TypeString(typ types.Type) string {
 	return g.TypesMap.(bypass).TypeStringBypass(typ)
 }
@@ -181,7 +215,10 @@ func (g *gen) genStatement(typ types.Type, this string) error {
 		} else {
 			gotypeStr := g.TypeString(reftyp)
 			external := isNamed && g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("gostring")
+			for _, field := range fields.Skipped {
+				p.P(field.SkipComment())
+			}
 			if len(fields.Fields) == 0 {
 				g.W("return &%s{}", gotypeStr)
 			} else {
@@ -202,7 +239,10 @@ func (g *gen) genStatement(typ types.Type, this string) error {
 		p.P("}")
 		return nil
 	case *types.Struct:
-		fields := derive.Fields(g.TypesMap, ttyp, false)
+		fields := derive.Fields(g.TypesMap, ttyp, false).For("gostring")
+		for _, field := range fields.Skipped {
+			p.P(field.SkipComment())
+		}
 		gotypeStr := g.TypeString(typ)
 		g.W("%s := &%s{}", this, gotypeStr)
 		for _, field := range fields.Fields {
@@ -21,6 +21,7 @@
 // Supported types:
 //   - basic types
 //   - named structs
+//   - instantiated generic structs, for example Pair[string, *User]
 //   - slices
 //   - maps
 //   - pointers to these types
@@ -32,6 +33,10 @@
 //   - function
 //   - unnamed structs, which are not comparable with the == operator
 //
+// Fields with the struct tag `derive:"-"` or `derive:"hash=-"` are not included in the hash.
+//
+// A type declaration with the comment //goderive:methods hash generates a Hash method, which calls deriveHash, for the type.
+//
 // Example output can be found here:
 // https://github.com/awalterschulze/goderive/tree/main/example/plugin/hash
 //
@@ -52,6 +57,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("hash", "deriveHash", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(T) uint64")
+}
+
 // New is a constructor for the hash code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -83,6 +92,29 @@ func (g *gen) Generate(typs []types.Type) error {
 	return g.genFunc(typs)
 }
 
+// MethodName returns the name of the method that is generated for the //goderive:methods directive.
+func (g *gen) MethodName() string {
+	return "Hash"
+}
+
+// MethodTypes returns the input types of the deriveHash function that is called by the Hash method.
+func (g *gen) MethodTypes(typ *types.Named) []types.Type {
+	return []types.Type{types.NewPointer(typ)}
+}
+
+// GenerateMethod generates a Hash method that calls the deriveHash function.
+func (g *gen) GenerateMethod(typ *types.Named, funcName string) error {
+	p := g.printer
+	p.P("")
+	p.P("// Hash returns the hash of the object.")
+	p.P("func (this %s) Hash() uint64 {", g.TypeString(types.NewPointer(typ)))
+	p.In()
+	p.P("return %s(this)", funcName)
+	p.Out()
+	p.P("}")
+	return nil
+}
+
 func (g *gen) genFunc(typs []types.Type) error {
 	p := g.printer
 	g.Generating(typs...)
@@ -156,7 +188,10 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 		p.P("}")
 		if isStruct && isNamed {
 			external := g.TypesMap.IsExternal(named)
-			fields := derive.Fields(g.TypesMap, strct, external)
+			fields := derive.Fields(g.TypesMap, strct, external).For("hash")
+			for _, field := range fields.Skipped {
+				p.P(field.SkipComment())
+			}
 			if len(fields.Fields) == 0 {
 				p.P("return 17")
 				return nil
@@ -193,7 +228,10 @@ func (g *gen) genStatement(o string, typ types.Type) error {
 			p.P("return " + fieldStr)
 			return nil
 		} else {
-			fields := derive.Fields(g.TypesMap, ttyp, false)
+			fields := derive.Fields(g.TypesMap, ttyp, false).For("hash")
+			for _, field := range fields.Skipped {
+				p.P(field.SkipComment())
+			}
 			if len(fields.Fields) == 0 {
 				p.P("return 17")
 				return nil
@@ -14,8 +14,8 @@
 
 // Package intersect contains the implementation of the intersect plugin, which generates the deriveIntersect function.
 //
-//	func deriveIntersect([]T, []T) []T
-//	func deriveIntersect(map[T]struct{}, map[T]struct{}) map[T]struct{}
+//	deriveIntersect([]T, []T) []T
+//	deriveIntersect(map[T]struct{}, map[T]struct{}) map[T]struct{}
 //
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/intersect
 package intersect
@@ -33,6 +33,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("intersect", "deriveIntersect", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"([]T, []T) []T",
+		"(map[T]struct{}, map[T]struct{}) map[T]struct{}",
+	)
+}
+
 // New is a constructor for the intersect code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
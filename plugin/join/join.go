@@ -55,6 +55,21 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("join", "deriveJoin", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"([][]T) []T",
+		"([]string) string",
+		"(func() (T, error), error) func() (T, error)",
+		"(func() error, error) func() error",
+		"(func() (T, ..., error), error) func() (T, ..., error)",
+		"(<-chan <-chan T) <-chan T",
+		"(chan <-chan T) <-chan T",
+		"([]<-chan T) <-chan T",
+		"([]chan T) <-chan T",
+		"(chan T, chan T, ...) <-chan T",
+	)
+}
+
 // New is a constructor for the join code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -16,12 +16,20 @@
 //
 // The deriveKeys function returns a map's keys as a slice.
 //
+//	deriveKeys(map[K]V) []K
+//
+// The sorted option returns the keys in sorted order, using deriveSort, and can be set in a goderive.json file:
+//
+//	"options": {"keys": {"sorted": "true"}}
+//
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/keys
 package keys
 
 import (
+	"errors"
 	"fmt"
 	"go/types"
+	"strconv"
 
 	"awalterschulze.org/go/goderive/derive"
 )
@@ -32,18 +40,45 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("keys", "deriveKeys", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(map[K]V) []K")
+}
+
 // New is a constructor for the keys code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
 	return &gen{
 		TypesMap: typesMap,
 		printer:  p,
+		sort:     deps["sort"],
 	}
 }
 
 type gen struct {
 	derive.TypesMap
 	printer derive.Printer
+	sort    derive.Dependency
+	sorted  bool
+}
+
+// Configure sets the options of the keys plugin.
+func (g *gen) Configure(options map[string]string) error {
+	for key, value := range options {
+		switch key {
+		case "sorted":
+			sorted, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("option sorted: %v", err)
+			}
+			if sorted && g.sort == nil {
+				return errors.New("option sorted requires the sort plugin")
+			}
+			g.sorted = sorted
+		default:
+			return fmt.Errorf("unknown option %s", key)
+		}
+	}
+	return nil
 }
 
 func (g *gen) Add(name string, typs []types.Type) (string, error) {
@@ -70,7 +105,11 @@ func (g *gen) genFuncFor(typ *types.Map) error {
 	keyType := typ.Key()
 	keyTypeStr := g.TypeString(keyType)
 	p.P("")
-	p.P("// %s returns the keys of the input map as a slice.", name)
+	if g.sorted {
+		p.P("// %s returns the keys of the input map as a sorted slice.", name)
+	} else {
+		p.P("// %s returns the keys of the input map as a slice.", name)
+	}
 	p.P("//")
 	p.P("// Deprecated: In favour of generics.")
 	p.P("func %s(m %s) []%s {", name, typeStr, keyTypeStr)
@@ -81,7 +120,11 @@ func (g *gen) genFuncFor(typ *types.Map) error {
 	p.P("keys = append(keys, key)")
 	p.Out()
 	p.P("}")
-	p.P("return keys")
+	if g.sorted {
+		p.P("return %s(keys)", g.sort.GetFuncName(types.NewSlice(keyType)))
+	} else {
+		p.P("return keys")
+	}
 	p.Out()
 	p.P("}")
 	return nil
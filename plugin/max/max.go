@@ -16,7 +16,7 @@
 //
 // The deriveMax function returns the maximum of two arguments.
 //
-//	func deriveMax(T, T) T
+//	deriveMax(T, T) T
 //
 // deriveMax is a generic version of
 //
@@ -27,7 +27,7 @@
 //
 // It can also return the maximum element in a list.
 //
-//	func deriveMax(list []T, default T) (max T)
+//	deriveMax([]T, T) T
 //
 // A default value is provided for the empty list.
 //
@@ -47,6 +47,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("max", "deriveMax", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(T, T) T",
+		"([]T, T) T",
+	)
+}
+
 // New is a constructor for the max code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
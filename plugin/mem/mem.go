@@ -16,7 +16,7 @@
 //
 // The deriveMem function returns a memoized version of the input function.
 //
-//	func deriveMem(func(A) B) func(A) B
+//	deriveMem(func(A) B) func(A) B
 package mem
 
 import (
@@ -35,6 +35,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("mem", "deriveMem", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A) B) func(A) B")
+}
+
 // New is a constructor for the mem code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -16,7 +16,7 @@
 //
 // The deriveMin function returns the minimum of two arguments.
 //
-//	func deriveMin(T, T) T
+//	deriveMin(T, T) T
 //
 // deriveMin is a generic version of
 //
@@ -27,7 +27,7 @@
 //
 // It can also return the minimum element in a list.
 //
-//	func deriveMin(list []T, default T) (min T)
+//	deriveMin([]T, T) T
 //
 // A default value is provided for the empty list.
 //
@@ -47,6 +47,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("min", "deriveMin", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"(T, T) T",
+		"([]T, T) T",
+	)
+}
+
 // New is a constructor for the min code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -35,6 +35,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("pipeline", "derivePipeline", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A) <-chan B, func(B) <-chan C) func(A) <-chan C")
+}
+
 // New is a constructor for the pipeline code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -14,7 +14,7 @@
 
 // Package set contains the implementation of the set plugin, which generates the deriveSet function.
 //
-//	func deriveSet([]T) map[T]struct{}
+//	deriveSet([]T) map[T]struct{}
 //
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/set
 package set
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("set", "deriveSet", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "([]T) map[T]struct{}")
+}
+
 // New is a constructor for the set code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -17,6 +17,8 @@
 // The deriveSort function is useful for deterministically ranging over maps when used with deriveKeys.
 // deriveSort supports only the types that deriveCompare supports, since it uses it for sorting.
 //
+//	deriveSort([]T) []T
+//
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/sort
 //
 // Even though sort returns a list it also mutates the input list.
@@ -35,6 +37,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("sort", "deriveSort", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "([]T) []T")
+}
+
 // New is a constructor for the sort code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -0,0 +1,93 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+// Package std contains the standard plugins of goderive.
+// Importing this package also registers all of them, see derive.Register.
+package std
+
+import (
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/all"
+	"awalterschulze.org/go/goderive/plugin/any"
+	"awalterschulze.org/go/goderive/plugin/apply"
+	"awalterschulze.org/go/goderive/plugin/clone"
+	"awalterschulze.org/go/goderive/plugin/compare"
+	"awalterschulze.org/go/goderive/plugin/compose"
+	"awalterschulze.org/go/goderive/plugin/contains"
+	"awalterschulze.org/go/goderive/plugin/curry"
+	"awalterschulze.org/go/goderive/plugin/deepcopy"
+	"awalterschulze.org/go/goderive/plugin/do"
+	"awalterschulze.org/go/goderive/plugin/dup"
+	"awalterschulze.org/go/goderive/plugin/equal"
+	"awalterschulze.org/go/goderive/plugin/filter"
+	"awalterschulze.org/go/goderive/plugin/flip"
+	"awalterschulze.org/go/goderive/plugin/fmap"
+	"awalterschulze.org/go/goderive/plugin/gostring"
+	"awalterschulze.org/go/goderive/plugin/hash"
+	"awalterschulze.org/go/goderive/plugin/intersect"
+	"awalterschulze.org/go/goderive/plugin/join"
+	"awalterschulze.org/go/goderive/plugin/keys"
+	"awalterschulze.org/go/goderive/plugin/max"
+	"awalterschulze.org/go/goderive/plugin/mem"
+	"awalterschulze.org/go/goderive/plugin/min"
+	"awalterschulze.org/go/goderive/plugin/pipeline"
+	"awalterschulze.org/go/goderive/plugin/set"
+	"awalterschulze.org/go/goderive/plugin/sort"
+	"awalterschulze.org/go/goderive/plugin/takewhile"
+	"awalterschulze.org/go/goderive/plugin/toerror"
+	"awalterschulze.org/go/goderive/plugin/traverse"
+	"awalterschulze.org/go/goderive/plugin/tuple"
+	"awalterschulze.org/go/goderive/plugin/uncurry"
+	"awalterschulze.org/go/goderive/plugin/union"
+	"awalterschulze.org/go/goderive/plugin/unique"
+)
+
+// Plugins returns a new instance of every standard plugin.
+func Plugins() []derive.Plugin {
+	return []derive.Plugin{
+		equal.NewPlugin(),
+		compare.NewPlugin(),
+		fmap.NewPlugin(),
+		join.NewPlugin(),
+		keys.NewPlugin(),
+		sort.NewPlugin(),
+		deepcopy.NewPlugin(),
+		set.NewPlugin(),
+		min.NewPlugin(),
+		max.NewPlugin(),
+		contains.NewPlugin(),
+		intersect.NewPlugin(),
+		union.NewPlugin(),
+		filter.NewPlugin(),
+		takewhile.NewPlugin(),
+		unique.NewPlugin(),
+		flip.NewPlugin(),
+		toerror.NewPlugin(),
+		curry.NewPlugin(),
+		uncurry.NewPlugin(),
+		all.NewPlugin(),
+		any.NewPlugin(),
+		tuple.NewPlugin(),
+		gostring.NewPlugin(),
+		compose.NewPlugin(),
+		do.NewPlugin(),
+		pipeline.NewPlugin(),
+		dup.NewPlugin(),
+		clone.NewPlugin(),
+		hash.NewPlugin(),
+		mem.NewPlugin(),
+		traverse.NewPlugin(),
+		apply.NewPlugin(),
+	}
+}
@@ -16,7 +16,7 @@
 //
 // The deriveTakeWhile function returns the elements of the input list until the predicate fails.
 //
-//	func deriveTakeWhile(func (T) bool, []T) []T
+//	deriveTakeWhile(func(T) bool, []T) []T
 package takewhile
 
 import (
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("takewhile", "deriveTakeWhile", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(T) bool, []T) []T")
+}
+
 // New is a constructor for the takewhile code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
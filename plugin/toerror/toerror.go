@@ -16,7 +16,7 @@
 //
 // The deriveToError function transforms return type of a function from (B..., bool) into (B..., error).
 //
-//	deriveToError(e error, f func(A...) (B..., bool)) func(A...) (B..., error)
+//	deriveToError(error, func(A...) (B..., bool)) func(A...) (B..., error)
 package toerror
 
 import (
@@ -33,6 +33,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("toerror", "deriveToError", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(error, func(A...) (B..., bool)) func(A...) (B..., error)")
+}
+
 // New is a constructor for the toerror code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -32,6 +32,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("traverse", "deriveTraverse", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A) (B, error), []A) ([]B, error)")
+}
+
 // New is a constructor for the traverse code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -36,6 +36,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("tuple", "deriveTuple", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(A, B, ...) func() (A, B, ...)")
+}
+
 // New is a constructor for the tuple code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -16,7 +16,7 @@
 //
 // The deriveUncurry function uncurries the input function.
 //
-//	deriveUncurry(f func(A) func(B, ...) T) func(A, B, ...) T
+//	deriveUncurry(func(A) func(B, ...) T) func(A, B, ...) T
 package uncurry
 
 import (
@@ -33,6 +33,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("uncurry", "deriveUncurry", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "(func(A) func(B, ...) T) func(A, B, ...) T")
+}
+
 // New is a constructor for the uncurry code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
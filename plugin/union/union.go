@@ -14,8 +14,8 @@
 
 // Package union contains the implementation of the union plugin, which generates the deriveUnion function.
 //
-//	func deriveUnion([]T, []T) []T
-//	func deriveUnion(map[T]struct{}, map[T]struct{}) map[T]struct{}
+//	deriveUnion([]T, []T) []T
+//	deriveUnion(map[T]struct{}, map[T]struct{}) map[T]struct{}
 //
 // Example: https://github.com/awalterschulze/goderive/tree/main/example/plugin/union
 package union
@@ -33,6 +33,13 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("union", "deriveUnion", New)
 }
 
+func init() {
+	derive.Register(NewPlugin,
+		"([]T, []T) []T",
+		"(map[T]struct{}, map[T]struct{}) map[T]struct{}",
+	)
+}
+
 // New is a constructor for the union code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
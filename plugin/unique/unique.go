@@ -36,6 +36,10 @@ func NewPlugin() derive.Plugin {
 	return derive.NewPlugin("unique", "deriveUnique", New)
 }
 
+func init() {
+	derive.Register(NewPlugin, "([]T) []T")
+}
+
 // New is a constructor for the unique code generator.
 // This generator should be reconstructed for each package.
 func New(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
@@ -0,0 +1,5 @@
+package foo
+
+type Foo struct {
+	Name string
+}
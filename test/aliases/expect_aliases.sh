@@ -0,0 +1,21 @@
+cp aliases.gold aliases.go
+goderive .
+status=0
+if ! grep -q "^	afoo \"awalterschulze.org/go/goderive/test/aliases/a/foo\"$" ./derived.gen.go || ! grep -q "^	bfoo \"awalterschulze.org/go/goderive/test/aliases/b/foo\"$" ./derived.gen.go; then
+    echo "expected the packages with the same name to be imported with the name of their parent directory, but got:"
+    cat ./derived.gen.go
+    status=1
+elif ! go vet .; then
+    echo "expected the derived file to compile"
+    status=1
+else
+    cp derived.gen.go first.gen.go
+    goderive .
+    if ! diff first.gen.go derived.gen.go; then
+        echo "expected the same aliases for every run"
+        status=1
+    fi
+    rm ./first.gen.go
+fi
+rm ./aliases.go ./derived.gen.go
+exit $status
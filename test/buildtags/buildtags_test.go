@@ -0,0 +1,26 @@
+//go:build integration
+
+package buildtags
+
+import (
+	"testing"
+)
+
+type Integration struct {
+	Name string
+}
+
+func (this *Integration) Equal(that *Integration) bool {
+	return deriveEqual(this, that)
+}
+
+func TestEqualWithBuildTag(t *testing.T) {
+	this := &Integration{Name: "A"}
+	that := &Integration{Name: "B"}
+	if !this.Equal(this) {
+		t.Fatal("expected equal")
+	}
+	if this.Equal(that) {
+		t.Fatal("expected not equal")
+	}
+}
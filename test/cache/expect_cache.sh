@@ -0,0 +1,22 @@
+cachedir=$(mktemp -d)
+cp cache.gold cache.go
+goderive -v -cachedir=$cachedir . 2> ./first.log
+cp derived.gen.go ./first.gen
+rm derived.gen.go
+goderive -v -cachedir=$cachedir . 2> ./second.log
+status=0
+if ! grep -q "cache: 0 hits, 1 misses" ./first.log; then
+    echo "expected a cache miss on the first run, but got:"
+    cat ./first.log
+    status=1
+elif ! grep -q "cache: 1 hits, 0 misses" ./second.log; then
+    echo "expected a cache hit on the second run, but got:"
+    cat ./second.log
+    status=1
+elif ! diff ./first.gen ./derived.gen.go; then
+    echo "expected the cached derived.gen.go to be the same as the generated one"
+    status=1
+fi
+rm -rf $cachedir
+rm ./cache.go ./derived.gen.go ./first.gen ./first.log ./second.log
+exit $status
@@ -0,0 +1,22 @@
+cp check.gold check.go
+if goderive -check . ; then
+    echo "expected check to fail, since derived.gen.go does not exist yet"
+    rm ./check.go
+    exit 1
+fi
+if [ -f ./derived.gen.go ]; then
+    echo "expected check to not create derived.gen.go"
+    rm ./derived.gen.go
+    rm ./check.go
+    exit 1
+fi
+goderive .
+if ! goderive -check . ; then
+    echo "expected check to succeed, since derived.gen.go is up to date"
+    rm ./derived.gen.go
+    rm ./check.go
+    exit 1
+fi
+rm ./check.go
+rm ./derived.gen.go
+exit 0
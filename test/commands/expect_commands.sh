@@ -0,0 +1,24 @@
+cp commands.gold commands.go
+status=0
+if ! goderive list | grep -q "^equal  *deriveEqual  *deriveEqual(T, T) bool$"; then
+    echo "expected the equal plugin to be listed with its prefix and signature"
+    status=1
+elif ! goderive explain deriveEqual_PtrTo_B . > ./explain.txt || ! grep -q "^	called at commands.go:13:9$" ./explain.txt || ! grep -q "^deriveEqual_PtrTo_B(\*B, \*B) in derived.gen.go, generated by the equal plugin$" ./explain.txt; then
+    echo "expected the explanation to include the types and the call, that required the function, but got:"
+    cat ./explain.txt
+    status=1
+elif [ -f derived.gen.go ]; then
+    echo "expected explain not to write the derived file"
+    status=1
+elif ! goderive gen . || ! goderive check .; then
+    echo "expected the derived file to be up to date after gen"
+    status=1
+elif ! goderive clean . || [ -f derived.gen.go ]; then
+    echo "expected clean to remove the derived file"
+    status=1
+elif goderive check . > /dev/null 2>&1; then
+    echo "expected check to fail after clean"
+    status=1
+fi
+rm -f ./commands.go ./derived.gen.go ./explain.txt
+exit $status
@@ -0,0 +1,19 @@
+cp config.gold config.go
+goderive .
+status=0
+if [ -f ./derived.gen.go ]; then
+    echo "expected the output filename from goderive.json to be used instead of derived.gen.go"
+    rm ./derived.gen.go
+    status=1
+elif ! grep -q "^func eq(" ./config.gen.go; then
+    echo "expected the equal prefix from goderive.json to be used"
+    status=1
+elif grep -q "GoString" ./config.gen.go; then
+    echo "expected the gostring plugin to be disabled by goderive.json"
+    status=1
+elif ! grep -q "return deriveSort_SliceOf_string(keys)" ./config.gen.go; then
+    echo "expected the sorted option of the keys plugin from goderive.json to be used"
+    status=1
+fi
+rm ./config.go ./config.gen.go
+exit $status
@@ -0,0 +1,22 @@
+cp custom.gold custom.go
+go build -o ./goderive-custom.bin ./goderive-custom
+status=0
+if ! ./goderive-custom.bin list | grep -q "^name  *deriveName  *deriveName(T) string$"; then
+    echo "expected the custom plugin to be listed"
+    status=1
+elif ! GODERIVE_CUSTOM_DUPLICATE=1 ./goderive-custom.bin list 2>&1 | grep -q "^plugin name is passed more than once$"; then
+    echo "expected a plugin that is passed twice to be rejected"
+    status=1
+elif ! ./goderive-custom.bin -nocache .; then
+    echo "expected the custom binary to generate the derived file"
+    status=1
+elif ! grep -q "^func deriveName(\*A) string {$" ./derived.gen.go || ! grep -q "^func deriveEqual(this, that \*A) bool {$" ./derived.gen.go; then
+    echo "expected functions from both the custom plugin and the standard plugins, but got:"
+    cat ./derived.gen.go
+    status=1
+elif ! go vet .; then
+    echo "expected the derived file to compile"
+    status=1
+fi
+rm -f ./custom.go ./derived.gen.go ./goderive-custom.bin
+exit $status
@@ -0,0 +1,57 @@
+// Command goderive-custom is a custom goderive binary, with the standard plugins and a name plugin.
+package main
+
+import (
+	"fmt"
+	"go/types"
+	"os"
+
+	"awalterschulze.org/go/goderive/derive"
+	"awalterschulze.org/go/goderive/plugin/std"
+)
+
+func main() {
+	plugins := append(std.Plugins(), newPlugin())
+	if os.Getenv("GODERIVE_CUSTOM_DUPLICATE") == "1" {
+		// Passing a plugin twice is an error.
+		plugins = append(plugins, newPlugin())
+	}
+	derive.Main(plugins)
+}
+
+func newPlugin() derive.Plugin {
+	return derive.NewPlugin("name", "deriveName", newName)
+}
+
+func init() {
+	derive.Register(newPlugin, "(T) string")
+}
+
+func newName(typesMap derive.TypesMap, p derive.Printer, deps map[string]derive.Dependency) derive.Generator {
+	return &name{typesMap, p}
+}
+
+// name generates a function that returns the name of the type of its argument.
+type name struct {
+	derive.TypesMap
+	printer derive.Printer
+}
+
+func (g *name) Add(name string, typs []types.Type) (string, error) {
+	if len(typs) != 1 {
+		return "", fmt.Errorf("%s does not have one argument", name)
+	}
+	return g.SetFuncName(name, typs[0])
+}
+
+func (g *name) Generate(typs []types.Type) error {
+	g.Generating(typs...)
+	typeStr := g.TypeString(typs[0])
+	g.printer.P("")
+	g.printer.P("func %s(%s) string {", g.GetFuncName(typs[0]), typeStr)
+	g.printer.In()
+	g.printer.P("return %q", typeStr)
+	g.printer.Out()
+	g.printer.P("}")
+	return nil
+}
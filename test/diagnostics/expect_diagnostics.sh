@@ -0,0 +1,18 @@
+cp diagnostics.gold diagnostics.go
+if goderive -json . > diagnostics.json ; then
+    echo "expected every unsupported call to be reported"
+    rm ./derived.gen.go
+    rm ./diagnostics.go
+    rm ./diagnostics.json
+    exit 1
+fi
+sed -i "s#$(pwd)/##" diagnostics.json
+if ! diff expected.json diagnostics.json ; then
+    echo "expected the diagnostics to match expected.json"
+    rm ./diagnostics.go
+    rm ./diagnostics.json
+    exit 1
+fi
+rm ./diagnostics.go
+rm ./diagnostics.json
+exit 0
@@ -0,0 +1,22 @@
+cp a/a.gold a/a.go
+cp b/b.gold b/b.go
+status=0
+if ! goderive -safe ./... ; then
+    echo "expected the derived function of b to be reused in safe mode, instead of accessing its private field"
+    status=1
+elif ! grep -q "^func DeriveEqual_PtrTo_T(this, that \*T) bool {$" b/derived.gen.go ; then
+    echo "expected b to export its derived function"
+    cat b/derived.gen.go
+    status=1
+elif ! grep -q "return b.DeriveEqual_PtrTo_T(this, that)" a/derived.gen.go ; then
+    echo "expected a to call the exported function of b"
+    cat a/derived.gen.go
+    status=1
+elif ! goderive check -safe ./... ; then
+    echo "expected the derived files to be up to date after a single run"
+    status=1
+elif ! go vet ./... ; then
+    status=1
+fi
+rm -f ./a/a.go ./a/derived.gen.go ./b/b.go ./b/derived.gen.go
+exit $status
@@ -3,6 +3,7 @@ package extra
 import (
 	"math/rand"
 	"reflect"
+	"testing/quick"
 )
 
 type StructWithoutEqualMethod struct {
@@ -54,3 +55,24 @@ func (this *PrivateFieldAndNoEqualMethod) Generate(rand *rand.Rand, size int) re
 	this.strct.Number = rand.Int63()
 	return reflect.ValueOf(this)
 }
+
+type Box[T any] struct {
+	Contents T
+	label    string
+}
+
+func (this *Box[T]) Generate(rand *rand.Rand, size int) reflect.Value {
+	if size == 0 {
+		this = nil
+		return reflect.ValueOf(this)
+	}
+	this = &Box[T]{}
+	contents, _ := quick.Value(reflect.TypeOf(this.Contents), rand)
+	this.Contents = contents.Interface().(T)
+	if size == 1 {
+		return reflect.ValueOf(this)
+	}
+	label, _ := quick.Value(reflect.TypeOf(this.label), rand)
+	this.label = label.Interface().(string)
+	return reflect.ValueOf(this)
+}
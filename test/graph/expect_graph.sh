@@ -0,0 +1,9 @@
+cp graph.gold graph.go
+goderive -graph=dot . > ./got.dot
+status=0
+if ! diff graph.dot ./got.dot; then
+    echo "unexpected graph"
+    status=1
+fi
+rm ./graph.go ./derived.gen.go ./got.dot
+exit $status
@@ -0,0 +1,22 @@
+cachedir=$(mktemp -d)
+cp header.gold header.go
+goderive -v -cachedir=$cachedir -header=license.txt -build='!purego' -generate='goderive .' -metadata . 2> ./first.log
+goderive -v -cachedir=$cachedir -header=license.txt -build='!purego' -generate='goderive .' -metadata . 2> ./second.log
+status=0
+if ! head -n 9 derived.gen.go | diff top.gold -; then
+    echo "expected the license, build constraint and go:generate directive above the generated comment"
+    status=1
+elif ! sed -n 10p derived.gen.go | grep -q "^// goderive .* with plugins .*equal"; then
+    echo "expected the version and plugins below the generated comment"
+    status=1
+elif ! go vet . || go vet -tags=purego . 2> /dev/null; then
+    echo "expected the derived file to be excluded by the build constraint"
+    status=1
+elif ! grep -q "cache: 1 hits, 0 misses" ./second.log; then
+    echo "expected the derived file with a header to be recognized as a derived file by the cache, but got:"
+    cat ./second.log
+    status=1
+fi
+rm -rf $cachedir
+rm ./header.go ./derived.gen.go ./first.log ./second.log
+exit $status
@@ -30,6 +30,8 @@ func TestCloneStructs(t *testing.T) {
 		&Empty{},
 		&BuiltInTypes{},
 		&PtrToBuiltInTypes{},
+		&Generics{},
+		&Methods{},
 	}
 	for _, this := range structs {
 		desc := reflect.TypeOf(this).Elem().Name()
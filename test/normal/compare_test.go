@@ -52,6 +52,8 @@ func TestCompareStructs(t *testing.T) {
 		&Duration{},
 		&Nickname{},
 		&PrivateEmbedded{},
+		&Generics{},
+		&Methods{},
 	}
 	for _, this := range structs {
 		desc := reflect.TypeOf(this).Elem().Name()
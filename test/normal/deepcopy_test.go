@@ -52,6 +52,8 @@ func TestDeepCopyStructs(t *testing.T) {
 		&Nickname{},
 		&PrivateEmbedded{},
 		&StructOfStructs{},
+		&Generics{},
+		&Methods{},
 	}
 	for _, this := range structs {
 		desc := reflect.TypeOf(this).Elem().Name()
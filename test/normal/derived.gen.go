@@ -11,73 +11,10 @@ import (
 	"reflect"
 	"sort"
 	"strings"
-	"sync"
 	"time"
 	"unsafe"
 )
 
-// deriveTakeWhile returns the prefix of the list, where each item matches the predicate.
-//
-// Deprecated: In favour of generics.
-func deriveTakeWhile(predicate func(int) bool, list []int) []int {
-	out := make([]int, 0, len(list))
-	for i, elem := range list {
-		if !predicate(elem) {
-			break
-		}
-		out = append(out, list[i])
-	}
-	return out
-}
-
-// deriveIntersectSetOfInt64s returns the intersection of the two maps' keys.
-//
-// Deprecated: In favour of generics.
-func deriveIntersectSetOfInt64s(this, that map[int64]struct{}) map[int64]struct{} {
-	intersect := make(map[int64]struct{}, deriveMinInt(len(this), len(that)))
-	for k := range this {
-		if _, ok := that[k]; ok {
-			intersect[k] = struct{}{}
-		}
-	}
-	return intersect
-}
-
-// deriveIntersectOfInt64s returns the intersection of the two lists' values
-// It assumes that the first list only contains unique items.
-//
-// Deprecated: In favour of generics.
-func deriveIntersectOfInt64s(this, that []int64) []int64 {
-	intersect := make([]int64, 0, deriveMinInt(len(this), len(that)))
-	for i, v := range this {
-		if deriveContainsInt64s(that, v) {
-			intersect = append(intersect, this[i])
-		}
-	}
-	return intersect
-}
-
-// deriveTraverse returns a list where each element of the input list has been morphed by the input function or an error.
-func deriveTraverse(f func(string) (int, error), list []string) ([]int, error) {
-	out := make([]int, len(list))
-	var err error
-	for i, elem := range list {
-		out[i], err = f(elem)
-		if err != nil {
-			return nil, err
-		}
-	}
-	return out, nil
-}
-
-// derivePipeline composes f and g into a concurrent pipeline.
-func derivePipeline(f func(lines []string) <-chan string, g func(line string) <-chan int) func([]string) <-chan int {
-	return func(a []string) <-chan int {
-		b := f(a)
-		return deriveJoinChannels(deriveFmapChanChan(g, b))
-	}
-}
-
 // deriveGoStringEmpty returns a recursive representation of this as a valid go string.
 func deriveGoStringEmpty(this *Empty) string {
 	buf := bytes.NewBuffer(nil)
@@ -275,61 +212,61 @@ func deriveGoStringSliceOfPtrToBuiltInTypes(this *SliceOfPtrToBuiltInTypes) stri
 	} else {
 		fmt.Fprintf(buf, "this := &test.SliceOfPtrToBuiltInTypes{}\n")
 		if this.Bool != nil {
-			fmt.Fprintf(buf, "this.Bool = %s\n", deriveGoString(this.Bool))
+			fmt.Fprintf(buf, "this.Bool = %s\n", deriveGoString_SliceOf_PtrTo_bool(this.Bool))
 		}
 		if this.Byte != nil {
-			fmt.Fprintf(buf, "this.Byte = %s\n", deriveGoString_(this.Byte))
+			fmt.Fprintf(buf, "this.Byte = %s\n", deriveGoString_SliceOf_PtrTo_byte(this.Byte))
 		}
 		if this.Complex128 != nil {
-			fmt.Fprintf(buf, "this.Complex128 = %s\n", deriveGoString_1(this.Complex128))
+			fmt.Fprintf(buf, "this.Complex128 = %s\n", deriveGoString_SliceOf_PtrTo_complex128(this.Complex128))
 		}
 		if this.Complex64 != nil {
-			fmt.Fprintf(buf, "this.Complex64 = %s\n", deriveGoString_2(this.Complex64))
+			fmt.Fprintf(buf, "this.Complex64 = %s\n", deriveGoString_SliceOf_PtrTo_complex64(this.Complex64))
 		}
 		if this.Float64 != nil {
-			fmt.Fprintf(buf, "this.Float64 = %s\n", deriveGoString_3(this.Float64))
+			fmt.Fprintf(buf, "this.Float64 = %s\n", deriveGoString_SliceOf_PtrTo_float64(this.Float64))
 		}
 		if this.Float32 != nil {
-			fmt.Fprintf(buf, "this.Float32 = %s\n", deriveGoString_4(this.Float32))
+			fmt.Fprintf(buf, "this.Float32 = %s\n", deriveGoString_SliceOf_PtrTo_float32(this.Float32))
 		}
 		if this.Int != nil {
-			fmt.Fprintf(buf, "this.Int = %s\n", deriveGoString_5(this.Int))
+			fmt.Fprintf(buf, "this.Int = %s\n", deriveGoString_SliceOf_PtrTo_int(this.Int))
 		}
 		if this.Int16 != nil {
-			fmt.Fprintf(buf, "this.Int16 = %s\n", deriveGoString_6(this.Int16))
+			fmt.Fprintf(buf, "this.Int16 = %s\n", deriveGoString_SliceOf_PtrTo_int16(this.Int16))
 		}
 		if this.Int32 != nil {
-			fmt.Fprintf(buf, "this.Int32 = %s\n", deriveGoString_7(this.Int32))
+			fmt.Fprintf(buf, "this.Int32 = %s\n", deriveGoString_SliceOf_PtrTo_int32(this.Int32))
 		}
 		if this.Int64 != nil {
-			fmt.Fprintf(buf, "this.Int64 = %s\n", deriveGoString_8(this.Int64))
+			fmt.Fprintf(buf, "this.Int64 = %s\n", deriveGoString_SliceOf_PtrTo_int64(this.Int64))
 		}
 		if this.Int8 != nil {
-			fmt.Fprintf(buf, "this.Int8 = %s\n", deriveGoString_9(this.Int8))
+			fmt.Fprintf(buf, "this.Int8 = %s\n", deriveGoString_SliceOf_PtrTo_int8(this.Int8))
 		}
 		if this.Rune != nil {
-			fmt.Fprintf(buf, "this.Rune = %s\n", deriveGoString_7(this.Rune))
+			fmt.Fprintf(buf, "this.Rune = %s\n", deriveGoString_SliceOf_PtrTo_int32(this.Rune))
 		}
 		if this.String != nil {
-			fmt.Fprintf(buf, "this.String = %s\n", deriveGoString_10(this.String))
+			fmt.Fprintf(buf, "this.String = %s\n", deriveGoString_SliceOf_PtrTo_string(this.String))
 		}
 		if this.Uint != nil {
-			fmt.Fprintf(buf, "this.Uint = %s\n", deriveGoString_11(this.Uint))
+			fmt.Fprintf(buf, "this.Uint = %s\n", deriveGoString_SliceOf_PtrTo_uint(this.Uint))
 		}
 		if this.Uint16 != nil {
-			fmt.Fprintf(buf, "this.Uint16 = %s\n", deriveGoString_12(this.Uint16))
+			fmt.Fprintf(buf, "this.Uint16 = %s\n", deriveGoString_SliceOf_PtrTo_uint16(this.Uint16))
 		}
 		if this.Uint32 != nil {
-			fmt.Fprintf(buf, "this.Uint32 = %s\n", deriveGoString_13(this.Uint32))
+			fmt.Fprintf(buf, "this.Uint32 = %s\n", deriveGoString_SliceOf_PtrTo_uint32(this.Uint32))
 		}
 		if this.Uint64 != nil {
-			fmt.Fprintf(buf, "this.Uint64 = %s\n", deriveGoString_14(this.Uint64))
+			fmt.Fprintf(buf, "this.Uint64 = %s\n", deriveGoString_SliceOf_PtrTo_uint64(this.Uint64))
 		}
 		if this.Uint8 != nil {
-			fmt.Fprintf(buf, "this.Uint8 = %s\n", deriveGoString_(this.Uint8))
+			fmt.Fprintf(buf, "this.Uint8 = %s\n", deriveGoString_SliceOf_PtrTo_byte(this.Uint8))
 		}
 		if this.UintPtr != nil {
-			fmt.Fprintf(buf, "this.UintPtr = %s\n", deriveGoString_15(this.UintPtr))
+			fmt.Fprintf(buf, "this.UintPtr = %s\n", deriveGoString_SliceOf_PtrTo_uintptr(this.UintPtr))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -379,26 +316,26 @@ func deriveGoStringArrayOfPtrToBuiltInTypes(this *ArrayOfPtrToBuiltInTypes) stri
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
 		fmt.Fprintf(buf, "this := &test.ArrayOfPtrToBuiltInTypes{}\n")
-		fmt.Fprintf(buf, "this.Bool = %s\n", deriveGoString_16(this.Bool))
-		fmt.Fprintf(buf, "this.Byte = %s\n", deriveGoString_17(this.Byte))
-		fmt.Fprintf(buf, "this.Complex128 = %s\n", deriveGoString_18(this.Complex128))
-		fmt.Fprintf(buf, "this.Complex64 = %s\n", deriveGoString_19(this.Complex64))
-		fmt.Fprintf(buf, "this.Float64 = %s\n", deriveGoString_20(this.Float64))
-		fmt.Fprintf(buf, "this.Float32 = %s\n", deriveGoString_21(this.Float32))
-		fmt.Fprintf(buf, "this.Int = %s\n", deriveGoString_22(this.Int))
-		fmt.Fprintf(buf, "this.Int16 = %s\n", deriveGoString_23(this.Int16))
-		fmt.Fprintf(buf, "this.Int32 = %s\n", deriveGoString_24(this.Int32))
-		fmt.Fprintf(buf, "this.Int64 = %s\n", deriveGoString_25(this.Int64))
-		fmt.Fprintf(buf, "this.Int8 = %s\n", deriveGoString_26(this.Int8))
-		fmt.Fprintf(buf, "this.Rune = %s\n", deriveGoString_27(this.Rune))
-		fmt.Fprintf(buf, "this.String = %s\n", deriveGoString_28(this.String))
-		fmt.Fprintf(buf, "this.Uint = %s\n", deriveGoString_29(this.Uint))
-		fmt.Fprintf(buf, "this.Uint16 = %s\n", deriveGoString_30(this.Uint16))
-		fmt.Fprintf(buf, "this.Uint32 = %s\n", deriveGoString_31(this.Uint32))
-		fmt.Fprintf(buf, "this.Uint64 = %s\n", deriveGoString_32(this.Uint64))
-		fmt.Fprintf(buf, "this.Uint8 = %s\n", deriveGoString_33(this.Uint8))
-		fmt.Fprintf(buf, "this.UintPtr = %s\n", deriveGoString_34(this.UintPtr))
-		fmt.Fprintf(buf, "this.AnotherBoolOfDifferentSize = %s\n", deriveGoString_35(this.AnotherBoolOfDifferentSize))
+		fmt.Fprintf(buf, "this.Bool = %s\n", deriveGoString_ArrayOf1_PtrTo_bool(this.Bool))
+		fmt.Fprintf(buf, "this.Byte = %s\n", deriveGoString_ArrayOf2_PtrTo_byte(this.Byte))
+		fmt.Fprintf(buf, "this.Complex128 = %s\n", deriveGoString_ArrayOf3_PtrTo_complex128(this.Complex128))
+		fmt.Fprintf(buf, "this.Complex64 = %s\n", deriveGoString_ArrayOf4_PtrTo_complex64(this.Complex64))
+		fmt.Fprintf(buf, "this.Float64 = %s\n", deriveGoString_ArrayOf5_PtrTo_float64(this.Float64))
+		fmt.Fprintf(buf, "this.Float32 = %s\n", deriveGoString_ArrayOf6_PtrTo_float32(this.Float32))
+		fmt.Fprintf(buf, "this.Int = %s\n", deriveGoString_ArrayOf7_PtrTo_int(this.Int))
+		fmt.Fprintf(buf, "this.Int16 = %s\n", deriveGoString_ArrayOf8_PtrTo_int16(this.Int16))
+		fmt.Fprintf(buf, "this.Int32 = %s\n", deriveGoString_ArrayOf9_PtrTo_int32(this.Int32))
+		fmt.Fprintf(buf, "this.Int64 = %s\n", deriveGoString_ArrayOf10_PtrTo_int64(this.Int64))
+		fmt.Fprintf(buf, "this.Int8 = %s\n", deriveGoString_ArrayOf11_PtrTo_int8(this.Int8))
+		fmt.Fprintf(buf, "this.Rune = %s\n", deriveGoString_ArrayOf12_PtrTo_rune(this.Rune))
+		fmt.Fprintf(buf, "this.String = %s\n", deriveGoString_ArrayOf13_PtrTo_string(this.String))
+		fmt.Fprintf(buf, "this.Uint = %s\n", deriveGoString_ArrayOf14_PtrTo_uint(this.Uint))
+		fmt.Fprintf(buf, "this.Uint16 = %s\n", deriveGoString_ArrayOf15_PtrTo_uint16(this.Uint16))
+		fmt.Fprintf(buf, "this.Uint32 = %s\n", deriveGoString_ArrayOf16_PtrTo_uint32(this.Uint32))
+		fmt.Fprintf(buf, "this.Uint64 = %s\n", deriveGoString_ArrayOf17_PtrTo_uint64(this.Uint64))
+		fmt.Fprintf(buf, "this.Uint8 = %s\n", deriveGoString_ArrayOf18_PtrTo_uint8(this.Uint8))
+		fmt.Fprintf(buf, "this.UintPtr = %s\n", deriveGoString_ArrayOf19_PtrTo_uintptr(this.UintPtr))
+		fmt.Fprintf(buf, "this.AnotherBoolOfDifferentSize = %s\n", deriveGoString_ArrayOf10_PtrTo_bool(this.AnotherBoolOfDifferentSize))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
@@ -463,13 +400,13 @@ func deriveGoStringSliceToSlice(this *SliceToSlice) string {
 	} else {
 		fmt.Fprintf(buf, "this := &test.SliceToSlice{}\n")
 		if this.Ints != nil {
-			fmt.Fprintf(buf, "this.Ints = %s\n", deriveGoString_36(this.Ints))
+			fmt.Fprintf(buf, "this.Ints = %s\n", deriveGoString_SliceOf_SliceOf_int(this.Ints))
 		}
 		if this.Strings != nil {
-			fmt.Fprintf(buf, "this.Strings = %s\n", deriveGoString_37(this.Strings))
+			fmt.Fprintf(buf, "this.Strings = %s\n", deriveGoString_SliceOf_SliceOf_string(this.Strings))
 		}
 		if this.IntPtrs != nil {
-			fmt.Fprintf(buf, "this.IntPtrs = %s\n", deriveGoString_38(this.IntPtrs))
+			fmt.Fprintf(buf, "this.IntPtrs = %s\n", deriveGoString_SliceOf_SliceOf_PtrTo_int(this.IntPtrs))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -492,7 +429,7 @@ func deriveGoStringPtrTo(this *PtrTo) string {
 			fmt.Fprintf(buf, "this.Slice = %s\n", deriveGoStringIntPtrSlice(this.Slice))
 		}
 		if this.Array != nil {
-			fmt.Fprintf(buf, "this.Array = %s\n", deriveGoString_39(this.Array))
+			fmt.Fprintf(buf, "this.Array = %s\n", deriveGoString_PtrTo_ArrayOf4_int(this.Array))
 		}
 		if this.Map != nil {
 			fmt.Fprintf(buf, "this.Map = %s\n", deriveGoStringIntPtrMap(this.Map))
@@ -526,25 +463,25 @@ func deriveGoStringStructs(this *Structs) string {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
 		fmt.Fprintf(buf, "this := &test.Structs{}\n")
-		fmt.Fprintf(buf, "this.Struct = %s\n", deriveGoString_N(this.Struct))
+		fmt.Fprintf(buf, "this.Struct = %s\n", deriveGoString_Name(this.Struct))
 		if this.PtrToStruct != nil {
 			fmt.Fprintf(buf, "this.PtrToStruct = %s\n", deriveGoStringName(this.PtrToStruct))
 		}
 		if this.SliceOfStructs != nil {
-			fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_40(this.SliceOfStructs))
+			fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_SliceOf_Name(this.SliceOfStructs))
 		}
 		if this.SliceToPtrOfStruct != nil {
-			fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_41(this.SliceToPtrOfStruct))
+			fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_SliceOf_PtrTo_Name(this.SliceToPtrOfStruct))
 		}
-		fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_S(this.StructWithoutMethod))
+		fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_StructWithoutMethod(this.StructWithoutMethod))
 		if this.PtrToStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.PtrToStructWithoutMethod = %s\n", deriveGoString_42(this.PtrToStructWithoutMethod))
+			fmt.Fprintf(buf, "this.PtrToStructWithoutMethod = %s\n", deriveGoString_PtrTo_StructWithoutMethod(this.PtrToStructWithoutMethod))
 		}
 		if this.SliceOfStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.SliceOfStructWithoutMethod = %s\n", deriveGoString_43(this.SliceOfStructWithoutMethod))
+			fmt.Fprintf(buf, "this.SliceOfStructWithoutMethod = %s\n", deriveGoString_SliceOf_StructWithoutMethod(this.SliceOfStructWithoutMethod))
 		}
 		if this.SliceToPtrOfStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.SliceToPtrOfStructWithoutMethod = %s\n", deriveGoString_44(this.SliceToPtrOfStructWithoutMethod))
+			fmt.Fprintf(buf, "this.SliceToPtrOfStructWithoutMethod = %s\n", deriveGoString_SliceOf_PtrTo_StructWithoutMethod(this.SliceToPtrOfStructWithoutMethod))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -561,34 +498,34 @@ func deriveGoStringMapWithStructs(this *MapWithStructs) string {
 	} else {
 		fmt.Fprintf(buf, "this := &test.MapWithStructs{}\n")
 		if this.NameToString != nil {
-			fmt.Fprintf(buf, "this.NameToString = %s\n", deriveGoString_45(this.NameToString))
+			fmt.Fprintf(buf, "this.NameToString = %s\n", deriveGoString_MapOf_Name_To_string(this.NameToString))
 		}
 		if this.StringToName != nil {
-			fmt.Fprintf(buf, "this.StringToName = %s\n", deriveGoString_46(this.StringToName))
+			fmt.Fprintf(buf, "this.StringToName = %s\n", deriveGoString_MapOf_string_To_Name(this.StringToName))
 		}
 		if this.StringToPtrToName != nil {
-			fmt.Fprintf(buf, "this.StringToPtrToName = %s\n", deriveGoString_47(this.StringToPtrToName))
+			fmt.Fprintf(buf, "this.StringToPtrToName = %s\n", deriveGoString_MapOf_string_To_PtrTo_Name(this.StringToPtrToName))
 		}
 		if this.StringToSliceOfName != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfName = %s\n", deriveGoString_48(this.StringToSliceOfName))
+			fmt.Fprintf(buf, "this.StringToSliceOfName = %s\n", deriveGoString_MapOf_string_To_SliceOf_Name(this.StringToSliceOfName))
 		}
 		if this.StringToSliceOfPtrToName != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfPtrToName = %s\n", deriveGoString_49(this.StringToSliceOfPtrToName))
+			fmt.Fprintf(buf, "this.StringToSliceOfPtrToName = %s\n", deriveGoString_MapOf_string_To_SliceOf_PtrTo_Name(this.StringToSliceOfPtrToName))
 		}
 		if this.StringToStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.StringToStructWithoutMethod = %s\n", deriveGoString_50(this.StringToStructWithoutMethod))
+			fmt.Fprintf(buf, "this.StringToStructWithoutMethod = %s\n", deriveGoString_MapOf_string_To_StructWithoutMethod(this.StringToStructWithoutMethod))
 		}
 		if this.StructWithoutMethodToString != nil {
-			fmt.Fprintf(buf, "this.StructWithoutMethodToString = %s\n", deriveGoString_51(this.StructWithoutMethodToString))
+			fmt.Fprintf(buf, "this.StructWithoutMethodToString = %s\n", deriveGoString_MapOf_StructWithoutMethod_To_string(this.StructWithoutMethodToString))
 		}
 		if this.StringToPtrToStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.StringToPtrToStructWithoutMethod = %s\n", deriveGoString_52(this.StringToPtrToStructWithoutMethod))
+			fmt.Fprintf(buf, "this.StringToPtrToStructWithoutMethod = %s\n", deriveGoString_MapOf_string_To_PtrTo_StructWithoutMethod(this.StringToPtrToStructWithoutMethod))
 		}
 		if this.StringToSliceOfStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfStructWithoutMethod = %s\n", deriveGoString_53(this.StringToSliceOfStructWithoutMethod))
+			fmt.Fprintf(buf, "this.StringToSliceOfStructWithoutMethod = %s\n", deriveGoString_MapOf_string_To_SliceOf_StructWithoutMethod(this.StringToSliceOfStructWithoutMethod))
 		}
 		if this.StringToSliceOfPtrToStructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.StringToSliceOfPtrToStructWithoutMethod = %s\n", deriveGoString_54(this.StringToSliceOfPtrToStructWithoutMethod))
+			fmt.Fprintf(buf, "this.StringToSliceOfPtrToStructWithoutMethod = %s\n", deriveGoString_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this.StringToSliceOfPtrToStructWithoutMethod))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -608,7 +545,7 @@ func deriveGoStringRecursiveType(this *RecursiveType) string {
 			fmt.Fprintf(buf, "this.Bytes = %#v\n", this.Bytes)
 		}
 		if this.N != nil {
-			fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_55(this.N))
+			fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_MapOf_int_To_RecursiveType(this.N))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -624,8 +561,8 @@ func deriveGoStringEmbeddedStruct1(this *EmbeddedStruct1) string {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
 		fmt.Fprintf(buf, "this := &test.EmbeddedStruct1{}\n")
-		fmt.Fprintf(buf, "this.Name = %s\n", deriveGoString_N(this.Name))
-		fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_S(this.StructWithoutMethod))
+		fmt.Fprintf(buf, "this.Name = %s\n", deriveGoString_Name(this.Name))
+		fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_StructWithoutMethod(this.StructWithoutMethod))
 		if this.Structs != nil {
 			fmt.Fprintf(buf, "this.Structs = %s\n", deriveGoStringStructs(this.Structs))
 		}
@@ -643,12 +580,12 @@ func deriveGoStringEmbeddedStruct2(this *EmbeddedStruct2) string {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
 		fmt.Fprintf(buf, "this := &test.EmbeddedStruct2{}\n")
-		fmt.Fprintf(buf, "this.Structs = %s\n", deriveGoString_St(this.Structs))
+		fmt.Fprintf(buf, "this.Structs = %s\n", deriveGoString_Structs(this.Structs))
 		if this.Name != nil {
 			fmt.Fprintf(buf, "this.Name = %s\n", deriveGoStringName(this.Name))
 		}
 		if this.StructWithoutMethod != nil {
-			fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_42(this.StructWithoutMethod))
+			fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_PtrTo_StructWithoutMethod(this.StructWithoutMethod))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -665,9 +602,9 @@ func deriveGoStringStructWithStructFieldWithoutEqualMethod(this *StructWithStruc
 	} else {
 		fmt.Fprintf(buf, "this := &test.StructWithStructFieldWithoutEqualMethod{}\n")
 		if this.A != nil {
-			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_56(this.A))
+			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_PtrTo_StructWithoutEqualMethod(this.A))
 		}
-		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_Str(this.B))
+		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_StructWithoutEqualMethod(this.B))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
@@ -683,9 +620,9 @@ func deriveGoStringStructWithStructWithFromAnotherPackage(this *StructWithStruct
 	} else {
 		fmt.Fprintf(buf, "this := &test.StructWithStructWithFromAnotherPackage{}\n")
 		if this.A != nil {
-			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_57(this.A))
+			fmt.Fprintf(buf, "this.A = %s\n", deriveGoString_PtrTo_extra_StructWithoutEqualMethod(this.A))
 		}
-		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_Stru(this.B))
+		fmt.Fprintf(buf, "this.B = %s\n", deriveGoString_extra_StructWithoutEqualMethod(this.B))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
@@ -702,21 +639,21 @@ func deriveGoStringEnums(this *Enums) string {
 		fmt.Fprintf(buf, "this := &test.Enums{}\n")
 		fmt.Fprintf(buf, "this.Enum = %#v\n", this.Enum)
 		if this.PtrToEnum != nil {
-			fmt.Fprintf(buf, "this.PtrToEnum = %s\n", deriveGoString_58(this.PtrToEnum))
+			fmt.Fprintf(buf, "this.PtrToEnum = %s\n", deriveGoString_PtrTo_MyEnum(this.PtrToEnum))
 		}
 		if this.SliceToEnum != nil {
-			fmt.Fprintf(buf, "this.SliceToEnum = %s\n", deriveGoString_59(this.SliceToEnum))
+			fmt.Fprintf(buf, "this.SliceToEnum = %s\n", deriveGoString_SliceOf_MyEnum(this.SliceToEnum))
 		}
 		if this.SliceToPtrToEnum != nil {
-			fmt.Fprintf(buf, "this.SliceToPtrToEnum = %s\n", deriveGoString_60(this.SliceToPtrToEnum))
+			fmt.Fprintf(buf, "this.SliceToPtrToEnum = %s\n", deriveGoString_SliceOf_PtrTo_MyEnum(this.SliceToPtrToEnum))
 		}
 		if this.MapToEnum != nil {
-			fmt.Fprintf(buf, "this.MapToEnum = %s\n", deriveGoString_61(this.MapToEnum))
+			fmt.Fprintf(buf, "this.MapToEnum = %s\n", deriveGoString_MapOf_int32_To_MyEnum(this.MapToEnum))
 		}
 		if this.EnumToMap != nil {
-			fmt.Fprintf(buf, "this.EnumToMap = %s\n", deriveGoString_62(this.EnumToMap))
+			fmt.Fprintf(buf, "this.EnumToMap = %s\n", deriveGoString_MapOf_MyEnum_To_int32(this.EnumToMap))
 		}
-		fmt.Fprintf(buf, "this.ArrayEnum = %s\n", deriveGoString_63(this.ArrayEnum))
+		fmt.Fprintf(buf, "this.ArrayEnum = %s\n", deriveGoString_ArrayOf2_MyEnum(this.ArrayEnum))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
@@ -735,10 +672,10 @@ func deriveGoStringNamedTypes(this *NamedTypes) string {
 			fmt.Fprintf(buf, "this.Slice = %#v\n", this.Slice)
 		}
 		if this.PtrToSlice != nil {
-			fmt.Fprintf(buf, "this.PtrToSlice = %s\n", deriveGoString_64(this.PtrToSlice))
+			fmt.Fprintf(buf, "this.PtrToSlice = %s\n", deriveGoString_PtrTo_MySlice(this.PtrToSlice))
 		}
 		if this.SliceToSlice != nil {
-			fmt.Fprintf(buf, "this.SliceToSlice = %s\n", deriveGoString_65(this.SliceToSlice))
+			fmt.Fprintf(buf, "this.SliceToSlice = %s\n", deriveGoString_SliceOf_MySlice(this.SliceToSlice))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -756,16 +693,16 @@ func deriveGoStringDuration(this *Duration) string {
 		fmt.Fprintf(buf, "this := &test.Duration{}\n")
 		fmt.Fprintf(buf, "this.D = %#v\n", this.D)
 		if this.P != nil {
-			fmt.Fprintf(buf, "this.P = %s\n", deriveGoString_66(this.P))
+			fmt.Fprintf(buf, "this.P = %s\n", deriveGoString_PtrTo_time_Duration(this.P))
 		}
 		if this.Ds != nil {
-			fmt.Fprintf(buf, "this.Ds = %s\n", deriveGoString_67(this.Ds))
+			fmt.Fprintf(buf, "this.Ds = %s\n", deriveGoString_SliceOf_time_Duration(this.Ds))
 		}
 		if this.DPs != nil {
-			fmt.Fprintf(buf, "this.DPs = %s\n", deriveGoString_68(this.DPs))
+			fmt.Fprintf(buf, "this.DPs = %s\n", deriveGoString_SliceOf_PtrTo_time_Duration(this.DPs))
 		}
 		if this.MD != nil {
-			fmt.Fprintf(buf, "this.MD = %s\n", deriveGoString_69(this.MD))
+			fmt.Fprintf(buf, "this.MD = %s\n", deriveGoString_MapOf_int_To_time_Duration(this.MD))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -782,7 +719,7 @@ func deriveGoStringNickname(this *Nickname) string {
 	} else {
 		fmt.Fprintf(buf, "this := &test.Nickname{}\n")
 		if this.Alias != nil {
-			fmt.Fprintf(buf, "this.Alias = %s\n", deriveGoString_70(this.Alias))
+			fmt.Fprintf(buf, "this.Alias = %s\n", deriveGoString_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this.Alias))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -798,39 +735,39 @@ func deriveGoStringPrivateEmbedded(this *PrivateEmbedded) string {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
 		fmt.Fprintf(buf, "this := &test.PrivateEmbedded{}\n")
-		fmt.Fprintf(buf, "this.privateStruct = %s\n", deriveGoString_p(this.privateStruct))
+		fmt.Fprintf(buf, "this.privateStruct = %s\n", deriveGoString_privateStruct(this.privateStruct))
 		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoStringIntSlices returns a recursive representation of this as a valid go string.
-func deriveGoStringIntSlices(this []int) string {
+// deriveGoStringCache returns a recursive representation of this as a valid go string.
+func deriveGoStringCache(this *Cache) string {
 	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() []int {\n")
+	fmt.Fprintf(buf, "func() *test.Cache {\n")
 	if this == nil {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
-		fmt.Fprintf(buf, "return %#v\n", this)
+		// mu is skipped, since it is tagged with `derive:"-"`.
+		// Updated is skipped, since it is tagged with `derive:"-"`.
+		fmt.Fprintf(buf, "this := &test.Cache{}\n")
+		fmt.Fprintf(buf, "this.Key = %#v\n", this.Key)
+		if this.Values != nil {
+			fmt.Fprintf(buf, "this.Values = %#v\n", this.Values)
+		}
+		fmt.Fprintf(buf, "this.Hits = %#v\n", this.Hits)
+		fmt.Fprintf(buf, "this.Entry = %s\n", deriveGoString_CacheEntry(this.Entry))
+		fmt.Fprintf(buf, "return this\n")
 	}
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoStringIntArray returns a recursive representation of this as a valid go string.
-func deriveGoStringIntArray(this [10]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() [10]int {\n")
-	fmt.Fprintf(buf, "return %#v\n", this)
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
-// deriveGoStringMapOfIntToInt returns a recursive representation of this as a valid go string.
-func deriveGoStringMapOfIntToInt(this map[int]int) string {
+// deriveGoStringIntSlices returns a recursive representation of this as a valid go string.
+func deriveGoStringIntSlices(this []int) string {
 	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() map[int]int {\n")
+	fmt.Fprintf(buf, "func() []int {\n")
 	if this == nil {
 		fmt.Fprintf(buf, "return nil\n")
 	} else {
@@ -872,21 +809,6 @@ func deriveGoStringIntPtrSlice(this *[]int) string {
 	return buf.String()
 }
 
-// deriveGoStringIntPtrArray returns a recursive representation of this as a valid go string.
-func deriveGoStringIntPtrArray(this *[10]int) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() *[10]int {\n")
-	if this == nil {
-		fmt.Fprintf(buf, "return nil\n")
-	} else {
-		fmt.Fprintf(buf, "this := new([10]int)\n")
-		fmt.Fprintf(buf, "*this = %#v\n", *this)
-		fmt.Fprintf(buf, "return this\n")
-	}
-	fmt.Fprintf(buf, "}()\n")
-	return buf.String()
-}
-
 // deriveGoStringIntPtrMap returns a recursive representation of this as a valid go string.
 func deriveGoStringIntPtrMap(this *map[int]int) string {
 	buf := bytes.NewBuffer(nil)
@@ -904,31 +826,24 @@ func deriveGoStringIntPtrMap(this *map[int]int) string {
 	return buf.String()
 }
 
-// deriveGoStringNoPointerStruct returns a recursive representation of this as a valid go string.
-func deriveGoStringNoPointerStruct(this BuiltInTypes) string {
-	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() test.BuiltInTypes {\n")
-	fmt.Fprintf(buf, "this := &test.BuiltInTypes{}\n")
-	fmt.Fprintf(buf, "this.Bool = %#v\n", this.Bool)
-	fmt.Fprintf(buf, "this.Byte = %#v\n", this.Byte)
-	fmt.Fprintf(buf, "this.Complex128 = %#v\n", this.Complex128)
-	fmt.Fprintf(buf, "this.Complex64 = %#v\n", this.Complex64)
-	fmt.Fprintf(buf, "this.Float64 = %#v\n", this.Float64)
-	fmt.Fprintf(buf, "this.Float32 = %#v\n", this.Float32)
-	fmt.Fprintf(buf, "this.Int = %#v\n", this.Int)
-	fmt.Fprintf(buf, "this.Int16 = %#v\n", this.Int16)
-	fmt.Fprintf(buf, "this.Int32 = %#v\n", this.Int32)
-	fmt.Fprintf(buf, "this.Int64 = %#v\n", this.Int64)
-	fmt.Fprintf(buf, "this.Int8 = %#v\n", this.Int8)
-	fmt.Fprintf(buf, "this.Rune = %#v\n", this.Rune)
-	fmt.Fprintf(buf, "this.String = %#v\n", this.String)
-	fmt.Fprintf(buf, "this.Uint = %#v\n", this.Uint)
-	fmt.Fprintf(buf, "this.Uint16 = %#v\n", this.Uint16)
-	fmt.Fprintf(buf, "this.Uint32 = %#v\n", this.Uint32)
-	fmt.Fprintf(buf, "this.Uint64 = %#v\n", this.Uint64)
-	fmt.Fprintf(buf, "this.Uint8 = %#v\n", this.Uint8)
-	fmt.Fprintf(buf, "this.UintPtr = %#v\n", this.UintPtr)
-	fmt.Fprintf(buf, "return *this\n")
+// deriveGoString_PtrTo_Methods returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_Methods(this *Methods) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *test.Methods {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &test.Methods{}\n")
+		fmt.Fprintf(buf, "this.Name = %#v\n", this.Name)
+		if this.Aliases != nil {
+			fmt.Fprintf(buf, "this.Aliases = %#v\n", this.Aliases)
+		}
+		if this.Pair != nil {
+			fmt.Fprintf(buf, "this.Pair = %s\n", deriveGoString_PtrTo_Pair_string_int64(this.Pair))
+		}
+		fmt.Fprintf(buf, "this.Inner = %s\n", deriveGoString_Name(this.Inner))
+		fmt.Fprintf(buf, "return this\n")
+	}
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
@@ -1465,7 +1380,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Bool = make([]*bool, len(src.Bool))
 		}
-		deriveDeepCopy(dst.Bool, src.Bool)
+		deriveDeepCopy_SliceOf_PtrTo_bool(dst.Bool, src.Bool)
 	}
 	if src.Byte == nil {
 		dst.Byte = nil
@@ -1483,7 +1398,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Byte = make([]*byte, len(src.Byte))
 		}
-		deriveDeepCopy_(dst.Byte, src.Byte)
+		deriveDeepCopy_SliceOf_PtrTo_byte(dst.Byte, src.Byte)
 	}
 	if src.Complex128 == nil {
 		dst.Complex128 = nil
@@ -1501,7 +1416,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Complex128 = make([]*complex128, len(src.Complex128))
 		}
-		deriveDeepCopy_1(dst.Complex128, src.Complex128)
+		deriveDeepCopy_SliceOf_PtrTo_complex128(dst.Complex128, src.Complex128)
 	}
 	if src.Complex64 == nil {
 		dst.Complex64 = nil
@@ -1519,7 +1434,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Complex64 = make([]*complex64, len(src.Complex64))
 		}
-		deriveDeepCopy_2(dst.Complex64, src.Complex64)
+		deriveDeepCopy_SliceOf_PtrTo_complex64(dst.Complex64, src.Complex64)
 	}
 	if src.Float64 == nil {
 		dst.Float64 = nil
@@ -1537,7 +1452,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Float64 = make([]*float64, len(src.Float64))
 		}
-		deriveDeepCopy_3(dst.Float64, src.Float64)
+		deriveDeepCopy_SliceOf_PtrTo_float64(dst.Float64, src.Float64)
 	}
 	if src.Float32 == nil {
 		dst.Float32 = nil
@@ -1555,7 +1470,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Float32 = make([]*float32, len(src.Float32))
 		}
-		deriveDeepCopy_4(dst.Float32, src.Float32)
+		deriveDeepCopy_SliceOf_PtrTo_float32(dst.Float32, src.Float32)
 	}
 	if src.Int == nil {
 		dst.Int = nil
@@ -1573,7 +1488,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Int = make([]*int, len(src.Int))
 		}
-		deriveDeepCopy_5(dst.Int, src.Int)
+		deriveDeepCopy_SliceOf_PtrTo_int(dst.Int, src.Int)
 	}
 	if src.Int16 == nil {
 		dst.Int16 = nil
@@ -1591,7 +1506,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Int16 = make([]*int16, len(src.Int16))
 		}
-		deriveDeepCopy_6(dst.Int16, src.Int16)
+		deriveDeepCopy_SliceOf_PtrTo_int16(dst.Int16, src.Int16)
 	}
 	if src.Int32 == nil {
 		dst.Int32 = nil
@@ -1609,7 +1524,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Int32 = make([]*int32, len(src.Int32))
 		}
-		deriveDeepCopy_7(dst.Int32, src.Int32)
+		deriveDeepCopy_SliceOf_PtrTo_int32(dst.Int32, src.Int32)
 	}
 	if src.Int64 == nil {
 		dst.Int64 = nil
@@ -1627,7 +1542,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Int64 = make([]*int64, len(src.Int64))
 		}
-		deriveDeepCopy_8(dst.Int64, src.Int64)
+		deriveDeepCopy_SliceOf_PtrTo_int64(dst.Int64, src.Int64)
 	}
 	if src.Int8 == nil {
 		dst.Int8 = nil
@@ -1645,7 +1560,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Int8 = make([]*int8, len(src.Int8))
 		}
-		deriveDeepCopy_9(dst.Int8, src.Int8)
+		deriveDeepCopy_SliceOf_PtrTo_int8(dst.Int8, src.Int8)
 	}
 	if src.Rune == nil {
 		dst.Rune = nil
@@ -1663,7 +1578,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Rune = make([]*rune, len(src.Rune))
 		}
-		deriveDeepCopy_7(dst.Rune, src.Rune)
+		deriveDeepCopy_SliceOf_PtrTo_int32(dst.Rune, src.Rune)
 	}
 	if src.String == nil {
 		dst.String = nil
@@ -1681,7 +1596,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.String = make([]*string, len(src.String))
 		}
-		deriveDeepCopy_10(dst.String, src.String)
+		deriveDeepCopy_SliceOf_PtrTo_string(dst.String, src.String)
 	}
 	if src.Uint == nil {
 		dst.Uint = nil
@@ -1699,7 +1614,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Uint = make([]*uint, len(src.Uint))
 		}
-		deriveDeepCopy_11(dst.Uint, src.Uint)
+		deriveDeepCopy_SliceOf_PtrTo_uint(dst.Uint, src.Uint)
 	}
 	if src.Uint16 == nil {
 		dst.Uint16 = nil
@@ -1717,7 +1632,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Uint16 = make([]*uint16, len(src.Uint16))
 		}
-		deriveDeepCopy_12(dst.Uint16, src.Uint16)
+		deriveDeepCopy_SliceOf_PtrTo_uint16(dst.Uint16, src.Uint16)
 	}
 	if src.Uint32 == nil {
 		dst.Uint32 = nil
@@ -1735,7 +1650,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Uint32 = make([]*uint32, len(src.Uint32))
 		}
-		deriveDeepCopy_13(dst.Uint32, src.Uint32)
+		deriveDeepCopy_SliceOf_PtrTo_uint32(dst.Uint32, src.Uint32)
 	}
 	if src.Uint64 == nil {
 		dst.Uint64 = nil
@@ -1753,7 +1668,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Uint64 = make([]*uint64, len(src.Uint64))
 		}
-		deriveDeepCopy_14(dst.Uint64, src.Uint64)
+		deriveDeepCopy_SliceOf_PtrTo_uint64(dst.Uint64, src.Uint64)
 	}
 	if src.Uint8 == nil {
 		dst.Uint8 = nil
@@ -1771,7 +1686,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.Uint8 = make([]*uint8, len(src.Uint8))
 		}
-		deriveDeepCopy_(dst.Uint8, src.Uint8)
+		deriveDeepCopy_SliceOf_PtrTo_byte(dst.Uint8, src.Uint8)
 	}
 	if src.UintPtr == nil {
 		dst.UintPtr = nil
@@ -1789,7 +1704,7 @@ func deriveDeepCopyPtrToSliceOfPtrToBuiltInTypes(dst, src *SliceOfPtrToBuiltInTy
 		} else {
 			dst.UintPtr = make([]*uintptr, len(src.UintPtr))
 		}
-		deriveDeepCopy_15(dst.UintPtr, src.UintPtr)
+		deriveDeepCopy_SliceOf_PtrTo_uintptr(dst.UintPtr, src.UintPtr)
 	}
 }
 
@@ -1985,13 +1900,13 @@ func deriveDeepCopyPtrToArrayOfPtrToBuiltInTypes(dst, src *ArrayOfPtrToBuiltInTy
 func deriveDeepCopyPtrToMapsOfSimplerBuiltInTypes(dst, src *MapsOfSimplerBuiltInTypes) {
 	if src.StringToUint32 != nil {
 		dst.StringToUint32 = make(map[string]uint32, len(src.StringToUint32))
-		deriveDeepCopy_16(dst.StringToUint32, src.StringToUint32)
+		deriveDeepCopy_MapOf_string_To_uint32(dst.StringToUint32, src.StringToUint32)
 	} else {
 		dst.StringToUint32 = nil
 	}
 	if src.Uint64ToInt64 != nil {
 		dst.Uint64ToInt64 = make(map[uint8]int64, len(src.Uint64ToInt64))
-		deriveDeepCopy_17(dst.Uint64ToInt64, src.Uint64ToInt64)
+		deriveDeepCopy_MapOf_uint8_To_int64(dst.Uint64ToInt64, src.Uint64ToInt64)
 	} else {
 		dst.Uint64ToInt64 = nil
 	}
@@ -2001,31 +1916,31 @@ func deriveDeepCopyPtrToMapsOfSimplerBuiltInTypes(dst, src *MapsOfSimplerBuiltIn
 func deriveDeepCopyPtrToMapsOfBuiltInTypes(dst, src *MapsOfBuiltInTypes) {
 	if src.BoolToString != nil {
 		dst.BoolToString = make(map[bool]string, len(src.BoolToString))
-		deriveDeepCopy_18(dst.BoolToString, src.BoolToString)
+		deriveDeepCopy_MapOf_bool_To_string(dst.BoolToString, src.BoolToString)
 	} else {
 		dst.BoolToString = nil
 	}
 	if src.StringToBool != nil {
 		dst.StringToBool = make(map[string]bool, len(src.StringToBool))
-		deriveDeepCopy_19(dst.StringToBool, src.StringToBool)
+		deriveDeepCopy_MapOf_string_To_bool(dst.StringToBool, src.StringToBool)
 	} else {
 		dst.StringToBool = nil
 	}
 	if src.Complex128ToComplex64 != nil {
 		dst.Complex128ToComplex64 = make(map[complex128]complex64, len(src.Complex128ToComplex64))
-		deriveDeepCopy_20(dst.Complex128ToComplex64, src.Complex128ToComplex64)
+		deriveDeepCopy_MapOf_complex128_To_complex64(dst.Complex128ToComplex64, src.Complex128ToComplex64)
 	} else {
 		dst.Complex128ToComplex64 = nil
 	}
 	if src.Float64ToUint32 != nil {
 		dst.Float64ToUint32 = make(map[float64]uint32, len(src.Float64ToUint32))
-		deriveDeepCopy_21(dst.Float64ToUint32, src.Float64ToUint32)
+		deriveDeepCopy_MapOf_float64_To_uint32(dst.Float64ToUint32, src.Float64ToUint32)
 	} else {
 		dst.Float64ToUint32 = nil
 	}
 	if src.Uint16ToUint8 != nil {
 		dst.Uint16ToUint8 = make(map[uint16]uint8, len(src.Uint16ToUint8))
-		deriveDeepCopy_22(dst.Uint16ToUint8, src.Uint16ToUint8)
+		deriveDeepCopy_MapOf_uint16_To_uint8(dst.Uint16ToUint8, src.Uint16ToUint8)
 	} else {
 		dst.Uint16ToUint8 = nil
 	}
@@ -2049,7 +1964,7 @@ func deriveDeepCopyPtrToSliceToSlice(dst, src *SliceToSlice) {
 		} else {
 			dst.Ints = make([][]int, len(src.Ints))
 		}
-		deriveDeepCopy_23(dst.Ints, src.Ints)
+		deriveDeepCopy_SliceOf_SliceOf_int(dst.Ints, src.Ints)
 	}
 	if src.Strings == nil {
 		dst.Strings = nil
@@ -2067,7 +1982,7 @@ func deriveDeepCopyPtrToSliceToSlice(dst, src *SliceToSlice) {
 		} else {
 			dst.Strings = make([][]string, len(src.Strings))
 		}
-		deriveDeepCopy_24(dst.Strings, src.Strings)
+		deriveDeepCopy_SliceOf_SliceOf_string(dst.Strings, src.Strings)
 	}
 	if src.IntPtrs == nil {
 		dst.IntPtrs = nil
@@ -2085,7 +2000,7 @@ func deriveDeepCopyPtrToSliceToSlice(dst, src *SliceToSlice) {
 		} else {
 			dst.IntPtrs = make([][]*int, len(src.IntPtrs))
 		}
-		deriveDeepCopy_25(dst.IntPtrs, src.IntPtrs)
+		deriveDeepCopy_SliceOf_SliceOf_PtrTo_int(dst.IntPtrs, src.IntPtrs)
 	}
 }
 
@@ -2101,7 +2016,7 @@ func deriveDeepCopyPtrToPtrTo(dst, src *PtrTo) {
 		dst.Slice = nil
 	} else {
 		dst.Slice = new([]int)
-		deriveDeepCopy_26(dst.Slice, src.Slice)
+		deriveDeepCopy_PtrTo_SliceOf_int(dst.Slice, src.Slice)
 	}
 	if src.Array == nil {
 		dst.Array = nil
@@ -2113,7 +2028,7 @@ func deriveDeepCopyPtrToPtrTo(dst, src *PtrTo) {
 		dst.Map = nil
 	} else {
 		dst.Map = new(map[int]int)
-		deriveDeepCopy_27(dst.Map, src.Map)
+		deriveDeepCopy_PtrTo_MapOf_int_To_int(dst.Map, src.Map)
 	}
 }
 
@@ -2165,7 +2080,7 @@ func deriveDeepCopyPtrToStructs(dst, src *Structs) {
 		} else {
 			dst.SliceToPtrOfStruct = make([]*Name, len(src.SliceToPtrOfStruct))
 		}
-		deriveDeepCopy_28(dst.SliceToPtrOfStruct, src.SliceToPtrOfStruct)
+		deriveDeepCopy_SliceOf_PtrTo_Name(dst.SliceToPtrOfStruct, src.SliceToPtrOfStruct)
 	}
 	dst.StructWithoutMethod = src.StructWithoutMethod
 	if src.PtrToStructWithoutMethod == nil {
@@ -2208,7 +2123,7 @@ func deriveDeepCopyPtrToStructs(dst, src *Structs) {
 		} else {
 			dst.SliceToPtrOfStructWithoutMethod = make([]*StructWithoutMethod, len(src.SliceToPtrOfStructWithoutMethod))
 		}
-		deriveDeepCopy_29(dst.SliceToPtrOfStructWithoutMethod, src.SliceToPtrOfStructWithoutMethod)
+		deriveDeepCopy_SliceOf_PtrTo_StructWithoutMethod(dst.SliceToPtrOfStructWithoutMethod, src.SliceToPtrOfStructWithoutMethod)
 	}
 }
 
@@ -2216,61 +2131,61 @@ func deriveDeepCopyPtrToStructs(dst, src *Structs) {
 func deriveDeepCopyPtrToMapWithStructs(dst, src *MapWithStructs) {
 	if src.NameToString != nil {
 		dst.NameToString = make(map[Name]string, len(src.NameToString))
-		deriveDeepCopy_30(dst.NameToString, src.NameToString)
+		deriveDeepCopy_MapOf_Name_To_string(dst.NameToString, src.NameToString)
 	} else {
 		dst.NameToString = nil
 	}
 	if src.StringToName != nil {
 		dst.StringToName = make(map[string]Name, len(src.StringToName))
-		deriveDeepCopy_31(dst.StringToName, src.StringToName)
+		deriveDeepCopy_MapOf_string_To_Name(dst.StringToName, src.StringToName)
 	} else {
 		dst.StringToName = nil
 	}
 	if src.StringToPtrToName != nil {
 		dst.StringToPtrToName = make(map[string]*Name, len(src.StringToPtrToName))
-		deriveDeepCopy_32(dst.StringToPtrToName, src.StringToPtrToName)
+		deriveDeepCopy_MapOf_string_To_PtrTo_Name(dst.StringToPtrToName, src.StringToPtrToName)
 	} else {
 		dst.StringToPtrToName = nil
 	}
 	if src.StringToSliceOfName != nil {
 		dst.StringToSliceOfName = make(map[string][]Name, len(src.StringToSliceOfName))
-		deriveDeepCopy_33(dst.StringToSliceOfName, src.StringToSliceOfName)
+		deriveDeepCopy_MapOf_string_To_SliceOf_Name(dst.StringToSliceOfName, src.StringToSliceOfName)
 	} else {
 		dst.StringToSliceOfName = nil
 	}
 	if src.StringToSliceOfPtrToName != nil {
 		dst.StringToSliceOfPtrToName = make(map[string][]*Name, len(src.StringToSliceOfPtrToName))
-		deriveDeepCopy_34(dst.StringToSliceOfPtrToName, src.StringToSliceOfPtrToName)
+		deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_Name(dst.StringToSliceOfPtrToName, src.StringToSliceOfPtrToName)
 	} else {
 		dst.StringToSliceOfPtrToName = nil
 	}
 	if src.StringToStructWithoutMethod != nil {
 		dst.StringToStructWithoutMethod = make(map[string]StructWithoutMethod, len(src.StringToStructWithoutMethod))
-		deriveDeepCopy_35(dst.StringToStructWithoutMethod, src.StringToStructWithoutMethod)
+		deriveDeepCopy_MapOf_string_To_StructWithoutMethod(dst.StringToStructWithoutMethod, src.StringToStructWithoutMethod)
 	} else {
 		dst.StringToStructWithoutMethod = nil
 	}
 	if src.StructWithoutMethodToString != nil {
 		dst.StructWithoutMethodToString = make(map[StructWithoutMethod]string, len(src.StructWithoutMethodToString))
-		deriveDeepCopy_36(dst.StructWithoutMethodToString, src.StructWithoutMethodToString)
+		deriveDeepCopy_MapOf_StructWithoutMethod_To_string(dst.StructWithoutMethodToString, src.StructWithoutMethodToString)
 	} else {
 		dst.StructWithoutMethodToString = nil
 	}
 	if src.StringToPtrToStructWithoutMethod != nil {
 		dst.StringToPtrToStructWithoutMethod = make(map[string]*StructWithoutMethod, len(src.StringToPtrToStructWithoutMethod))
-		deriveDeepCopy_37(dst.StringToPtrToStructWithoutMethod, src.StringToPtrToStructWithoutMethod)
+		deriveDeepCopy_MapOf_string_To_PtrTo_StructWithoutMethod(dst.StringToPtrToStructWithoutMethod, src.StringToPtrToStructWithoutMethod)
 	} else {
 		dst.StringToPtrToStructWithoutMethod = nil
 	}
 	if src.StringToSliceOfStructWithoutMethod != nil {
 		dst.StringToSliceOfStructWithoutMethod = make(map[string][]StructWithoutMethod, len(src.StringToSliceOfStructWithoutMethod))
-		deriveDeepCopy_38(dst.StringToSliceOfStructWithoutMethod, src.StringToSliceOfStructWithoutMethod)
+		deriveDeepCopy_MapOf_string_To_SliceOf_StructWithoutMethod(dst.StringToSliceOfStructWithoutMethod, src.StringToSliceOfStructWithoutMethod)
 	} else {
 		dst.StringToSliceOfStructWithoutMethod = nil
 	}
 	if src.StringToSliceOfPtrToStructWithoutMethod != nil {
 		dst.StringToSliceOfPtrToStructWithoutMethod = make(map[string][]*StructWithoutMethod, len(src.StringToSliceOfPtrToStructWithoutMethod))
-		deriveDeepCopy_39(dst.StringToSliceOfPtrToStructWithoutMethod, src.StringToSliceOfPtrToStructWithoutMethod)
+		deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(dst.StringToSliceOfPtrToStructWithoutMethod, src.StringToSliceOfPtrToStructWithoutMethod)
 	} else {
 		dst.StringToSliceOfPtrToStructWithoutMethod = nil
 	}
@@ -2298,7 +2213,7 @@ func deriveDeepCopyPtrToRecursiveType(dst, src *RecursiveType) {
 	}
 	if src.N != nil {
 		dst.N = make(map[int]RecursiveType, len(src.N))
-		deriveDeepCopy_40(dst.N, src.N)
+		deriveDeepCopy_MapOf_int_To_RecursiveType(dst.N, src.N)
 	} else {
 		dst.N = nil
 	}
@@ -2370,7 +2285,7 @@ func deriveDeepCopyPtrToFieldWithStructWithPrivateFields(dst, src *FieldWithStru
 		dst.A = nil
 	} else {
 		dst.A = new(extra.PrivateFieldAndNoEqualMethod)
-		deriveDeepCopy_41(dst.A, src.A)
+		deriveDeepCopy_PtrTo_extra_PrivateFieldAndNoEqualMethod(dst.A, src.A)
 	}
 }
 
@@ -2417,17 +2332,17 @@ func deriveDeepCopyPtrToEnums(dst, src *Enums) {
 		} else {
 			dst.SliceToPtrToEnum = make([]*MyEnum, len(src.SliceToPtrToEnum))
 		}
-		deriveDeepCopy_42(dst.SliceToPtrToEnum, src.SliceToPtrToEnum)
+		deriveDeepCopy_SliceOf_PtrTo_MyEnum(dst.SliceToPtrToEnum, src.SliceToPtrToEnum)
 	}
 	if src.MapToEnum != nil {
 		dst.MapToEnum = make(map[int32]MyEnum, len(src.MapToEnum))
-		deriveDeepCopy_43(dst.MapToEnum, src.MapToEnum)
+		deriveDeepCopy_MapOf_int32_To_MyEnum(dst.MapToEnum, src.MapToEnum)
 	} else {
 		dst.MapToEnum = nil
 	}
 	if src.EnumToMap != nil {
 		dst.EnumToMap = make(map[MyEnum]int32, len(src.EnumToMap))
-		deriveDeepCopy_44(dst.EnumToMap, src.EnumToMap)
+		deriveDeepCopy_MapOf_MyEnum_To_int32(dst.EnumToMap, src.EnumToMap)
 	} else {
 		dst.EnumToMap = nil
 	}
@@ -2458,7 +2373,7 @@ func deriveDeepCopyPtrToNamedTypes(dst, src *NamedTypes) {
 		dst.PtrToSlice = nil
 	} else {
 		dst.PtrToSlice = new(MySlice)
-		deriveDeepCopy_45(dst.PtrToSlice, src.PtrToSlice)
+		deriveDeepCopy_PtrTo_MySlice(dst.PtrToSlice, src.PtrToSlice)
 	}
 	if src.SliceToSlice == nil {
 		dst.SliceToSlice = nil
@@ -2476,7 +2391,7 @@ func deriveDeepCopyPtrToNamedTypes(dst, src *NamedTypes) {
 		} else {
 			dst.SliceToSlice = make([]MySlice, len(src.SliceToSlice))
 		}
-		deriveDeepCopy_46(dst.SliceToSlice, src.SliceToSlice)
+		deriveDeepCopy_SliceOf_MySlice(dst.SliceToSlice, src.SliceToSlice)
 	}
 }
 
@@ -2523,11 +2438,11 @@ func deriveDeepCopyPtrToDuration(dst, src *Duration) {
 		} else {
 			dst.DPs = make([]*time.Duration, len(src.DPs))
 		}
-		deriveDeepCopy_47(dst.DPs, src.DPs)
+		deriveDeepCopy_SliceOf_PtrTo_time_Duration(dst.DPs, src.DPs)
 	}
 	if src.MD != nil {
 		dst.MD = make(map[int]time.Duration, len(src.MD))
-		deriveDeepCopy_48(dst.MD, src.MD)
+		deriveDeepCopy_MapOf_int_To_time_Duration(dst.MD, src.MD)
 	} else {
 		dst.MD = nil
 	}
@@ -2537,7 +2452,7 @@ func deriveDeepCopyPtrToDuration(dst, src *Duration) {
 func deriveDeepCopyPtrToNickname(dst, src *Nickname) {
 	if src.Alias != nil {
 		dst.Alias = make(map[string][]*pickle.Rick, len(src.Alias))
-		deriveDeepCopy_49(dst.Alias, src.Alias)
+		deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(dst.Alias, src.Alias)
 	} else {
 		dst.Alias = nil
 	}
@@ -2547,7 +2462,7 @@ func deriveDeepCopyPtrToNickname(dst, src *Nickname) {
 func deriveDeepCopyPtrToPrivateEmbedded(dst, src *PrivateEmbedded) {
 	{
 		field := new(privateStruct)
-		deriveDeepCopy_50(field, &src.privateStruct)
+		deriveDeepCopy_PtrTo_privateStruct(field, &src.privateStruct)
 		dst.privateStruct = *field
 	}
 }
@@ -2566,245 +2481,106 @@ func deriveDeepCopyPtrToStructOfStructs(dst, src *StructOfStructs) {
 	}
 }
 
-// deriveDeepCopySimpleStructWithDeepCopy recursively copies the contents of src into dst.
-func deriveDeepCopySimpleStructWithDeepCopy(dst, src *SimpleStructWithDeepCopy) {
-	dst.Level = src.Level
-}
-
-// deriveDeepCopyAliasWithMethod recursively copies the contents of src into dst.
-func deriveDeepCopyAliasWithMethod(dst, src *aliasWithMethod) {
-	dst.Level = src.Level
-}
-
-// deriveContainsInt64s returns whether the item is contained in the list.
-//
-// Deprecated: In favour of generics.
-func deriveContainsInt64s(list []int64, item int64) bool {
-	for _, v := range list {
-		if v == item {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveContainsStruct returns whether the item is contained in the list.
-//
-// Deprecated: In favour of generics.
-func deriveContainsStruct(list []*BuiltInTypes, item *BuiltInTypes) bool {
-	for _, v := range list {
-		if deriveEqualPtrToBuiltInTypes(v, item) {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveContainsStructPtr returns whether the item is contained in the list.
-//
-// Deprecated: In favour of generics.
-func deriveContainsStructPtr(list []PtrToBuiltInTypes, item PtrToBuiltInTypes) bool {
-	for _, v := range list {
-		if deriveEqual(v, item) {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveUncurryMarshal combines a function that returns a function, into one function.
-func deriveUncurryMarshal(f func(data []byte) func(v any) error) func(data []byte, v any) error {
-	return func(data []byte, v any) error {
-		return f(data)(v)
-	}
-}
-
-// deriveUncurry3 combines a function that returns a function, into one function.
-func deriveUncurry3(f func(a int) func(b string, c bool) string) func(a int, b string, c bool) string {
-	return func(a int, b string, c bool) string {
-		return f(a)(b, c)
-	}
-}
-
-// deriveUncurryCurried combines a function that returns a function, into one function.
-func deriveUncurryCurried(f func(b string) func(c bool) string) func(b string, c bool) string {
-	return func(b string, c bool) string {
-		return f(b)(c)
-	}
-}
-
-// deriveUncurryBlankIdentifier combines a function that returns a function, into one function.
-func deriveUncurryBlankIdentifier(f func(param_0 string) func(innerParam_0 bool, c int) string) func(param_0 string, innerParam_0 bool, c int) string {
-	return func(param_0 string, innerParam_0 bool, c int) string {
-		return f(param_0)(innerParam_0, c)
-	}
-}
-
-// deriveToError0 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError0(err error, f func() bool) func() error {
-	return func() error {
-		success := f()
-		if success {
-			return nil
-		}
-		return err
-	}
-}
-
-// deriveToError1 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError1(err error, f func() (int, bool)) func() (int, error) {
-	return func() (int, error) {
-		out0, success := f()
-		if success {
-			return out0, nil
-		}
-		return out0, err
-	}
-}
-
-// deriveToError2 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError2(err error, f func(a int) (int, bool)) func(a int) (int, error) {
-	return func(a int) (int, error) {
-		out0, success := f(a)
-		if success {
-			return out0, nil
-		}
-		return out0, err
-	}
-}
-
-// deriveToError3 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError3(err error, f func(a int, b int) (int, bool)) func(a int, b int) (int, error) {
-	return func(a int, b int) (int, error) {
-		out0, success := f(a, b)
-		if success {
-			return out0, nil
-		}
-		return out0, err
-	}
-}
-
-// deriveToError4 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError4(err error, f func(a int, b int) (int, int, bool)) func(a int, b int) (int, int, error) {
-	return func(a int, b int) (int, int, error) {
-		out0, out1, success := f(a, b)
-		if success {
-			return out0, out1, nil
-		}
-		return out0, out1, err
-	}
-}
-
-// deriveToError5 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError5(err error, f func(lt *LocalType) (*LocalType, bool)) func(lt *LocalType) (*LocalType, error) {
-	return func(lt *LocalType) (*LocalType, error) {
-		out0, success := f(lt)
-		if success {
-			return out0, nil
-		}
-		return out0, err
+// deriveDeepCopyPtrToGenerics recursively copies the contents of src into dst.
+func deriveDeepCopyPtrToGenerics(dst, src *Generics) {
+	if src.IntTree == nil {
+		dst.IntTree = nil
+	} else {
+		dst.IntTree = new(Tree[int])
+		deriveDeepCopy_PtrTo_Tree_int(dst.IntTree, src.IntTree)
 	}
-}
-
-// deriveToError6 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError6(err error, f func(t *time.Time) (*time.Time, bool)) func(t *time.Time) (*time.Time, error) {
-	return func(t *time.Time) (*time.Time, error) {
-		out0, success := f(t)
-		if success {
-			return out0, nil
-		}
-		return out0, err
+	{
+		field := new(Tree[Name])
+		deriveDeepCopy_PtrTo_Tree_Name(field, &src.NameTree)
+		dst.NameTree = *field
 	}
-}
-
-// deriveToError7 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
-func deriveToError7(err error, f func(param_0 string) (string, bool)) func(param_0 string) (string, error) {
-	return func(param_0 string) (string, error) {
-		out0, success := f(param_0)
-		if success {
-			return out0, nil
+	if src.Pairs == nil {
+		dst.Pairs = nil
+	} else {
+		if dst.Pairs != nil {
+			if len(src.Pairs) > len(dst.Pairs) {
+				if cap(dst.Pairs) >= len(src.Pairs) {
+					dst.Pairs = (dst.Pairs)[:len(src.Pairs)]
+				} else {
+					dst.Pairs = make([]Pair[string, *Name], len(src.Pairs))
+				}
+			} else if len(src.Pairs) < len(dst.Pairs) {
+				dst.Pairs = (dst.Pairs)[:len(src.Pairs)]
+			}
+		} else {
+			dst.Pairs = make([]Pair[string, *Name], len(src.Pairs))
 		}
-		return out0, err
+		deriveDeepCopy_SliceOf_Pair_string_PtrTo_Name(dst.Pairs, src.Pairs)
 	}
-}
-
-// deriveCompose composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveCompose(f0 func() (string, error), f1 func(string) (float64, error)) func() (float64, error) {
-	return func() (float64, error) {
-		v_1_0, err0 := f0()
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		return v_2_0, nil
+	if src.PairsByKey != nil {
+		dst.PairsByKey = make(map[string]Pair[int64, []string], len(src.PairsByKey))
+		deriveDeepCopy_MapOf_string_To_Pair_int64_SliceOf_string(dst.PairsByKey, src.PairsByKey)
+	} else {
+		dst.PairsByKey = nil
 	}
-}
-
-// deriveComposeA composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveComposeA(f0 func(string) (string, error), f1 func(string) (float64, error)) func(string) (float64, error) {
-	return func(v_0_0 string) (float64, error) {
-		v_1_0, err0 := f0(v_0_0)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		return v_2_0, nil
+	if src.Box == nil {
+		dst.Box = nil
+	} else {
+		dst.Box = new(extra.Box[Pair[string, int]])
+		*dst.Box = *src.Box
 	}
 }
 
-// deriveCompose2 composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveCompose2(f0 func(string, string) ([]string, string, error), f1 func([]string, string) (float64, error)) func(string, string) (float64, error) {
-	return func(v_0_0 string, v_0_1 string) (float64, error) {
-		v_1_0, v_1_1, err0 := f0(v_0_0, v_0_1)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0, v_1_1)
-		if err1 != nil {
-			return 0, err1
+// deriveDeepCopyPtrToCache recursively copies the contents of src into dst.
+func deriveDeepCopyPtrToCache(dst, src *Cache) {
+	// mu is skipped, since it is tagged with `derive:"-"`.
+	// Updated is skipped, since it is tagged with `derive:"-"`.
+	dst.Key = src.Key
+	if src.Values == nil {
+		dst.Values = nil
+	} else {
+		if dst.Values != nil {
+			if len(src.Values) > len(dst.Values) {
+				if cap(dst.Values) >= len(src.Values) {
+					dst.Values = (dst.Values)[:len(src.Values)]
+				} else {
+					dst.Values = make([]int, len(src.Values))
+				}
+			} else if len(src.Values) < len(dst.Values) {
+				dst.Values = (dst.Values)[:len(src.Values)]
+			}
+		} else {
+			dst.Values = make([]int, len(src.Values))
 		}
-		return v_2_0, nil
+		copy(dst.Values, src.Values)
 	}
+	dst.Hits = src.Hits
+	dst.Entry = src.Entry
 }
 
-// deriveComposeRetBool composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
-func deriveComposeRetBool(f0 func(string) (string, error), f1 func(string) (bool, error)) func(string) (bool, error) {
-	return func(v_0_0 string) (bool, error) {
-		v_1_0, err0 := f0(v_0_0)
-		if err0 != nil {
-			return false, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return false, err1
+// deriveDeepCopy_PtrTo_Methods recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Methods(dst, src *Methods) {
+	dst.Name = src.Name
+	if src.Aliases == nil {
+		dst.Aliases = nil
+	} else {
+		if dst.Aliases != nil {
+			if len(src.Aliases) > len(dst.Aliases) {
+				if cap(dst.Aliases) >= len(src.Aliases) {
+					dst.Aliases = (dst.Aliases)[:len(src.Aliases)]
+				} else {
+					dst.Aliases = make([]string, len(src.Aliases))
+				}
+			} else if len(src.Aliases) < len(dst.Aliases) {
+				dst.Aliases = (dst.Aliases)[:len(src.Aliases)]
+			}
+		} else {
+			dst.Aliases = make([]string, len(src.Aliases))
 		}
-		return v_2_0, nil
+		copy(dst.Aliases, src.Aliases)
 	}
-}
-
-// deriveComposeVariadic composes functions f0, f1 and f2 into one function, that takes the parameters from f0 and returns the results from f2.
-func deriveComposeVariadic(f0 func(string) (string, error), f1 func(string) (float64, error), f2 func(float64) (int, error)) func(string) (int, error) {
-	return func(v_0_0 string) (int, error) {
-		v_1_0, err0 := f0(v_0_0)
-		if err0 != nil {
-			return 0, err0
-		}
-		v_2_0, err1 := f1(v_1_0)
-		if err1 != nil {
-			return 0, err1
-		}
-		v_3_0, err2 := f2(v_2_0)
-		if err2 != nil {
-			return 0, err2
-		}
-		return v_3_0, nil
+	if src.Pair == nil {
+		dst.Pair = nil
+	} else {
+		dst.Pair = new(Pair[string, int64])
+		*dst.Pair = *src.Pair
 	}
+	dst.Inner = src.Inner
 }
 
 // deriveComparePtrToEmpty returns:
@@ -2838,10 +2614,10 @@ func deriveComparePtrToBuiltInTypes(this, that *BuiltInTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_byte(this.Byte, that.Byte); c != 0 {
 		return c
 	}
 	if c := deriveCompareComplex64(this.Complex128, that.Complex128); c != 0 {
@@ -2850,49 +2626,49 @@ func deriveComparePtrToBuiltInTypes(this, that *BuiltInTypes) int {
 	if c := deriveCompareComplex32(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_f(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_fl(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_i(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_in(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int6(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
 	if c := deriveCompare_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_int32(this.Rune, that.Rune); c != 0 {
 		return c
 	}
 	if c := strings.Compare(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_u(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_ui(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_uin(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_uint(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_byte(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_1(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2912,10 +2688,10 @@ func deriveComparePtrToPrivateBuiltInTypes(this, that *PrivateBuiltInTypes) int
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare(this.privateBool, that.privateBool); c != 0 {
+	if c := deriveCompare_bool(this.privateBool, that.privateBool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_(this.privateByte, that.privateByte); c != 0 {
+	if c := deriveCompare_byte(this.privateByte, that.privateByte); c != 0 {
 		return c
 	}
 	if c := deriveCompareComplex64(this.privateComplex128, that.privateComplex128); c != 0 {
@@ -2924,49 +2700,49 @@ func deriveComparePtrToPrivateBuiltInTypes(this, that *PrivateBuiltInTypes) int
 	if c := deriveCompareComplex32(this.privateComplex64, that.privateComplex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_f(this.privateFloat64, that.privateFloat64); c != 0 {
+	if c := deriveCompare_float64(this.privateFloat64, that.privateFloat64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_fl(this.privateFloat32, that.privateFloat32); c != 0 {
+	if c := deriveCompare_float32(this.privateFloat32, that.privateFloat32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_i(this.privateInt, that.privateInt); c != 0 {
+	if c := deriveCompare_int(this.privateInt, that.privateInt); c != 0 {
 		return c
 	}
-	if c := deriveCompare_in(this.privateInt16, that.privateInt16); c != 0 {
+	if c := deriveCompare_int16(this.privateInt16, that.privateInt16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int(this.privateInt32, that.privateInt32); c != 0 {
+	if c := deriveCompare_int32(this.privateInt32, that.privateInt32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int6(this.privateInt64, that.privateInt64); c != 0 {
+	if c := deriveCompare_int64(this.privateInt64, that.privateInt64); c != 0 {
 		return c
 	}
 	if c := deriveCompare_int8(this.privateInt8, that.privateInt8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_int(this.privateRune, that.privateRune); c != 0 {
+	if c := deriveCompare_int32(this.privateRune, that.privateRune); c != 0 {
 		return c
 	}
 	if c := strings.Compare(this.privateString, that.privateString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_u(this.privateUint, that.privateUint); c != 0 {
+	if c := deriveCompare_uint(this.privateUint, that.privateUint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_ui(this.privateUint16, that.privateUint16); c != 0 {
+	if c := deriveCompare_uint16(this.privateUint16, that.privateUint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_uin(this.privateUint32, that.privateUint32); c != 0 {
+	if c := deriveCompare_uint32(this.privateUint32, that.privateUint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_uint(this.privateUint64, that.privateUint64); c != 0 {
+	if c := deriveCompare_uint64(this.privateUint64, that.privateUint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_(this.privateUint8, that.privateUint8); c != 0 {
+	if c := deriveCompare_byte(this.privateUint8, that.privateUint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_1(this.privateUintPtr, that.privateUintPtr); c != 0 {
+	if c := deriveCompare_uintptr(this.privateUintPtr, that.privateUintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -2986,61 +2762,61 @@ func deriveComparePtrToPtrToBuiltInTypes(this, that *PtrToBuiltInTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_2(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_PtrTo_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_3(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_PtrTo_byte(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_4(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_PtrTo_complex128(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_5(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_PtrTo_complex64(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_6(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_PtrTo_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_7(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_PtrTo_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_8(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_PtrTo_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_9(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_PtrTo_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_10(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_PtrTo_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_11(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_PtrTo_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_12(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_PtrTo_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_10(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_PtrTo_int32(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_13(this.String, that.String); c != 0 {
+	if c := deriveCompare_PtrTo_string(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_14(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_PtrTo_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_15(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_PtrTo_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_16(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_PtrTo_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_17(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_PtrTo_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_3(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_PtrTo_byte(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_18(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_PtrTo_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -3060,61 +2836,61 @@ func deriveComparePtrToSliceOfBuiltInTypes(this, that *SliceOfBuiltInTypes) int
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_19(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_SliceOf_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
 	if c := bytes.Compare(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_20(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_SliceOf_complex128(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_21(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_SliceOf_complex64(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_22(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_SliceOf_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_23(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_SliceOf_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_24(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_SliceOf_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_25(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_SliceOf_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_26(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_SliceOf_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_27(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_SliceOf_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_28(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_SliceOf_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_26(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_SliceOf_int32(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_29(this.String, that.String); c != 0 {
+	if c := deriveCompare_SliceOf_string(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_30(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_SliceOf_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_31(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_SliceOf_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_32(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_SliceOf_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_33(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_SliceOf_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
 	if c := bytes.Compare(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_34(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_SliceOf_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -3134,61 +2910,61 @@ func deriveComparePtrToSliceOfPtrToBuiltInTypes(this, that *SliceOfPtrToBuiltInT
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_35(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_36(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_byte(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_37(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_complex128(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_38(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_complex64(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_39(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_40(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_41(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_42(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_43(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_44(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_45(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_43(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int32(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_46(this.String, that.String); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_string(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_47(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_48(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_49(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_50(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_36(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_byte(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_51(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
 	return 0
@@ -3208,64 +2984,64 @@ func deriveComparePtrToArrayOfBuiltInTypes(this, that *ArrayOfBuiltInTypes) int
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_52(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_ArrayOf1_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_53(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_ArrayOf2_byte(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_54(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_ArrayOf3_complex128(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_55(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_ArrayOf4_complex64(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_56(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_ArrayOf5_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_57(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_ArrayOf6_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_58(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_ArrayOf7_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_59(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_ArrayOf8_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_60(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_ArrayOf9_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_61(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_ArrayOf10_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_62(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_ArrayOf11_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_63(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_ArrayOf12_rune(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_64(this.String, that.String); c != 0 {
+	if c := deriveCompare_ArrayOf13_string(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_65(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_ArrayOf14_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_66(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_ArrayOf15_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_67(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_ArrayOf16_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_68(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_ArrayOf17_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_69(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_ArrayOf18_uint8(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_70(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_ArrayOf19_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
-	if c := deriveCompare_71(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
+	if c := deriveCompare_ArrayOf10_bool(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
 		return c
 	}
 	return 0
@@ -3285,64 +3061,64 @@ func deriveComparePtrToArrayOfPtrToBuiltInTypes(this, that *ArrayOfPtrToBuiltInT
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_72(this.Bool, that.Bool); c != 0 {
+	if c := deriveCompare_ArrayOf1_PtrTo_bool(this.Bool, that.Bool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_73(this.Byte, that.Byte); c != 0 {
+	if c := deriveCompare_ArrayOf2_PtrTo_byte(this.Byte, that.Byte); c != 0 {
 		return c
 	}
-	if c := deriveCompare_74(this.Complex128, that.Complex128); c != 0 {
+	if c := deriveCompare_ArrayOf3_PtrTo_complex128(this.Complex128, that.Complex128); c != 0 {
 		return c
 	}
-	if c := deriveCompare_75(this.Complex64, that.Complex64); c != 0 {
+	if c := deriveCompare_ArrayOf4_PtrTo_complex64(this.Complex64, that.Complex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_76(this.Float64, that.Float64); c != 0 {
+	if c := deriveCompare_ArrayOf5_PtrTo_float64(this.Float64, that.Float64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_77(this.Float32, that.Float32); c != 0 {
+	if c := deriveCompare_ArrayOf6_PtrTo_float32(this.Float32, that.Float32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_78(this.Int, that.Int); c != 0 {
+	if c := deriveCompare_ArrayOf7_PtrTo_int(this.Int, that.Int); c != 0 {
 		return c
 	}
-	if c := deriveCompare_79(this.Int16, that.Int16); c != 0 {
+	if c := deriveCompare_ArrayOf8_PtrTo_int16(this.Int16, that.Int16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_80(this.Int32, that.Int32); c != 0 {
+	if c := deriveCompare_ArrayOf9_PtrTo_int32(this.Int32, that.Int32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_81(this.Int64, that.Int64); c != 0 {
+	if c := deriveCompare_ArrayOf10_PtrTo_int64(this.Int64, that.Int64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_82(this.Int8, that.Int8); c != 0 {
+	if c := deriveCompare_ArrayOf11_PtrTo_int8(this.Int8, that.Int8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_83(this.Rune, that.Rune); c != 0 {
+	if c := deriveCompare_ArrayOf12_PtrTo_rune(this.Rune, that.Rune); c != 0 {
 		return c
 	}
-	if c := deriveCompare_84(this.String, that.String); c != 0 {
+	if c := deriveCompare_ArrayOf13_PtrTo_string(this.String, that.String); c != 0 {
 		return c
 	}
-	if c := deriveCompare_85(this.Uint, that.Uint); c != 0 {
+	if c := deriveCompare_ArrayOf14_PtrTo_uint(this.Uint, that.Uint); c != 0 {
 		return c
 	}
-	if c := deriveCompare_86(this.Uint16, that.Uint16); c != 0 {
+	if c := deriveCompare_ArrayOf15_PtrTo_uint16(this.Uint16, that.Uint16); c != 0 {
 		return c
 	}
-	if c := deriveCompare_87(this.Uint32, that.Uint32); c != 0 {
+	if c := deriveCompare_ArrayOf16_PtrTo_uint32(this.Uint32, that.Uint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_88(this.Uint64, that.Uint64); c != 0 {
+	if c := deriveCompare_ArrayOf17_PtrTo_uint64(this.Uint64, that.Uint64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_89(this.Uint8, that.Uint8); c != 0 {
+	if c := deriveCompare_ArrayOf18_PtrTo_uint8(this.Uint8, that.Uint8); c != 0 {
 		return c
 	}
-	if c := deriveCompare_90(this.UintPtr, that.UintPtr); c != 0 {
+	if c := deriveCompare_ArrayOf19_PtrTo_uintptr(this.UintPtr, that.UintPtr); c != 0 {
 		return c
 	}
-	if c := deriveCompare_91(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
+	if c := deriveCompare_ArrayOf10_PtrTo_bool(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize); c != 0 {
 		return c
 	}
 	return 0
@@ -3362,10 +3138,10 @@ func deriveComparePtrToMapsOfSimplerBuiltInTypes(this, that *MapsOfSimplerBuiltI
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_92(this.StringToUint32, that.StringToUint32); c != 0 {
+	if c := deriveCompare_MapOf_string_To_uint32(this.StringToUint32, that.StringToUint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_93(this.Uint64ToInt64, that.Uint64ToInt64); c != 0 {
+	if c := deriveCompare_MapOf_uint8_To_int64(this.Uint64ToInt64, that.Uint64ToInt64); c != 0 {
 		return c
 	}
 	return 0
@@ -3385,19 +3161,19 @@ func deriveComparePtrToMapsOfBuiltInTypes(this, that *MapsOfBuiltInTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_94(this.BoolToString, that.BoolToString); c != 0 {
+	if c := deriveCompare_MapOf_bool_To_string(this.BoolToString, that.BoolToString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_95(this.StringToBool, that.StringToBool); c != 0 {
+	if c := deriveCompare_MapOf_string_To_bool(this.StringToBool, that.StringToBool); c != 0 {
 		return c
 	}
-	if c := deriveCompare_96(this.Complex128ToComplex64, that.Complex128ToComplex64); c != 0 {
+	if c := deriveCompare_MapOf_complex128_To_complex64(this.Complex128ToComplex64, that.Complex128ToComplex64); c != 0 {
 		return c
 	}
-	if c := deriveCompare_97(this.Float64ToUint32, that.Float64ToUint32); c != 0 {
+	if c := deriveCompare_MapOf_float64_To_uint32(this.Float64ToUint32, that.Float64ToUint32); c != 0 {
 		return c
 	}
-	if c := deriveCompare_98(this.Uint16ToUint8, that.Uint16ToUint8); c != 0 {
+	if c := deriveCompare_MapOf_uint16_To_uint8(this.Uint16ToUint8, that.Uint16ToUint8); c != 0 {
 		return c
 	}
 	return 0
@@ -3417,13 +3193,13 @@ func deriveComparePtrToSliceToSlice(this, that *SliceToSlice) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_99(this.Ints, that.Ints); c != 0 {
+	if c := deriveCompare_SliceOf_SliceOf_int(this.Ints, that.Ints); c != 0 {
 		return c
 	}
-	if c := deriveCompare_100(this.Strings, that.Strings); c != 0 {
+	if c := deriveCompare_SliceOf_SliceOf_string(this.Strings, that.Strings); c != 0 {
 		return c
 	}
-	if c := deriveCompare_101(this.IntPtrs, that.IntPtrs); c != 0 {
+	if c := deriveCompare_SliceOf_SliceOf_PtrTo_int(this.IntPtrs, that.IntPtrs); c != 0 {
 		return c
 	}
 	return 0
@@ -3443,16 +3219,16 @@ func deriveComparePtrToPtrTo(this, that *PtrTo) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_8(this.Basic, that.Basic); c != 0 {
+	if c := deriveCompare_PtrTo_int(this.Basic, that.Basic); c != 0 {
 		return c
 	}
-	if c := deriveCompare_102(this.Slice, that.Slice); c != 0 {
+	if c := deriveCompare_PtrTo_SliceOf_int(this.Slice, that.Slice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_103(this.Array, that.Array); c != 0 {
+	if c := deriveCompare_PtrTo_ArrayOf4_int(this.Array, that.Array); c != 0 {
 		return c
 	}
-	if c := deriveCompare_104(this.Map, that.Map); c != 0 {
+	if c := deriveCompare_PtrTo_MapOf_int_To_int(this.Map, that.Map); c != 0 {
 		return c
 	}
 	return 0
@@ -3498,22 +3274,22 @@ func deriveComparePtrToStructs(this, that *Structs) int {
 	if c := this.PtrToStruct.Compare(that.PtrToStruct); c != 0 {
 		return c
 	}
-	if c := deriveCompare_105(this.SliceOfStructs, that.SliceOfStructs); c != 0 {
+	if c := deriveCompare_SliceOf_Name(this.SliceOfStructs, that.SliceOfStructs); c != 0 {
 		return c
 	}
-	if c := deriveCompare_106(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_Name(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct); c != 0 {
 		return c
 	}
-	if c := deriveCompare_107(&this.StructWithoutMethod, &that.StructWithoutMethod); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutMethod(&this.StructWithoutMethod, &that.StructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_107(this.PtrToStructWithoutMethod, that.PtrToStructWithoutMethod); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutMethod(this.PtrToStructWithoutMethod, that.PtrToStructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_108(this.SliceOfStructWithoutMethod, that.SliceOfStructWithoutMethod); c != 0 {
+	if c := deriveCompare_SliceOf_StructWithoutMethod(this.SliceOfStructWithoutMethod, that.SliceOfStructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_109(this.SliceToPtrOfStructWithoutMethod, that.SliceToPtrOfStructWithoutMethod); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_StructWithoutMethod(this.SliceToPtrOfStructWithoutMethod, that.SliceToPtrOfStructWithoutMethod); c != 0 {
 		return c
 	}
 	return 0
@@ -3533,34 +3309,34 @@ func deriveComparePtrToMapWithStructs(this, that *MapWithStructs) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_110(this.NameToString, that.NameToString); c != 0 {
+	if c := deriveCompare_MapOf_Name_To_string(this.NameToString, that.NameToString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_111(this.StringToName, that.StringToName); c != 0 {
+	if c := deriveCompare_MapOf_string_To_Name(this.StringToName, that.StringToName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_112(this.StringToPtrToName, that.StringToPtrToName); c != 0 {
+	if c := deriveCompare_MapOf_string_To_PtrTo_Name(this.StringToPtrToName, that.StringToPtrToName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_113(this.StringToSliceOfName, that.StringToSliceOfName); c != 0 {
+	if c := deriveCompare_MapOf_string_To_SliceOf_Name(this.StringToSliceOfName, that.StringToSliceOfName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_114(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName); c != 0 {
+	if c := deriveCompare_MapOf_string_To_SliceOf_PtrTo_Name(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName); c != 0 {
 		return c
 	}
-	if c := deriveCompare_115(this.StringToStructWithoutMethod, that.StringToStructWithoutMethod); c != 0 {
+	if c := deriveCompare_MapOf_string_To_StructWithoutMethod(this.StringToStructWithoutMethod, that.StringToStructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_116(this.StructWithoutMethodToString, that.StructWithoutMethodToString); c != 0 {
+	if c := deriveCompare_MapOf_StructWithoutMethod_To_string(this.StructWithoutMethodToString, that.StructWithoutMethodToString); c != 0 {
 		return c
 	}
-	if c := deriveCompare_117(this.StringToPtrToStructWithoutMethod, that.StringToPtrToStructWithoutMethod); c != 0 {
+	if c := deriveCompare_MapOf_string_To_PtrTo_StructWithoutMethod(this.StringToPtrToStructWithoutMethod, that.StringToPtrToStructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_118(this.StringToSliceOfStructWithoutMethod, that.StringToSliceOfStructWithoutMethod); c != 0 {
+	if c := deriveCompare_MapOf_string_To_SliceOf_StructWithoutMethod(this.StringToSliceOfStructWithoutMethod, that.StringToSliceOfStructWithoutMethod); c != 0 {
 		return c
 	}
-	if c := deriveCompare_119(this.StringToSliceOfPtrToStructWithoutMethod, that.StringToSliceOfPtrToStructWithoutMethod); c != 0 {
+	if c := deriveCompare_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this.StringToSliceOfPtrToStructWithoutMethod, that.StringToSliceOfPtrToStructWithoutMethod); c != 0 {
 		return c
 	}
 	return 0
@@ -3583,7 +3359,7 @@ func deriveComparePtrToRecursiveType(this, that *RecursiveType) int {
 	if c := bytes.Compare(this.Bytes, that.Bytes); c != 0 {
 		return c
 	}
-	if c := deriveCompare_120(this.N, that.N); c != 0 {
+	if c := deriveCompare_MapOf_int_To_RecursiveType(this.N, that.N); c != 0 {
 		return c
 	}
 	return 0
@@ -3606,7 +3382,7 @@ func deriveComparePtrToEmbeddedStruct1(this, that *EmbeddedStruct1) int {
 	if c := this.Name.Compare(&that.Name); c != 0 {
 		return c
 	}
-	if c := deriveCompare_107(&this.StructWithoutMethod, &that.StructWithoutMethod); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutMethod(&this.StructWithoutMethod, &that.StructWithoutMethod); c != 0 {
 		return c
 	}
 	if c := this.Structs.Compare(that.Structs); c != 0 {
@@ -3635,7 +3411,7 @@ func deriveComparePtrToEmbeddedStruct2(this, that *EmbeddedStruct2) int {
 	if c := this.Name.Compare(that.Name); c != 0 {
 		return c
 	}
-	if c := deriveCompare_107(this.StructWithoutMethod, that.StructWithoutMethod); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutMethod(this.StructWithoutMethod, that.StructWithoutMethod); c != 0 {
 		return c
 	}
 	return 0
@@ -3655,10 +3431,10 @@ func deriveComparePtrToStructWithStructFieldWithoutEqualMethod(this, that *Struc
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_121(this.A, that.A); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutEqualMethod(this.A, that.A); c != 0 {
 		return c
 	}
-	if c := deriveCompare_121(&this.B, &that.B); c != 0 {
+	if c := deriveCompare_PtrTo_StructWithoutEqualMethod(&this.B, &that.B); c != 0 {
 		return c
 	}
 	return 0
@@ -3678,10 +3454,10 @@ func deriveComparePtrToStructWithStructWithFromAnotherPackage(this, that *Struct
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_122(this.A, that.A); c != 0 {
+	if c := deriveCompare_PtrTo_extra_StructWithoutEqualMethod(this.A, that.A); c != 0 {
 		return c
 	}
-	if c := deriveCompare_122(&this.B, &that.B); c != 0 {
+	if c := deriveCompare_PtrTo_extra_StructWithoutEqualMethod(&this.B, &that.B); c != 0 {
 		return c
 	}
 	return 0
@@ -3701,7 +3477,7 @@ func deriveComparePtrToFieldWithStructWithPrivateFields(this, that *FieldWithStr
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_123(this.A, that.A); c != 0 {
+	if c := deriveCompare_PtrTo_extra_PrivateFieldAndNoEqualMethod(this.A, that.A); c != 0 {
 		return c
 	}
 	return 0
@@ -3721,25 +3497,25 @@ func deriveComparePtrToEnums(this, that *Enums) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_M(this.Enum, that.Enum); c != 0 {
+	if c := deriveCompare_MyEnum(this.Enum, that.Enum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_124(this.PtrToEnum, that.PtrToEnum); c != 0 {
+	if c := deriveCompare_PtrTo_MyEnum(this.PtrToEnum, that.PtrToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_125(this.SliceToEnum, that.SliceToEnum); c != 0 {
+	if c := deriveCompare_SliceOf_MyEnum(this.SliceToEnum, that.SliceToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_126(this.SliceToPtrToEnum, that.SliceToPtrToEnum); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_MyEnum(this.SliceToPtrToEnum, that.SliceToPtrToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_127(this.MapToEnum, that.MapToEnum); c != 0 {
+	if c := deriveCompare_MapOf_int32_To_MyEnum(this.MapToEnum, that.MapToEnum); c != 0 {
 		return c
 	}
-	if c := deriveCompare_128(this.EnumToMap, that.EnumToMap); c != 0 {
+	if c := deriveCompare_MapOf_MyEnum_To_int32(this.EnumToMap, that.EnumToMap); c != 0 {
 		return c
 	}
-	if c := deriveCompare_129(this.ArrayEnum, that.ArrayEnum); c != 0 {
+	if c := deriveCompare_ArrayOf2_MyEnum(this.ArrayEnum, that.ArrayEnum); c != 0 {
 		return c
 	}
 	return 0
@@ -3759,13 +3535,13 @@ func deriveComparePtrToNamedTypes(this, that *NamedTypes) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_27(this.Slice, that.Slice); c != 0 {
+	if c := deriveCompare_SliceOf_int64(this.Slice, that.Slice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_130(this.PtrToSlice, that.PtrToSlice); c != 0 {
+	if c := deriveCompare_PtrTo_MySlice(this.PtrToSlice, that.PtrToSlice); c != 0 {
 		return c
 	}
-	if c := deriveCompare_131(this.SliceToSlice, that.SliceToSlice); c != 0 {
+	if c := deriveCompare_SliceOf_MySlice(this.SliceToSlice, that.SliceToSlice); c != 0 {
 		return c
 	}
 	return 0
@@ -3785,19 +3561,19 @@ func deriveComparePtrToDuration(this, that *Duration) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_D(this.D, that.D); c != 0 {
+	if c := deriveCompare_time_Duration(this.D, that.D); c != 0 {
 		return c
 	}
-	if c := deriveCompare_132(this.P, that.P); c != 0 {
+	if c := deriveCompare_PtrTo_time_Duration(this.P, that.P); c != 0 {
 		return c
 	}
-	if c := deriveCompare_133(this.Ds, that.Ds); c != 0 {
+	if c := deriveCompare_SliceOf_time_Duration(this.Ds, that.Ds); c != 0 {
 		return c
 	}
-	if c := deriveCompare_134(this.DPs, that.DPs); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_time_Duration(this.DPs, that.DPs); c != 0 {
 		return c
 	}
-	if c := deriveCompare_135(this.MD, that.MD); c != 0 {
+	if c := deriveCompare_MapOf_int_To_time_Duration(this.MD, that.MD); c != 0 {
 		return c
 	}
 	return 0
@@ -3817,7 +3593,7 @@ func deriveComparePtrToNickname(this, that *Nickname) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_136(this.Alias, that.Alias); c != 0 {
+	if c := deriveCompare_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this.Alias, that.Alias); c != 0 {
 		return c
 	}
 	return 0
@@ -3837,7 +3613,68 @@ func deriveComparePtrToPrivateEmbedded(this, that *PrivateEmbedded) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_137(&this.privateStruct, &that.privateStruct); c != 0 {
+	if c := deriveCompare_PtrTo_privateStruct(&this.privateStruct, &that.privateStruct); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveComparePtrToGenerics returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveComparePtrToGenerics(this, that *Generics) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompareTreeOfInt(this.IntTree, that.IntTree); c != 0 {
+		return c
+	}
+	if c := deriveCompare_PtrTo_Tree_Name(&this.NameTree, &that.NameTree); c != 0 {
+		return c
+	}
+	if c := deriveCompare_SliceOf_Pair_string_PtrTo_Name(this.Pairs, that.Pairs); c != 0 {
+		return c
+	}
+	if c := deriveCompare_MapOf_string_To_Pair_int64_SliceOf_string(this.PairsByKey, that.PairsByKey); c != 0 {
+		return c
+	}
+	if c := deriveCompare_PtrTo_extra_Box_Pair_string_int(this.Box, that.Box); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveComparePtrToCache returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveComparePtrToCache(this, that *Cache) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	// mu is skipped, since it is tagged with `derive:"-"`.
+	// Hits is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	// Updated is skipped, since it is tagged with `derive:"-"`.
+	if c := strings.Compare(this.Key, that.Key); c != 0 {
+		return c
+	}
+	if c := deriveCompare_SliceOf_int(this.Values, that.Values); c != 0 {
+		return c
+	}
+	if c := deriveCompare_PtrTo_CacheEntry(&this.Entry, &that.Entry); c != 0 {
 		return c
 	}
 	return 0
@@ -3883,49 +3720,34 @@ func deriveCompareComplex64(this, that complex128) int {
 	}
 }
 
-// deriveCompareCurryComplex64 returns a curried compare function, which returns:
+// deriveCompareTreeOfInt returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompareCurryComplex64(this complex128) func(complex128) int {
-	return func(that complex128) int {
-		if thisr, thatr := real(this), real(that); thisr == thatr {
-			if thisi, thati := imag(this), imag(that); thisi == thati {
-				return 0
-			} else if thisi < thati {
-				return -1
-			} else {
-				return 1
-			}
-		} else if thisr < thatr {
-			return -1
-		} else {
-			return 1
+func deriveCompareTreeOfInt(this, that *Tree[int]) int {
+	if this == nil {
+		if that == nil {
+			return 0
 		}
+		return -1
 	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_int(this.Value, that.Value); c != 0 {
+		return c
+	}
+	if c := deriveCompare_SliceOf_PtrTo_Tree_int(this.Children, that.Children); c != 0 {
+		return c
+	}
+	return 0
 }
 
-// deriveCompareStringAlias returns:
-//   - 0 if this and that are equal,
-//   - -1 is this is smaller and
-//   - +1 is this is bigger.
-func deriveCompareStringAlias(this, that stringAlias) int {
-	return strings.Compare(string(this), string(that))
-}
-
-// deriveCompareStructWithStringAlias returns:
-//   - 0 if this and that are equal,
-//   - -1 is this is smaller and
-//   - +1 is this is bigger.
-func deriveCompareStructWithStringAlias(this, that StructWithStringAlias) int {
-	return deriveCompare_138(&this, &that)
-}
-
-// deriveCompareDeriveTheDerived returns:
+// deriveCompare_PtrTo_Methods returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
+func deriveCompare_PtrTo_Methods(this, that *Methods) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -3935,173 +3757,19 @@ func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_i(this.Field, that.Field); c != 0 {
+	if c := strings.Compare(this.Name, that.Name); c != 0 {
 		return c
 	}
-	return 0
-}
-
-// deriveUniqueInt64s returns a list containing only the unique items from the input list.
-// It does this by reusing the input list.
-//
-// Deprecated: In favour of generics.
-func deriveUniqueInt64s(list []int64) []int64 {
-	if len(list) == 0 {
-		return nil
+	if c := deriveCompare_SliceOf_string(this.Aliases, that.Aliases); c != 0 {
+		return c
 	}
-	return deriveKeysForInt64s(deriveSetInt64s(list))
-}
-
-// deriveUniqueStructs returns a list containing only the unique items from the input list.
-// It does this by reusing the input list.
-//
-// Deprecated: In favour of generics.
-func deriveUniqueStructs(list []*BuiltInTypes) []*BuiltInTypes {
-	if len(list) == 0 {
-		return nil
+	if c := deriveCompare_PtrTo_Pair_string_int64(this.Pair, that.Pair); c != 0 {
+		return c
 	}
-	table := make(map[uint64][]int)
-	u := 0
-	for i := 0; i < len(list); i++ {
-		contains := false
-		hash := deriveHashBuiltInTypes(list[i])
-		indexes := table[hash]
-		for _, index := range indexes {
-			if deriveEqualPtrToBuiltInTypes(list[index], list[i]) {
-				contains = true
-				break
-			}
-		}
-		if contains {
-			continue
-		}
-		if i != u {
-			list[u] = list[i]
-		}
-		table[hash] = append(table[hash], u)
-		u++
-	}
-	return list[:u]
-}
-
-// deriveUniqueStructsPtrs returns a list containing only the unique items from the input list.
-// It does this by reusing the input list.
-//
-// Deprecated: In favour of generics.
-func deriveUniqueStructsPtrs(list []PtrToBuiltInTypes) []PtrToBuiltInTypes {
-	if len(list) == 0 {
-		return nil
-	}
-	table := make(map[uint64][]int)
-	u := 0
-	for i := 0; i < len(list); i++ {
-		contains := false
-		hash := deriveHash(list[i])
-		indexes := table[hash]
-		for _, index := range indexes {
-			if deriveEqual(list[index], list[i]) {
-				contains = true
-				break
-			}
-		}
-		if contains {
-			continue
-		}
-		if i != u {
-			list[u] = list[i]
-		}
-		table[hash] = append(table[hash], u)
-		u++
-	}
-	return list[:u]
-}
-
-// deriveFilter returns a list of all items in the list that matches the predicate.
-//
-// Deprecated: In favour of generics.
-func deriveFilter(predicate func(int) bool, list []int) []int {
-	j := 0
-	for i, elem := range list {
-		if predicate(elem) {
-			if i != j {
-				list[j] = list[i]
-			}
-			j++
-		}
-	}
-	return list[:j]
-}
-
-// deriveFilterJudy returns a list of all items in the list that matches the predicate.
-//
-// Deprecated: In favour of generics.
-func deriveFilterJudy(predicate func(string) bool, list []string) []string {
-	j := 0
-	for i, elem := range list {
-		if predicate(elem) {
-			if i != j {
-				list[j] = list[i]
-			}
-			j++
-		}
-	}
-	return list[:j]
-}
-
-// deriveUnionSetOfInt64s returns the union of two maps, with respect to the keys.
-// It does this by adding the keys to the first map.
-//
-// Deprecated: In favour of generics.
-func deriveUnionSetOfInt64s(union, that map[int64]struct{}) map[int64]struct{} {
-	for k := range that {
-		union[k] = struct{}{}
-	}
-	return union
-}
-
-// deriveUnionOfInt64s returns the union of the items of the two input lists.
-// It does this by append items to the first list.
-//
-// Deprecated: In favour of generics.
-func deriveUnionOfInt64s(this, that []int64) []int64 {
-	for i, v := range that {
-		if !deriveContainsInt64s(this, v) {
-			this = append(this, that[i])
-		}
-	}
-	return this
-}
-
-// deriveTuple1 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple1(v0 int) func() int {
-	return func() int {
-		return v0
-	}
-}
-
-// deriveTuple2 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple2(v0 int, v1 string) func() (int, string) {
-	return func() (int, string) {
-		return v0, v1
-	}
-}
-
-// deriveTuple3 returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple3(v0 int, v1 string, v2 *BuiltInTypes) func() (int, string, *BuiltInTypes) {
-	return func() (int, string, *BuiltInTypes) {
-		return v0, v1, v2
-	}
-}
-
-// deriveTupleError returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTupleError(v0 []byte, v1 error) func() ([]byte, error) {
-	return func() ([]byte, error) {
-		return v0, v1
+	if c := this.Inner.Compare(&that.Inner); c != 0 {
+		return c
 	}
+	return 0
 }
 
 // deriveEqualPtrToEmpty returns whether this and that are equal.
@@ -4188,50 +3856,50 @@ func deriveEqualPtrToPtrToBuiltInTypes(this, that *PtrToBuiltInTypes) bool {
 func deriveEqualPtrToSliceOfBuiltInTypes(this, that *SliceOfBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_(this.Bool, that.Bool) &&
+			deriveEqual_SliceOf_bool(this.Bool, that.Bool) &&
 			bytes.Equal(this.Byte, that.Byte) &&
-			deriveEqual_1(this.Complex128, that.Complex128) &&
-			deriveEqual_2(this.Complex64, that.Complex64) &&
-			deriveEqual_3(this.Float64, that.Float64) &&
-			deriveEqual_4(this.Float32, that.Float32) &&
+			deriveEqual_SliceOf_complex128(this.Complex128, that.Complex128) &&
+			deriveEqual_SliceOf_complex64(this.Complex64, that.Complex64) &&
+			deriveEqual_SliceOf_float64(this.Float64, that.Float64) &&
+			deriveEqual_SliceOf_float32(this.Float32, that.Float32) &&
 			deriveEqualSliceOfint(this.Int, that.Int) &&
-			deriveEqual_5(this.Int16, that.Int16) &&
-			deriveEqual_6(this.Int32, that.Int32) &&
-			deriveEqual_7(this.Int64, that.Int64) &&
-			deriveEqual_8(this.Int8, that.Int8) &&
-			deriveEqual_6(this.Rune, that.Rune) &&
-			deriveEqual_9(this.String, that.String) &&
-			deriveEqual_10(this.Uint, that.Uint) &&
-			deriveEqual_11(this.Uint16, that.Uint16) &&
-			deriveEqual_12(this.Uint32, that.Uint32) &&
-			deriveEqual_13(this.Uint64, that.Uint64) &&
+			deriveEqual_SliceOf_int16(this.Int16, that.Int16) &&
+			deriveEqual_SliceOf_int32(this.Int32, that.Int32) &&
+			deriveEqual_SliceOf_int64(this.Int64, that.Int64) &&
+			deriveEqual_SliceOf_int8(this.Int8, that.Int8) &&
+			deriveEqual_SliceOf_int32(this.Rune, that.Rune) &&
+			deriveEqual_SliceOf_string(this.String, that.String) &&
+			deriveEqual_SliceOf_uint(this.Uint, that.Uint) &&
+			deriveEqual_SliceOf_uint16(this.Uint16, that.Uint16) &&
+			deriveEqual_SliceOf_uint32(this.Uint32, that.Uint32) &&
+			deriveEqual_SliceOf_uint64(this.Uint64, that.Uint64) &&
 			bytes.Equal(this.Uint8, that.Uint8) &&
-			deriveEqual_14(this.UintPtr, that.UintPtr)
+			deriveEqual_SliceOf_uintptr(this.UintPtr, that.UintPtr)
 }
 
 // deriveEqualPtrToSliceOfPtrToBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToSliceOfPtrToBuiltInTypes(this, that *SliceOfPtrToBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_15(this.Bool, that.Bool) &&
-			deriveEqual_16(this.Byte, that.Byte) &&
-			deriveEqual_17(this.Complex128, that.Complex128) &&
-			deriveEqual_18(this.Complex64, that.Complex64) &&
-			deriveEqual_19(this.Float64, that.Float64) &&
-			deriveEqual_20(this.Float32, that.Float32) &&
-			deriveEqual_21(this.Int, that.Int) &&
-			deriveEqual_22(this.Int16, that.Int16) &&
-			deriveEqual_23(this.Int32, that.Int32) &&
-			deriveEqual_24(this.Int64, that.Int64) &&
-			deriveEqual_25(this.Int8, that.Int8) &&
-			deriveEqual_23(this.Rune, that.Rune) &&
-			deriveEqual_26(this.String, that.String) &&
-			deriveEqual_27(this.Uint, that.Uint) &&
-			deriveEqual_28(this.Uint16, that.Uint16) &&
-			deriveEqual_29(this.Uint32, that.Uint32) &&
-			deriveEqual_30(this.Uint64, that.Uint64) &&
-			deriveEqual_16(this.Uint8, that.Uint8) &&
-			deriveEqual_31(this.UintPtr, that.UintPtr)
+			deriveEqual_SliceOf_PtrTo_bool(this.Bool, that.Bool) &&
+			deriveEqual_SliceOf_PtrTo_byte(this.Byte, that.Byte) &&
+			deriveEqual_SliceOf_PtrTo_complex128(this.Complex128, that.Complex128) &&
+			deriveEqual_SliceOf_PtrTo_complex64(this.Complex64, that.Complex64) &&
+			deriveEqual_SliceOf_PtrTo_float64(this.Float64, that.Float64) &&
+			deriveEqual_SliceOf_PtrTo_float32(this.Float32, that.Float32) &&
+			deriveEqual_SliceOf_PtrTo_int(this.Int, that.Int) &&
+			deriveEqual_SliceOf_PtrTo_int16(this.Int16, that.Int16) &&
+			deriveEqual_SliceOf_PtrTo_int32(this.Int32, that.Int32) &&
+			deriveEqual_SliceOf_PtrTo_int64(this.Int64, that.Int64) &&
+			deriveEqual_SliceOf_PtrTo_int8(this.Int8, that.Int8) &&
+			deriveEqual_SliceOf_PtrTo_int32(this.Rune, that.Rune) &&
+			deriveEqual_SliceOf_PtrTo_string(this.String, that.String) &&
+			deriveEqual_SliceOf_PtrTo_uint(this.Uint, that.Uint) &&
+			deriveEqual_SliceOf_PtrTo_uint16(this.Uint16, that.Uint16) &&
+			deriveEqual_SliceOf_PtrTo_uint32(this.Uint32, that.Uint32) &&
+			deriveEqual_SliceOf_PtrTo_uint64(this.Uint64, that.Uint64) &&
+			deriveEqual_SliceOf_PtrTo_byte(this.Uint8, that.Uint8) &&
+			deriveEqual_SliceOf_PtrTo_uintptr(this.UintPtr, that.UintPtr)
 }
 
 // deriveEqualPtrToArrayOfBuiltInTypes returns whether this and that are equal.
@@ -4264,54 +3932,54 @@ func deriveEqualPtrToArrayOfBuiltInTypes(this, that *ArrayOfBuiltInTypes) bool {
 func deriveEqualPtrToArrayOfPtrToBuiltInTypes(this, that *ArrayOfPtrToBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_32(this.Bool, that.Bool) &&
-			deriveEqual_33(this.Byte, that.Byte) &&
-			deriveEqual_34(this.Complex128, that.Complex128) &&
-			deriveEqual_35(this.Complex64, that.Complex64) &&
-			deriveEqual_36(this.Float64, that.Float64) &&
-			deriveEqual_37(this.Float32, that.Float32) &&
-			deriveEqual_38(this.Int, that.Int) &&
-			deriveEqual_39(this.Int16, that.Int16) &&
-			deriveEqual_40(this.Int32, that.Int32) &&
-			deriveEqual_41(this.Int64, that.Int64) &&
-			deriveEqual_42(this.Int8, that.Int8) &&
-			deriveEqual_43(this.Rune, that.Rune) &&
-			deriveEqual_44(this.String, that.String) &&
-			deriveEqual_45(this.Uint, that.Uint) &&
-			deriveEqual_46(this.Uint16, that.Uint16) &&
-			deriveEqual_47(this.Uint32, that.Uint32) &&
-			deriveEqual_48(this.Uint64, that.Uint64) &&
-			deriveEqual_49(this.Uint8, that.Uint8) &&
-			deriveEqual_50(this.UintPtr, that.UintPtr) &&
-			deriveEqual_51(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize)
+			deriveEqual_ArrayOf1_PtrTo_bool(this.Bool, that.Bool) &&
+			deriveEqual_ArrayOf2_PtrTo_byte(this.Byte, that.Byte) &&
+			deriveEqual_ArrayOf3_PtrTo_complex128(this.Complex128, that.Complex128) &&
+			deriveEqual_ArrayOf4_PtrTo_complex64(this.Complex64, that.Complex64) &&
+			deriveEqual_ArrayOf5_PtrTo_float64(this.Float64, that.Float64) &&
+			deriveEqual_ArrayOf6_PtrTo_float32(this.Float32, that.Float32) &&
+			deriveEqual_ArrayOf7_PtrTo_int(this.Int, that.Int) &&
+			deriveEqual_ArrayOf8_PtrTo_int16(this.Int16, that.Int16) &&
+			deriveEqual_ArrayOf9_PtrTo_int32(this.Int32, that.Int32) &&
+			deriveEqual_ArrayOf10_PtrTo_int64(this.Int64, that.Int64) &&
+			deriveEqual_ArrayOf11_PtrTo_int8(this.Int8, that.Int8) &&
+			deriveEqual_ArrayOf12_PtrTo_rune(this.Rune, that.Rune) &&
+			deriveEqual_ArrayOf13_PtrTo_string(this.String, that.String) &&
+			deriveEqual_ArrayOf14_PtrTo_uint(this.Uint, that.Uint) &&
+			deriveEqual_ArrayOf15_PtrTo_uint16(this.Uint16, that.Uint16) &&
+			deriveEqual_ArrayOf16_PtrTo_uint32(this.Uint32, that.Uint32) &&
+			deriveEqual_ArrayOf17_PtrTo_uint64(this.Uint64, that.Uint64) &&
+			deriveEqual_ArrayOf18_PtrTo_uint8(this.Uint8, that.Uint8) &&
+			deriveEqual_ArrayOf19_PtrTo_uintptr(this.UintPtr, that.UintPtr) &&
+			deriveEqual_ArrayOf10_PtrTo_bool(this.AnotherBoolOfDifferentSize, that.AnotherBoolOfDifferentSize)
 }
 
 // deriveEqualPtrToMapsOfSimplerBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToMapsOfSimplerBuiltInTypes(this, that *MapsOfSimplerBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_52(this.StringToUint32, that.StringToUint32) &&
-			deriveEqual_53(this.Uint64ToInt64, that.Uint64ToInt64)
+			deriveEqual_MapOf_string_To_uint32(this.StringToUint32, that.StringToUint32) &&
+			deriveEqual_MapOf_uint8_To_int64(this.Uint64ToInt64, that.Uint64ToInt64)
 }
 
 // deriveEqualPtrToMapsOfBuiltInTypes returns whether this and that are equal.
 func deriveEqualPtrToMapsOfBuiltInTypes(this, that *MapsOfBuiltInTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_54(this.BoolToString, that.BoolToString) &&
-			deriveEqual_55(this.StringToBool, that.StringToBool) &&
-			deriveEqual_56(this.Complex128ToComplex64, that.Complex128ToComplex64) &&
-			deriveEqual_57(this.Float64ToUint32, that.Float64ToUint32) &&
-			deriveEqual_58(this.Uint16ToUint8, that.Uint16ToUint8)
+			deriveEqual_MapOf_bool_To_string(this.BoolToString, that.BoolToString) &&
+			deriveEqual_MapOf_string_To_bool(this.StringToBool, that.StringToBool) &&
+			deriveEqual_MapOf_complex128_To_complex64(this.Complex128ToComplex64, that.Complex128ToComplex64) &&
+			deriveEqual_MapOf_float64_To_uint32(this.Float64ToUint32, that.Float64ToUint32) &&
+			deriveEqual_MapOf_uint16_To_uint8(this.Uint16ToUint8, that.Uint16ToUint8)
 }
 
 // deriveEqualPtrToSliceToSlice returns whether this and that are equal.
 func deriveEqualPtrToSliceToSlice(this, that *SliceToSlice) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_59(this.Ints, that.Ints) &&
-			deriveEqual_60(this.Strings, that.Strings) &&
-			deriveEqual_61(this.IntPtrs, that.IntPtrs)
+			deriveEqual_SliceOf_SliceOf_int(this.Ints, that.Ints) &&
+			deriveEqual_SliceOf_SliceOf_string(this.Strings, that.Strings) &&
+			deriveEqual_SliceOf_SliceOf_PtrTo_int(this.IntPtrs, that.IntPtrs)
 }
 
 // deriveEqualPtrToPtrTo returns whether this and that are equal.
@@ -4337,28 +4005,28 @@ func deriveEqualPtrToStructs(this, that *Structs) bool {
 		this != nil && that != nil &&
 			this.Struct.Equal(&that.Struct) &&
 			this.PtrToStruct.Equal(that.PtrToStruct) &&
-			deriveEqual_62(this.SliceOfStructs, that.SliceOfStructs) &&
-			deriveEqual_63(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct) &&
+			deriveEqual_SliceOf_Name(this.SliceOfStructs, that.SliceOfStructs) &&
+			deriveEqual_SliceOf_PtrTo_Name(this.SliceToPtrOfStruct, that.SliceToPtrOfStruct) &&
 			this.StructWithoutMethod == that.StructWithoutMethod &&
-			deriveEqual_64(this.PtrToStructWithoutMethod, that.PtrToStructWithoutMethod) &&
-			deriveEqual_65(this.SliceOfStructWithoutMethod, that.SliceOfStructWithoutMethod) &&
-			deriveEqual_66(this.SliceToPtrOfStructWithoutMethod, that.SliceToPtrOfStructWithoutMethod)
+			deriveEqual_PtrTo_StructWithoutMethod(this.PtrToStructWithoutMethod, that.PtrToStructWithoutMethod) &&
+			deriveEqual_SliceOf_StructWithoutMethod(this.SliceOfStructWithoutMethod, that.SliceOfStructWithoutMethod) &&
+			deriveEqual_SliceOf_PtrTo_StructWithoutMethod(this.SliceToPtrOfStructWithoutMethod, that.SliceToPtrOfStructWithoutMethod)
 }
 
 // deriveEqualPtrToMapWithStructs returns whether this and that are equal.
 func deriveEqualPtrToMapWithStructs(this, that *MapWithStructs) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_67(this.NameToString, that.NameToString) &&
-			deriveEqual_68(this.StringToName, that.StringToName) &&
-			deriveEqual_69(this.StringToPtrToName, that.StringToPtrToName) &&
-			deriveEqual_70(this.StringToSliceOfName, that.StringToSliceOfName) &&
-			deriveEqual_71(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName) &&
-			deriveEqual_72(this.StringToStructWithoutMethod, that.StringToStructWithoutMethod) &&
-			deriveEqual_73(this.StructWithoutMethodToString, that.StructWithoutMethodToString) &&
-			deriveEqual_74(this.StringToPtrToStructWithoutMethod, that.StringToPtrToStructWithoutMethod) &&
-			deriveEqual_75(this.StringToSliceOfStructWithoutMethod, that.StringToSliceOfStructWithoutMethod) &&
-			deriveEqual_76(this.StringToSliceOfPtrToStructWithoutMethod, that.StringToSliceOfPtrToStructWithoutMethod)
+			deriveEqual_MapOf_Name_To_string(this.NameToString, that.NameToString) &&
+			deriveEqual_MapOf_string_To_Name(this.StringToName, that.StringToName) &&
+			deriveEqual_MapOf_string_To_PtrTo_Name(this.StringToPtrToName, that.StringToPtrToName) &&
+			deriveEqual_MapOf_string_To_SliceOf_Name(this.StringToSliceOfName, that.StringToSliceOfName) &&
+			deriveEqual_MapOf_string_To_SliceOf_PtrTo_Name(this.StringToSliceOfPtrToName, that.StringToSliceOfPtrToName) &&
+			deriveEqual_MapOf_string_To_StructWithoutMethod(this.StringToStructWithoutMethod, that.StringToStructWithoutMethod) &&
+			deriveEqual_MapOf_StructWithoutMethod_To_string(this.StructWithoutMethodToString, that.StructWithoutMethodToString) &&
+			deriveEqual_MapOf_string_To_PtrTo_StructWithoutMethod(this.StringToPtrToStructWithoutMethod, that.StringToPtrToStructWithoutMethod) &&
+			deriveEqual_MapOf_string_To_SliceOf_StructWithoutMethod(this.StringToSliceOfStructWithoutMethod, that.StringToSliceOfStructWithoutMethod) &&
+			deriveEqual_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this.StringToSliceOfPtrToStructWithoutMethod, that.StringToSliceOfPtrToStructWithoutMethod)
 }
 
 // deriveEqualPtrToRecursiveType returns whether this and that are equal.
@@ -4366,7 +4034,7 @@ func deriveEqualPtrToRecursiveType(this, that *RecursiveType) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			bytes.Equal(this.Bytes, that.Bytes) &&
-			deriveEqual_77(this.N, that.N)
+			deriveEqual_MapOf_int_To_RecursiveType(this.N, that.N)
 }
 
 // deriveEqualPtrToEmbeddedStruct1 returns whether this and that are equal.
@@ -4384,7 +4052,7 @@ func deriveEqualPtrToEmbeddedStruct2(this, that *EmbeddedStruct2) bool {
 		this != nil && that != nil &&
 			this.Structs.Equal(&that.Structs) &&
 			this.Name.Equal(that.Name) &&
-			deriveEqual_64(this.StructWithoutMethod, that.StructWithoutMethod)
+			deriveEqual_PtrTo_StructWithoutMethod(this.StructWithoutMethod, that.StructWithoutMethod)
 }
 
 // deriveEqualPtrToUnnamedStruct returns whether this and that are equal.
@@ -4398,7 +4066,7 @@ func deriveEqualPtrToUnnamedStruct(this, that *UnnamedStruct) bool {
 func deriveEqualPtrToStructWithStructFieldWithoutEqualMethod(this, that *StructWithStructFieldWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_78(this.A, that.A) &&
+			deriveEqual_PtrTo_StructWithoutEqualMethod(this.A, that.A) &&
 			this.B == that.B
 }
 
@@ -4406,7 +4074,7 @@ func deriveEqualPtrToStructWithStructFieldWithoutEqualMethod(this, that *StructW
 func deriveEqualPtrToStructWithStructWithFromAnotherPackage(this, that *StructWithStructWithFromAnotherPackage) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_79(this.A, that.A) &&
+			deriveEqual_PtrTo_extra_StructWithoutEqualMethod(this.A, that.A) &&
 			this.B == that.B
 }
 
@@ -4414,7 +4082,7 @@ func deriveEqualPtrToStructWithStructWithFromAnotherPackage(this, that *StructWi
 func deriveEqualPtrToFieldWithStructWithPrivateFields(this, that *FieldWithStructWithPrivateFields) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_80(this.A, that.A)
+			deriveEqual_PtrTo_extra_PrivateFieldAndNoEqualMethod(this.A, that.A)
 }
 
 // deriveEqualPtrToEnums returns whether this and that are equal.
@@ -4422,11 +4090,11 @@ func deriveEqualPtrToEnums(this, that *Enums) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Enum == that.Enum &&
-			deriveEqual_81(this.PtrToEnum, that.PtrToEnum) &&
-			deriveEqual_82(this.SliceToEnum, that.SliceToEnum) &&
-			deriveEqual_83(this.SliceToPtrToEnum, that.SliceToPtrToEnum) &&
-			deriveEqual_84(this.MapToEnum, that.MapToEnum) &&
-			deriveEqual_85(this.EnumToMap, that.EnumToMap) &&
+			deriveEqual_PtrTo_MyEnum(this.PtrToEnum, that.PtrToEnum) &&
+			deriveEqual_SliceOf_MyEnum(this.SliceToEnum, that.SliceToEnum) &&
+			deriveEqual_SliceOf_PtrTo_MyEnum(this.SliceToPtrToEnum, that.SliceToPtrToEnum) &&
+			deriveEqual_MapOf_int32_To_MyEnum(this.MapToEnum, that.MapToEnum) &&
+			deriveEqual_MapOf_MyEnum_To_int32(this.EnumToMap, that.EnumToMap) &&
 			this.ArrayEnum == that.ArrayEnum
 }
 
@@ -4434,9 +4102,9 @@ func deriveEqualPtrToEnums(this, that *Enums) bool {
 func deriveEqualPtrToNamedTypes(this, that *NamedTypes) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_7(this.Slice, that.Slice) &&
-			deriveEqual_86(this.PtrToSlice, that.PtrToSlice) &&
-			deriveEqual_87(this.SliceToSlice, that.SliceToSlice)
+			deriveEqual_SliceOf_int64(this.Slice, that.Slice) &&
+			deriveEqual_PtrTo_MySlice(this.PtrToSlice, that.PtrToSlice) &&
+			deriveEqual_SliceOf_MySlice(this.SliceToSlice, that.SliceToSlice)
 }
 
 // deriveEqualPtrToTime returns whether this and that are equal.
@@ -4452,29 +4120,47 @@ func deriveEqualPtrToDuration(this, that *Duration) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.D == that.D &&
-			deriveEqual_88(this.P, that.P) &&
-			deriveEqual_89(this.Ds, that.Ds) &&
-			deriveEqual_90(this.DPs, that.DPs) &&
-			deriveEqual_91(this.MD, that.MD)
+			deriveEqual_PtrTo_time_Duration(this.P, that.P) &&
+			deriveEqual_SliceOf_time_Duration(this.Ds, that.Ds) &&
+			deriveEqual_SliceOf_PtrTo_time_Duration(this.DPs, that.DPs) &&
+			deriveEqual_MapOf_int_To_time_Duration(this.MD, that.MD)
 }
 
 // deriveEqualPtrToNickname returns whether this and that are equal.
 func deriveEqualPtrToNickname(this, that *Nickname) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_92(this.Alias, that.Alias)
+			deriveEqual_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this.Alias, that.Alias)
 }
 
 // deriveEqualPtrToPrivateEmbedded returns whether this and that are equal.
 func deriveEqualPtrToPrivateEmbedded(this, that *PrivateEmbedded) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			deriveEqual_93(&this.privateStruct, &that.privateStruct)
+			deriveEqual_PtrTo_privateStruct(&this.privateStruct, &that.privateStruct)
 }
 
-// deriveEqualInefficientDeriveTheDerived returns whether this and that are equal.
-func deriveEqualInefficientDeriveTheDerived(this, that int) bool {
-	return this == that
+// deriveEqualPtrToGenerics returns whether this and that are equal.
+func deriveEqualPtrToGenerics(this, that *Generics) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			deriveEqualTreeOfInt(this.IntTree, that.IntTree) &&
+			deriveEqual_PtrTo_Tree_Name(&this.NameTree, &that.NameTree) &&
+			deriveEqual_SliceOf_Pair_string_PtrTo_Name(this.Pairs, that.Pairs) &&
+			deriveEqual_MapOf_string_To_Pair_int64_SliceOf_string(this.PairsByKey, that.PairsByKey) &&
+			deriveEqual_PtrTo_extra_Box_Pair_string_int(this.Box, that.Box)
+}
+
+// deriveEqualPtrToCache returns whether this and that are equal.
+func deriveEqualPtrToCache(this, that *Cache) bool {
+	// mu is skipped, since it is tagged with `derive:"-"`.
+	// Hits is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	// Updated is skipped, since it is tagged with `derive:"-"`.
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Key == that.Key &&
+			deriveEqualSliceOfint(this.Values, that.Values) &&
+			deriveEqual_PtrTo_CacheEntry(&this.Entry, &that.Entry)
 }
 
 // deriveEqualSliceOfint returns whether this and that are equal.
@@ -4513,165 +4199,22 @@ func deriveEqualMapOfintToint(this, that map[int]int) bool {
 	return true
 }
 
-// deriveEqualPtrToint returns whether this and that are equal.
-func deriveEqualPtrToint(this, that *int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		return *this == *that
-	}
-	return false
-}
-
-// deriveEqualPtrToSliceOfint returns whether this and that are equal.
-func deriveEqualPtrToSliceOfint(this, that *[]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		if *this == nil || *that == nil {
-			return *this == nil && *that == nil
-		}
-		if len(*this) != len(*that) {
-			return false
-		}
-		for i := 0; i < len(*this); i++ {
-			if !((*this)[i] == (*that)[i]) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-// deriveEqualPtrToArray10Ofint returns whether this and that are equal.
-func deriveEqualPtrToArray10Ofint(this, that *[10]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		for i := 0; i < len(*this); i++ {
-			if !((*this)[i] == (*that)[i]) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
-}
-
-// deriveEqualPtrToMapOfintToint returns whether this and that are equal.
-func deriveEqualPtrToMapOfintToint(this, that *map[int]int) bool {
-	if this == nil && that == nil {
-		return true
-	}
-	if this != nil && that != nil {
-		if *this == nil || *that == nil {
-			return *this == nil && *that == nil
-		}
-		if len(*this) != len(*that) {
-			return false
-		}
-		for k, v := range *this {
-			thatv, ok := (*that)[k]
-			if !ok {
-				return false
-			}
-			if !(v == thatv) {
-				return false
-			}
-		}
-		return true
-	}
-	return false
+// deriveEqualTreeOfInt returns whether this and that are equal.
+func deriveEqualTreeOfInt(this, that *Tree[int]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value == that.Value &&
+			deriveEqual_SliceOf_PtrTo_Tree_int(this.Children, that.Children)
 }
 
-// deriveEqual1 returns whether this and that are equal.
-func deriveEqual1(this, that BuiltInTypes) bool {
-	return (&this).Equal(&that)
-}
-
-// deriveEqualCurry returns an equal closure, with the first parameter already filled in.
-func deriveEqualCurry(this *BuiltInTypes) func(*BuiltInTypes) bool {
-	return func(that *BuiltInTypes) bool {
-		return (this == nil && that == nil) ||
-			this != nil && that != nil &&
-				this.Bool == that.Bool &&
-				this.Byte == that.Byte &&
-				this.Complex128 == that.Complex128 &&
-				this.Complex64 == that.Complex64 &&
-				this.Float64 == that.Float64 &&
-				this.Float32 == that.Float32 &&
-				this.Int == that.Int &&
-				this.Int16 == that.Int16 &&
-				this.Int32 == that.Int32 &&
-				this.Int64 == that.Int64 &&
-				this.Int8 == that.Int8 &&
-				this.Rune == that.Rune &&
-				this.String == that.String &&
-				this.Uint == that.Uint &&
-				this.Uint16 == that.Uint16 &&
-				this.Uint32 == that.Uint32 &&
-				this.Uint64 == that.Uint64 &&
-				this.Uint8 == that.Uint8 &&
-				this.UintPtr == that.UintPtr
-	}
-}
-
-// deriveEqualMapTypes returns whether this and that are equal.
-func deriveEqualMapTypes(this, that *SomeJson) bool {
+// deriveEqual_PtrTo_Methods returns whether this and that are equal.
+func deriveEqual_PtrTo_Methods(this, that *Methods) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Name == that.Name &&
-			this.Other.Equal(that.Other)
-}
-
-// deriveEqualVisitor returns whether this and that are equal.
-func deriveEqualVisitor(this, that Visitor) bool {
-	return deriveEqual_94(&this, &that)
-}
-
-// deriveEqual returns whether this and that are equal.
-func deriveEqual(this, that PtrToBuiltInTypes) bool {
-	return (&this).Equal(&that)
-}
-
-// deriveCurryMarshal returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurryMarshal(f func(data []byte, v any) error) func(data []byte) func(v any) error {
-	return func(data []byte) func(v any) error {
-		return func(v any) error {
-			return f(data, v)
-		}
-	}
-}
-
-// deriveCurry3 returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurry3(f func(a int, b string, c bool) string) func(a int) func(b string, c bool) string {
-	return func(a int) func(b string, c bool) string {
-		return func(b string, c bool) string {
-			return f(a, b, c)
-		}
-	}
-}
-
-// deriveCurryCurried returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurryCurried(f func(b string, c bool) string) func(b string) func(c bool) string {
-	return func(b string) func(c bool) string {
-		return func(c bool) string {
-			return f(b, c)
-		}
-	}
-}
-
-// deriveCurryBlackIdentifier returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveCurryBlackIdentifier(f func(param_0 string, param_1 bool, param_2 int) string) func(param_0 string) func(param_1 bool, param_2 int) string {
-	return func(param_0 string) func(param_1 bool, param_2 int) string {
-		return func(param_1 bool, param_2 int) string {
-			return f(param_0, param_1, param_2)
-		}
-	}
+			deriveEqual_SliceOf_string(this.Aliases, that.Aliases) &&
+			deriveEqual_PtrTo_Pair_string_int64(this.Pair, that.Pair) &&
+			this.Inner.Equal(&that.Inner)
 }
 
 // deriveCloneEmpty returns a clone of the src parameter.
@@ -4704,108 +4247,36 @@ func deriveClonePtrToBuiltInTypes(src *PtrToBuiltInTypes) *PtrToBuiltInTypes {
 	return dst
 }
 
-// deriveCloneSliceOfint returns a clone of the src parameter.
-func deriveCloneSliceOfint(src []int) []int {
-	if src == nil {
-		return nil
-	}
-	dst := make([]int, len(src))
-	deriveDeepCopy_51(dst, src)
-	return dst
-}
-
-// deriveCloneMapOfintToint returns a clone of the src parameter.
-func deriveCloneMapOfintToint(src map[int]int) map[int]int {
-	if src == nil {
-		return nil
-	}
-	dst := make(map[int]int)
-	deriveDeepCopy_52(dst, src)
-	return dst
-}
-
-// deriveClonePtrToint returns a clone of the src parameter.
-func deriveClonePtrToint(src *int) *int {
-	if src == nil {
-		return nil
-	}
-	dst := new(int)
-	deriveDeepCopy_53(dst, src)
-	return dst
-}
-
-// deriveClonePtrToSliceOfint returns a clone of the src parameter.
-func deriveClonePtrToSliceOfint(src *[]int) *[]int {
+// deriveCloneGenerics returns a clone of the src parameter.
+func deriveCloneGenerics(src *Generics) *Generics {
 	if src == nil {
 		return nil
 	}
-	dst := new([]int)
-	deriveDeepCopy_26(dst, src)
+	dst := new(Generics)
+	deriveDeepCopyPtrToGenerics(dst, src)
 	return dst
 }
 
-// deriveClonePtrToArray10Ofint returns a clone of the src parameter.
-func deriveClonePtrToArray10Ofint(src *[10]int) *[10]int {
+// deriveCloneCache returns a clone of the src parameter.
+func deriveCloneCache(src *Cache) *Cache {
 	if src == nil {
 		return nil
 	}
-	dst := new([10]int)
-	deriveDeepCopy_54(dst, src)
+	dst := new(Cache)
+	deriveClone_PtrTo_Cache(dst, src)
 	return dst
 }
 
-// deriveClonePtrToMapOfintToint returns a clone of the src parameter.
-func deriveClonePtrToMapOfintToint(src *map[int]int) *map[int]int {
+// deriveClone_PtrTo_Methods returns a clone of the src parameter.
+func deriveClone_PtrTo_Methods(src *Methods) *Methods {
 	if src == nil {
 		return nil
 	}
-	dst := new(map[int]int)
-	deriveDeepCopy_27(dst, src)
+	dst := new(Methods)
+	deriveDeepCopy_PtrTo_Methods(dst, src)
 	return dst
 }
 
-// deriveClone1 returns a clone of the src parameter.
-func deriveClone1(src BuiltInTypes) BuiltInTypes {
-	dst := new(BuiltInTypes)
-	deriveDeepCopyPtrToBuiltInTypes(dst, &src)
-	return *dst
-}
-
-// deriveApplyMarshal applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveApplyMarshal(f func(v any) ([]byte, error), v any) func() ([]byte, error) {
-	return func() ([]byte, error) {
-		return f(v)
-	}
-}
-
-// deriveApplyMultiple applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveApplyMultiple(f func(a int, b string, c bool) string, c bool) func(a int, b string) string {
-	return func(a int, b string) string {
-		return f(a, b, c)
-	}
-}
-
-// deriveApply3 applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveApply3(f func(a string, b int, c bool) string, c bool) func(a string, b int) string {
-	return func(a string, b int) string {
-		return f(a, b, c)
-	}
-}
-
-// deriveApplyApplied applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveApplyApplied(f func(a string, b int) string, b int) func(a string) string {
-	return func(a string) string {
-		return f(a, b)
-	}
-}
-
-// deriveApplyBlankIdentifier applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
-func deriveApplyBlankIdentifier(f func(a string, param_1 bool, c int) string, c int) func(a string, param_1 bool) string {
-	return func(a string, param_1 bool) string {
-		return f(a, param_1, c)
-	}
-}
-
 // deriveSortedInts sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
@@ -4814,46 +4285,6 @@ func deriveSortedInts(list []int) []int {
 	return list
 }
 
-// deriveSortInt64s sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortInt64s(list []int64) []int64 {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
-// deriveSortStructs sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortStructs(list []*BuiltInTypes) []*BuiltInTypes {
-	sort.Slice(list, func(i, j int) bool { return deriveComparePtrToBuiltInTypes(list[i], list[j]) < 0 })
-	return list
-}
-
-// deriveSortedSliceIntAlias sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortedSliceIntAlias(list []intAlias) []intAlias {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
-// deriveSortedSliceStringAlias sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortedSliceStringAlias(list []stringAlias) []stringAlias {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
-// deriveSortedSliceFloat64Alias sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortedSliceFloat64Alias(list []float64Alias) []float64Alias {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
 // deriveSortedStrings sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
@@ -4862,269 +4293,6 @@ func deriveSortedStrings(list []string) []string {
 	return list
 }
 
-// deriveSortedStringKeyAlias sorts the slice inplace and also returns it.
-//
-// Deprecated: In favour of generics.
-func deriveSortedStringKeyAlias(list []stringKeyAlias) []stringKeyAlias {
-	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
-	return list
-}
-
-// deriveKeysForInt64s returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForInt64s(m map[int64]struct{}) []int64 {
-	keys := make([]int64, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForFmap returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForFmap(m map[int]string) []int {
-	keys := make([]int, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapStringToString returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForMapStringToString(m map[string]string) []string {
-	keys := make([]string, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapStringAliasToString returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForMapStringAliasToString(m map[stringKeyAlias]string) []stringKeyAlias {
-	keys := make([]stringKeyAlias, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapStringToStringAlias returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForMapStringToStringAlias(m map[string]stringAlias) []string {
-	keys := make([]string, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapIntToInt64 returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForMapIntToInt64(m map[int]int64) []int {
-	keys := make([]int, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveKeysForMapInt64ToInt64 returns the keys of the input map as a slice.
-//
-// Deprecated: In favour of generics.
-func deriveKeysForMapInt64ToInt64(m map[int64]int64) []int64 {
-	keys := make([]int64, 0, len(m))
-	for key := range m {
-		keys = append(keys, key)
-	}
-	return keys
-}
-
-// deriveJoinSS concatenates the list of lists into one list.
-func deriveJoinSS(listOfLists [][]string) []string {
-	if listOfLists == nil {
-		return nil
-	}
-	l := 0
-	for _, elem := range listOfLists {
-		l += len(elem)
-	}
-	res := make([]string, 0, l)
-	for _, elem := range listOfLists {
-		res = append(res, elem...)
-	}
-	return res
-}
-
-// deriveJoinEE returns the error or calls f and returns it's value and error.
-func deriveJoinEE(f func() (int64, error), err error) (int64, error) {
-	if err != nil {
-		return 0, err
-	}
-	return f()
-}
-
-// deriveJoinChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
-func deriveJoinChannels(in <-chan (<-chan int)) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoin concatenates the list of lists into one list.
-func deriveJoin(listOfLists [][]int) []int {
-	if listOfLists == nil {
-		return nil
-	}
-	l := 0
-	for _, elem := range listOfLists {
-		l += len(elem)
-	}
-	res := make([]int, 0, l)
-	for _, elem := range listOfLists {
-		res = append(res, elem...)
-	}
-	return res
-}
-
-// deriveJoinString concatenates the list of strings into one string.
-func deriveJoinString(list []string) string {
-	return strings.Join(list, "")
-}
-
-// deriveJoinJustError returns the error or calls f and returns it's error.
-func deriveJoinJustError(f func() error, err error) error {
-	if err != nil {
-		return err
-	}
-	return f()
-}
-
-// deriveJoinErrorAndString returns the error or calls f and returns it's value and error.
-func deriveJoinErrorAndString(f func() (string, error), err error) (string, error) {
-	if err != nil {
-		return "", err
-	}
-	return f()
-}
-
-// deriveJoinErrorAndValues returns the error or calls f and returns it's value and error.
-func deriveJoinErrorAndValues(f func() (string, int, error), err error) (string, int, error) {
-	if err != nil {
-		return "", 0, err
-	}
-	return f()
-}
-
-// deriveJoinSendRecvChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
-func deriveJoinSendRecvChannels(in chan (<-chan int64)) <-chan int64 {
-	out := make(chan int64)
-	go func() {
-		wait := sync.WaitGroup{}
-		for c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinSliceOfRecvChannels listens on all input channels and sends all their results onto the single output channel.
-func deriveJoinSliceOfRecvChannels(in []<-chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for _, c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinSliceOfSendRecvChannels listens on all input channels and sends all their results onto the single output channel.
-func deriveJoinSliceOfSendRecvChannels(in []chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		wait := sync.WaitGroup{}
-		for _, c := range in {
-			wait.Add(1)
-			res := c
-			go func() {
-				for r := range res {
-					out <- r
-				}
-				wait.Done()
-			}()
-		}
-		wait.Wait()
-		close(out)
-	}()
-	return out
-}
-
-// deriveJoinVariantOfSendRecvChannels listens on all input channels c0 and c1, and sends all their results onto the single output channel.
-func deriveJoinVariantOfSendRecvChannels(c0 chan int, c1 chan int) <-chan int {
-	out := make(chan int)
-	go func() {
-		for c0 != nil || c1 != nil {
-			select {
-			case v0, ok0 := <-c0:
-				if !ok0 {
-					c0 = nil
-				} else {
-					out <- v0
-				}
-			case v1, ok1 := <-c1:
-				if !ok1 {
-					c1 = nil
-				} else {
-					out <- v1
-				}
-			}
-		}
-		close(out)
-	}()
-	return out
-}
-
 // deriveHashEmpty returns the hash of the object.
 func deriveHashEmpty(object *Empty) uint64 {
 	if object == nil {
@@ -5139,7 +4307,7 @@ func deriveHashBuiltInTypes(object *BuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.Bool)
+	h = 31*h + deriveHash_bool(object.Bool)
 	h = 31*h + uint64(object.Byte)
 	h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object.Complex128)))) + math.Float64bits(imag(object.Complex128))
 	h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object.Complex64))))) + uint64(math.Float32bits(imag(object.Complex64)))
@@ -5151,7 +4319,7 @@ func deriveHashBuiltInTypes(object *BuiltInTypes) uint64 {
 	h = 31*h + uint64(object.Int64)
 	h = 31*h + uint64(object.Int8)
 	h = 31*h + uint64(object.Rune)
-	h = 31*h + deriveHash_s(object.String)
+	h = 31*h + deriveHash_string(object.String)
 	h = 31*h + uint64(object.Uint)
 	h = 31*h + uint64(object.Uint16)
 	h = 31*h + uint64(object.Uint32)
@@ -5167,7 +4335,7 @@ func deriveHashPtrToPrivateBuiltInTypes(object *PrivateBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_(object.privateBool)
+	h = 31*h + deriveHash_bool(object.privateBool)
 	h = 31*h + uint64(object.privateByte)
 	h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object.privateComplex128)))) + math.Float64bits(imag(object.privateComplex128))
 	h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object.privateComplex64))))) + uint64(math.Float32bits(imag(object.privateComplex64)))
@@ -5179,7 +4347,7 @@ func deriveHashPtrToPrivateBuiltInTypes(object *PrivateBuiltInTypes) uint64 {
 	h = 31*h + uint64(object.privateInt64)
 	h = 31*h + uint64(object.privateInt8)
 	h = 31*h + uint64(object.privateRune)
-	h = 31*h + deriveHash_s(object.privateString)
+	h = 31*h + deriveHash_string(object.privateString)
 	h = 31*h + uint64(object.privateUint)
 	h = 31*h + uint64(object.privateUint16)
 	h = 31*h + uint64(object.privateUint32)
@@ -5195,25 +4363,25 @@ func deriveHashPtrToBuiltInTypes(object *PtrToBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_1(object.Bool)
-	h = 31*h + deriveHash_2(object.Byte)
-	h = 31*h + deriveHash_3(object.Complex128)
-	h = 31*h + deriveHash_4(object.Complex64)
-	h = 31*h + deriveHash_5(object.Float64)
-	h = 31*h + deriveHash_6(object.Float32)
+	h = 31*h + deriveHash_PtrTo_bool(object.Bool)
+	h = 31*h + deriveHash_PtrTo_byte(object.Byte)
+	h = 31*h + deriveHash_PtrTo_complex128(object.Complex128)
+	h = 31*h + deriveHash_PtrTo_complex64(object.Complex64)
+	h = 31*h + deriveHash_PtrTo_float64(object.Float64)
+	h = 31*h + deriveHash_PtrTo_float32(object.Float32)
 	h = 31*h + deriveHashPtrToint(object.Int)
-	h = 31*h + deriveHash_7(object.Int16)
-	h = 31*h + deriveHash_8(object.Int32)
-	h = 31*h + deriveHash_9(object.Int64)
-	h = 31*h + deriveHash_10(object.Int8)
-	h = 31*h + deriveHash_8(object.Rune)
-	h = 31*h + deriveHash_11(object.String)
-	h = 31*h + deriveHash_12(object.Uint)
-	h = 31*h + deriveHash_13(object.Uint16)
-	h = 31*h + deriveHash_14(object.Uint32)
-	h = 31*h + deriveHash_15(object.Uint64)
-	h = 31*h + deriveHash_2(object.Uint8)
-	h = 31*h + deriveHash_16(object.UintPtr)
+	h = 31*h + deriveHash_PtrTo_int16(object.Int16)
+	h = 31*h + deriveHash_PtrTo_int32(object.Int32)
+	h = 31*h + deriveHash_PtrTo_int64(object.Int64)
+	h = 31*h + deriveHash_PtrTo_int8(object.Int8)
+	h = 31*h + deriveHash_PtrTo_int32(object.Rune)
+	h = 31*h + deriveHash_PtrTo_string(object.String)
+	h = 31*h + deriveHash_PtrTo_uint(object.Uint)
+	h = 31*h + deriveHash_PtrTo_uint16(object.Uint16)
+	h = 31*h + deriveHash_PtrTo_uint32(object.Uint32)
+	h = 31*h + deriveHash_PtrTo_uint64(object.Uint64)
+	h = 31*h + deriveHash_PtrTo_byte(object.Uint8)
+	h = 31*h + deriveHash_PtrTo_uintptr(object.UintPtr)
 	return h
 }
 
@@ -5223,25 +4391,25 @@ func deriveHashSliceOfBuiltInTypes(object *SliceOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_17(object.Bool)
-	h = 31*h + deriveHash_18(object.Byte)
-	h = 31*h + deriveHash_19(object.Complex128)
-	h = 31*h + deriveHash_20(object.Complex64)
-	h = 31*h + deriveHash_21(object.Float64)
-	h = 31*h + deriveHash_22(object.Float32)
+	h = 31*h + deriveHash_SliceOf_bool(object.Bool)
+	h = 31*h + deriveHash_SliceOf_byte(object.Byte)
+	h = 31*h + deriveHash_SliceOf_complex128(object.Complex128)
+	h = 31*h + deriveHash_SliceOf_complex64(object.Complex64)
+	h = 31*h + deriveHash_SliceOf_float64(object.Float64)
+	h = 31*h + deriveHash_SliceOf_float32(object.Float32)
 	h = 31*h + deriveHashSliceOfint(object.Int)
-	h = 31*h + deriveHash_23(object.Int16)
-	h = 31*h + deriveHash_24(object.Int32)
-	h = 31*h + deriveHash_25(object.Int64)
-	h = 31*h + deriveHash_26(object.Int8)
-	h = 31*h + deriveHash_24(object.Rune)
-	h = 31*h + deriveHash_27(object.String)
-	h = 31*h + deriveHash_28(object.Uint)
-	h = 31*h + deriveHash_29(object.Uint16)
-	h = 31*h + deriveHash_30(object.Uint32)
-	h = 31*h + deriveHash_31(object.Uint64)
-	h = 31*h + deriveHash_18(object.Uint8)
-	h = 31*h + deriveHash_32(object.UintPtr)
+	h = 31*h + deriveHash_SliceOf_int16(object.Int16)
+	h = 31*h + deriveHash_SliceOf_int32(object.Int32)
+	h = 31*h + deriveHash_SliceOf_int64(object.Int64)
+	h = 31*h + deriveHash_SliceOf_int8(object.Int8)
+	h = 31*h + deriveHash_SliceOf_int32(object.Rune)
+	h = 31*h + deriveHash_SliceOf_string(object.String)
+	h = 31*h + deriveHash_SliceOf_uint(object.Uint)
+	h = 31*h + deriveHash_SliceOf_uint16(object.Uint16)
+	h = 31*h + deriveHash_SliceOf_uint32(object.Uint32)
+	h = 31*h + deriveHash_SliceOf_uint64(object.Uint64)
+	h = 31*h + deriveHash_SliceOf_byte(object.Uint8)
+	h = 31*h + deriveHash_SliceOf_uintptr(object.UintPtr)
 	return h
 }
 
@@ -5251,25 +4419,25 @@ func deriveHashSliceOfPtrToBuiltInTypes(object *SliceOfPtrToBuiltInTypes) uint64
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_33(object.Bool)
-	h = 31*h + deriveHash_34(object.Byte)
-	h = 31*h + deriveHash_35(object.Complex128)
-	h = 31*h + deriveHash_36(object.Complex64)
-	h = 31*h + deriveHash_37(object.Float64)
-	h = 31*h + deriveHash_38(object.Float32)
-	h = 31*h + deriveHash_39(object.Int)
-	h = 31*h + deriveHash_40(object.Int16)
-	h = 31*h + deriveHash_41(object.Int32)
-	h = 31*h + deriveHash_42(object.Int64)
-	h = 31*h + deriveHash_43(object.Int8)
-	h = 31*h + deriveHash_41(object.Rune)
-	h = 31*h + deriveHash_44(object.String)
-	h = 31*h + deriveHash_45(object.Uint)
-	h = 31*h + deriveHash_46(object.Uint16)
-	h = 31*h + deriveHash_47(object.Uint32)
-	h = 31*h + deriveHash_48(object.Uint64)
-	h = 31*h + deriveHash_34(object.Uint8)
-	h = 31*h + deriveHash_49(object.UintPtr)
+	h = 31*h + deriveHash_SliceOf_PtrTo_bool(object.Bool)
+	h = 31*h + deriveHash_SliceOf_PtrTo_byte(object.Byte)
+	h = 31*h + deriveHash_SliceOf_PtrTo_complex128(object.Complex128)
+	h = 31*h + deriveHash_SliceOf_PtrTo_complex64(object.Complex64)
+	h = 31*h + deriveHash_SliceOf_PtrTo_float64(object.Float64)
+	h = 31*h + deriveHash_SliceOf_PtrTo_float32(object.Float32)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int(object.Int)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int16(object.Int16)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int32(object.Int32)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int64(object.Int64)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int8(object.Int8)
+	h = 31*h + deriveHash_SliceOf_PtrTo_int32(object.Rune)
+	h = 31*h + deriveHash_SliceOf_PtrTo_string(object.String)
+	h = 31*h + deriveHash_SliceOf_PtrTo_uint(object.Uint)
+	h = 31*h + deriveHash_SliceOf_PtrTo_uint16(object.Uint16)
+	h = 31*h + deriveHash_SliceOf_PtrTo_uint32(object.Uint32)
+	h = 31*h + deriveHash_SliceOf_PtrTo_uint64(object.Uint64)
+	h = 31*h + deriveHash_SliceOf_PtrTo_byte(object.Uint8)
+	h = 31*h + deriveHash_SliceOf_PtrTo_uintptr(object.UintPtr)
 	return h
 }
 
@@ -5279,26 +4447,26 @@ func deriveHashArrayOfBuiltInTypes(object *ArrayOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_50(object.Bool)
-	h = 31*h + deriveHash_51(object.Byte)
-	h = 31*h + deriveHash_52(object.Complex128)
-	h = 31*h + deriveHash_53(object.Complex64)
-	h = 31*h + deriveHash_54(object.Float64)
-	h = 31*h + deriveHash_55(object.Float32)
-	h = 31*h + deriveHash_56(object.Int)
-	h = 31*h + deriveHash_57(object.Int16)
-	h = 31*h + deriveHash_58(object.Int32)
-	h = 31*h + deriveHash_59(object.Int64)
-	h = 31*h + deriveHash_60(object.Int8)
-	h = 31*h + deriveHash_61(object.Rune)
-	h = 31*h + deriveHash_62(object.String)
-	h = 31*h + deriveHash_63(object.Uint)
-	h = 31*h + deriveHash_64(object.Uint16)
-	h = 31*h + deriveHash_65(object.Uint32)
-	h = 31*h + deriveHash_66(object.Uint64)
-	h = 31*h + deriveHash_67(object.Uint8)
-	h = 31*h + deriveHash_68(object.UintPtr)
-	h = 31*h + deriveHash_69(object.AnotherBoolOfDifferentSize)
+	h = 31*h + deriveHash_ArrayOf1_bool(object.Bool)
+	h = 31*h + deriveHash_ArrayOf2_byte(object.Byte)
+	h = 31*h + deriveHash_ArrayOf3_complex128(object.Complex128)
+	h = 31*h + deriveHash_ArrayOf4_complex64(object.Complex64)
+	h = 31*h + deriveHash_ArrayOf5_float64(object.Float64)
+	h = 31*h + deriveHash_ArrayOf6_float32(object.Float32)
+	h = 31*h + deriveHash_ArrayOf7_int(object.Int)
+	h = 31*h + deriveHash_ArrayOf8_int16(object.Int16)
+	h = 31*h + deriveHash_ArrayOf9_int32(object.Int32)
+	h = 31*h + deriveHash_ArrayOf10_int64(object.Int64)
+	h = 31*h + deriveHash_ArrayOf11_int8(object.Int8)
+	h = 31*h + deriveHash_ArrayOf12_rune(object.Rune)
+	h = 31*h + deriveHash_ArrayOf13_string(object.String)
+	h = 31*h + deriveHash_ArrayOf14_uint(object.Uint)
+	h = 31*h + deriveHash_ArrayOf15_uint16(object.Uint16)
+	h = 31*h + deriveHash_ArrayOf16_uint32(object.Uint32)
+	h = 31*h + deriveHash_ArrayOf17_uint64(object.Uint64)
+	h = 31*h + deriveHash_ArrayOf18_uint8(object.Uint8)
+	h = 31*h + deriveHash_ArrayOf19_uintptr(object.UintPtr)
+	h = 31*h + deriveHash_ArrayOf10_bool(object.AnotherBoolOfDifferentSize)
 	return h
 }
 
@@ -5308,26 +4476,26 @@ func deriveHashArrayOfPtrToBuiltInTypes(object *ArrayOfPtrToBuiltInTypes) uint64
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_70(object.Bool)
-	h = 31*h + deriveHash_71(object.Byte)
-	h = 31*h + deriveHash_72(object.Complex128)
-	h = 31*h + deriveHash_73(object.Complex64)
-	h = 31*h + deriveHash_74(object.Float64)
-	h = 31*h + deriveHash_75(object.Float32)
-	h = 31*h + deriveHash_76(object.Int)
-	h = 31*h + deriveHash_77(object.Int16)
-	h = 31*h + deriveHash_78(object.Int32)
-	h = 31*h + deriveHash_79(object.Int64)
-	h = 31*h + deriveHash_80(object.Int8)
-	h = 31*h + deriveHash_81(object.Rune)
-	h = 31*h + deriveHash_82(object.String)
-	h = 31*h + deriveHash_83(object.Uint)
-	h = 31*h + deriveHash_84(object.Uint16)
-	h = 31*h + deriveHash_85(object.Uint32)
-	h = 31*h + deriveHash_86(object.Uint64)
-	h = 31*h + deriveHash_87(object.Uint8)
-	h = 31*h + deriveHash_88(object.UintPtr)
-	h = 31*h + deriveHash_89(object.AnotherBoolOfDifferentSize)
+	h = 31*h + deriveHash_ArrayOf1_PtrTo_bool(object.Bool)
+	h = 31*h + deriveHash_ArrayOf2_PtrTo_byte(object.Byte)
+	h = 31*h + deriveHash_ArrayOf3_PtrTo_complex128(object.Complex128)
+	h = 31*h + deriveHash_ArrayOf4_PtrTo_complex64(object.Complex64)
+	h = 31*h + deriveHash_ArrayOf5_PtrTo_float64(object.Float64)
+	h = 31*h + deriveHash_ArrayOf6_PtrTo_float32(object.Float32)
+	h = 31*h + deriveHash_ArrayOf7_PtrTo_int(object.Int)
+	h = 31*h + deriveHash_ArrayOf8_PtrTo_int16(object.Int16)
+	h = 31*h + deriveHash_ArrayOf9_PtrTo_int32(object.Int32)
+	h = 31*h + deriveHash_ArrayOf10_PtrTo_int64(object.Int64)
+	h = 31*h + deriveHash_ArrayOf11_PtrTo_int8(object.Int8)
+	h = 31*h + deriveHash_ArrayOf12_PtrTo_rune(object.Rune)
+	h = 31*h + deriveHash_ArrayOf13_PtrTo_string(object.String)
+	h = 31*h + deriveHash_ArrayOf14_PtrTo_uint(object.Uint)
+	h = 31*h + deriveHash_ArrayOf15_PtrTo_uint16(object.Uint16)
+	h = 31*h + deriveHash_ArrayOf16_PtrTo_uint32(object.Uint32)
+	h = 31*h + deriveHash_ArrayOf17_PtrTo_uint64(object.Uint64)
+	h = 31*h + deriveHash_ArrayOf18_PtrTo_uint8(object.Uint8)
+	h = 31*h + deriveHash_ArrayOf19_PtrTo_uintptr(object.UintPtr)
+	h = 31*h + deriveHash_ArrayOf10_PtrTo_bool(object.AnotherBoolOfDifferentSize)
 	return h
 }
 
@@ -5337,8 +4505,8 @@ func deriveHashMapsOfSimplerBuiltInTypes(object *MapsOfSimplerBuiltInTypes) uint
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_90(object.StringToUint32)
-	h = 31*h + deriveHash_91(object.Uint64ToInt64)
+	h = 31*h + deriveHash_MapOf_string_To_uint32(object.StringToUint32)
+	h = 31*h + deriveHash_MapOf_uint8_To_int64(object.Uint64ToInt64)
 	return h
 }
 
@@ -5348,11 +4516,11 @@ func deriveHashMapsOfBuiltInTypes(object *MapsOfBuiltInTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_92(object.BoolToString)
-	h = 31*h + deriveHash_93(object.StringToBool)
-	h = 31*h + deriveHash_94(object.Complex128ToComplex64)
-	h = 31*h + deriveHash_95(object.Float64ToUint32)
-	h = 31*h + deriveHash_96(object.Uint16ToUint8)
+	h = 31*h + deriveHash_MapOf_bool_To_string(object.BoolToString)
+	h = 31*h + deriveHash_MapOf_string_To_bool(object.StringToBool)
+	h = 31*h + deriveHash_MapOf_complex128_To_complex64(object.Complex128ToComplex64)
+	h = 31*h + deriveHash_MapOf_float64_To_uint32(object.Float64ToUint32)
+	h = 31*h + deriveHash_MapOf_uint16_To_uint8(object.Uint16ToUint8)
 	return h
 }
 
@@ -5362,9 +4530,9 @@ func deriveHashSliceToSlice(object *SliceToSlice) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_97(object.Ints)
-	h = 31*h + deriveHash_98(object.Strings)
-	h = 31*h + deriveHash_99(object.IntPtrs)
+	h = 31*h + deriveHash_SliceOf_SliceOf_int(object.Ints)
+	h = 31*h + deriveHash_SliceOf_SliceOf_string(object.Strings)
+	h = 31*h + deriveHash_SliceOf_SliceOf_PtrTo_int(object.IntPtrs)
 	return h
 }
 
@@ -5376,7 +4544,7 @@ func deriveHashPtrTo(object *PtrTo) uint64 {
 	h := uint64(17)
 	h = 31*h + deriveHashPtrToint(object.Basic)
 	h = 31*h + deriveHashPtrToSliceOfint(object.Slice)
-	h = 31*h + deriveHash_100(object.Array)
+	h = 31*h + deriveHash_PtrTo_ArrayOf4_int(object.Array)
 	h = 31*h + deriveHashPtrToMapOfintToint(object.Map)
 	return h
 }
@@ -5387,7 +4555,7 @@ func deriveHashName(object *Name) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_s(object.Name)
+	h = 31*h + deriveHash_string(object.Name)
 	return h
 }
 
@@ -5397,14 +4565,14 @@ func deriveHashStructs(object *Structs) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_N(object.Struct)
+	h = 31*h + deriveHash_Name(object.Struct)
 	h = 31*h + deriveHashName(object.PtrToStruct)
-	h = 31*h + deriveHash_101(object.SliceOfStructs)
-	h = 31*h + deriveHash_102(object.SliceToPtrOfStruct)
-	h = 31*h + deriveHash_S(object.StructWithoutMethod)
-	h = 31*h + deriveHash_103(object.PtrToStructWithoutMethod)
-	h = 31*h + deriveHash_104(object.SliceOfStructWithoutMethod)
-	h = 31*h + deriveHash_105(object.SliceToPtrOfStructWithoutMethod)
+	h = 31*h + deriveHash_SliceOf_Name(object.SliceOfStructs)
+	h = 31*h + deriveHash_SliceOf_PtrTo_Name(object.SliceToPtrOfStruct)
+	h = 31*h + deriveHash_StructWithoutMethod(object.StructWithoutMethod)
+	h = 31*h + deriveHash_PtrTo_StructWithoutMethod(object.PtrToStructWithoutMethod)
+	h = 31*h + deriveHash_SliceOf_StructWithoutMethod(object.SliceOfStructWithoutMethod)
+	h = 31*h + deriveHash_SliceOf_PtrTo_StructWithoutMethod(object.SliceToPtrOfStructWithoutMethod)
 	return h
 }
 
@@ -5414,16 +4582,16 @@ func deriveHashMapWithStructs(object *MapWithStructs) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_106(object.NameToString)
-	h = 31*h + deriveHash_107(object.StringToName)
-	h = 31*h + deriveHash_108(object.StringToPtrToName)
-	h = 31*h + deriveHash_109(object.StringToSliceOfName)
-	h = 31*h + deriveHash_110(object.StringToSliceOfPtrToName)
-	h = 31*h + deriveHash_111(object.StringToStructWithoutMethod)
-	h = 31*h + deriveHash_112(object.StructWithoutMethodToString)
-	h = 31*h + deriveHash_113(object.StringToPtrToStructWithoutMethod)
-	h = 31*h + deriveHash_114(object.StringToSliceOfStructWithoutMethod)
-	h = 31*h + deriveHash_115(object.StringToSliceOfPtrToStructWithoutMethod)
+	h = 31*h + deriveHash_MapOf_Name_To_string(object.NameToString)
+	h = 31*h + deriveHash_MapOf_string_To_Name(object.StringToName)
+	h = 31*h + deriveHash_MapOf_string_To_PtrTo_Name(object.StringToPtrToName)
+	h = 31*h + deriveHash_MapOf_string_To_SliceOf_Name(object.StringToSliceOfName)
+	h = 31*h + deriveHash_MapOf_string_To_SliceOf_PtrTo_Name(object.StringToSliceOfPtrToName)
+	h = 31*h + deriveHash_MapOf_string_To_StructWithoutMethod(object.StringToStructWithoutMethod)
+	h = 31*h + deriveHash_MapOf_StructWithoutMethod_To_string(object.StructWithoutMethodToString)
+	h = 31*h + deriveHash_MapOf_string_To_PtrTo_StructWithoutMethod(object.StringToPtrToStructWithoutMethod)
+	h = 31*h + deriveHash_MapOf_string_To_SliceOf_StructWithoutMethod(object.StringToSliceOfStructWithoutMethod)
+	h = 31*h + deriveHash_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(object.StringToSliceOfPtrToStructWithoutMethod)
 	return h
 }
 
@@ -5433,8 +4601,8 @@ func deriveHashRecursiveType(object *RecursiveType) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_18(object.Bytes)
-	h = 31*h + deriveHash_116(object.N)
+	h = 31*h + deriveHash_SliceOf_byte(object.Bytes)
+	h = 31*h + deriveHash_MapOf_int_To_RecursiveType(object.N)
 	return h
 }
 
@@ -5444,8 +4612,8 @@ func deriveHashEmbeddedStruct1(object *EmbeddedStruct1) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_N(object.Name)
-	h = 31*h + deriveHash_S(object.StructWithoutMethod)
+	h = 31*h + deriveHash_Name(object.Name)
+	h = 31*h + deriveHash_StructWithoutMethod(object.StructWithoutMethod)
 	h = 31*h + deriveHashStructs(object.Structs)
 	return h
 }
@@ -5456,9 +4624,9 @@ func deriveHashEmbeddedStruct2(object *EmbeddedStruct2) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_St(object.Structs)
+	h = 31*h + deriveHash_Structs(object.Structs)
 	h = 31*h + deriveHashName(object.Name)
-	h = 31*h + deriveHash_103(object.StructWithoutMethod)
+	h = 31*h + deriveHash_PtrTo_StructWithoutMethod(object.StructWithoutMethod)
 	return h
 }
 
@@ -5468,8 +4636,8 @@ func deriveHashStructWithStructFieldWithoutEqualMethod(object *StructWithStructF
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_117(object.A)
-	h = 31*h + deriveHash_Str(object.B)
+	h = 31*h + deriveHash_PtrTo_StructWithoutEqualMethod(object.A)
+	h = 31*h + deriveHash_StructWithoutEqualMethod(object.B)
 	return h
 }
 
@@ -5479,8 +4647,8 @@ func deriveHashStructWithStructWithFromAnotherPackage(object *StructWithStructWi
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_118(object.A)
-	h = 31*h + deriveHash_Stru(object.B)
+	h = 31*h + deriveHash_PtrTo_extra_StructWithoutEqualMethod(object.A)
+	h = 31*h + deriveHash_extra_StructWithoutEqualMethod(object.B)
 	return h
 }
 
@@ -5491,12 +4659,12 @@ func deriveHashEnums(object *Enums) uint64 {
 	}
 	h := uint64(17)
 	h = 31*h + uint64(object.Enum)
-	h = 31*h + deriveHash_119(object.PtrToEnum)
-	h = 31*h + deriveHash_120(object.SliceToEnum)
-	h = 31*h + deriveHash_121(object.SliceToPtrToEnum)
-	h = 31*h + deriveHash_122(object.MapToEnum)
-	h = 31*h + deriveHash_123(object.EnumToMap)
-	h = 31*h + deriveHash_124(object.ArrayEnum)
+	h = 31*h + deriveHash_PtrTo_MyEnum(object.PtrToEnum)
+	h = 31*h + deriveHash_SliceOf_MyEnum(object.SliceToEnum)
+	h = 31*h + deriveHash_SliceOf_PtrTo_MyEnum(object.SliceToPtrToEnum)
+	h = 31*h + deriveHash_MapOf_int32_To_MyEnum(object.MapToEnum)
+	h = 31*h + deriveHash_MapOf_MyEnum_To_int32(object.EnumToMap)
+	h = 31*h + deriveHash_ArrayOf2_MyEnum(object.ArrayEnum)
 	return h
 }
 
@@ -5506,9 +4674,9 @@ func deriveHashNamedTypes(object *NamedTypes) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_25(object.Slice)
-	h = 31*h + deriveHash_125(object.PtrToSlice)
-	h = 31*h + deriveHash_126(object.SliceToSlice)
+	h = 31*h + deriveHash_SliceOf_int64(object.Slice)
+	h = 31*h + deriveHash_PtrTo_MySlice(object.PtrToSlice)
+	h = 31*h + deriveHash_SliceOf_MySlice(object.SliceToSlice)
 	return h
 }
 
@@ -5519,10 +4687,10 @@ func deriveHashDuration(object *Duration) uint64 {
 	}
 	h := uint64(17)
 	h = 31*h + uint64(object.D)
-	h = 31*h + deriveHash_127(object.P)
-	h = 31*h + deriveHash_128(object.Ds)
-	h = 31*h + deriveHash_129(object.DPs)
-	h = 31*h + deriveHash_130(object.MD)
+	h = 31*h + deriveHash_PtrTo_time_Duration(object.P)
+	h = 31*h + deriveHash_SliceOf_time_Duration(object.Ds)
+	h = 31*h + deriveHash_SliceOf_PtrTo_time_Duration(object.DPs)
+	h = 31*h + deriveHash_MapOf_int_To_time_Duration(object.MD)
 	return h
 }
 
@@ -5532,7 +4700,7 @@ func deriveHashNickname(object *Nickname) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_131(object.Alias)
+	h = 31*h + deriveHash_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(object.Alias)
 	return h
 }
 
@@ -5542,7 +4710,47 @@ func deriveHashPrivateEmbedded(object *PrivateEmbedded) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_p(object.privateStruct)
+	h = 31*h + deriveHash_privateStruct(object.privateStruct)
+	return h
+}
+
+// deriveHashGenerics returns the hash of the object.
+func deriveHashGenerics(object *Generics) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHashTreeOfInt(object.IntTree)
+	h = 31*h + deriveHash_Tree_Name(object.NameTree)
+	h = 31*h + deriveHash_SliceOf_Pair_string_PtrTo_Name(object.Pairs)
+	h = 31*h + deriveHash_MapOf_string_To_Pair_int64_SliceOf_string(object.PairsByKey)
+	h = 31*h + deriveHash_PtrTo_extra_Box_Pair_string_int(object.Box)
+	return h
+}
+
+// deriveHashCache returns the hash of the object.
+func deriveHashCache(object *Cache) uint64 {
+	if object == nil {
+		return 0
+	}
+	// mu is skipped, since it is tagged with `derive:"-"`.
+	// Hits is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	// Updated is skipped, since it is tagged with `derive:"-"`.
+	h := uint64(17)
+	h = 31*h + deriveHash_string(object.Key)
+	h = 31*h + deriveHashSliceOfint(object.Values)
+	h = 31*h + deriveHash_CacheEntry(object.Entry)
+	return h
+}
+
+// deriveHashTreeOfInt returns the hash of the object.
+func deriveHashTreeOfInt(object *Tree[int]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + uint64(object.Value)
+	h = 31*h + deriveHash_SliceOf_PtrTo_Tree_int(object.Children)
 	return h
 }
 
@@ -5564,7 +4772,7 @@ func deriveHashMapOfintToint(object map[int]int) uint64 {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys(object)) {
+	for _, k := range deriveSortedInts(deriveKeys_MapOf_int_To_int(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
@@ -5587,14 +4795,6 @@ func deriveHashPtrToSliceOfint(object *[]int) uint64 {
 	return (31 * 17) + deriveHashSliceOfint(*object)
 }
 
-// deriveHashPtrToArray10Ofint returns the hash of the object.
-func deriveHashPtrToArray10Ofint(object *[10]int) uint64 {
-	if object == nil {
-		return 0
-	}
-	return (31 * 17) + deriveHash_132(*object)
-}
-
 // deriveHashPtrToMapOfintToint returns the hash of the object.
 func deriveHashPtrToMapOfintToint(object *map[int]int) uint64 {
 	if object == nil {
@@ -5603,458 +4803,21 @@ func deriveHashPtrToMapOfintToint(object *map[int]int) uint64 {
 	return (31 * 17) + deriveHashMapOfintToint(*object)
 }
 
-// deriveHash1 returns the hash of the object.
-func deriveHash1(object BuiltInTypes) uint64 {
-	return deriveHashBuiltInTypes(&object)
-}
-
-// deriveHash returns the hash of the object.
-func deriveHash(object PtrToBuiltInTypes) uint64 {
-	return deriveHashPtrToBuiltInTypes(&object)
-}
-
-// deriveFmapForKeys returns a list where each element of the input list has been morphed by the input function.
-func deriveFmapForKeys(f func(int) string, list []int) []string {
-	out := make([]string, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmap returns a list where each element of the input list has been morphed by the input function.
-func deriveFmap(f func(int) int, list []int) []int {
-	out := make([]int, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapString morphs a string into list by apply the input function to each rune.
-func deriveFmapString(f func(rune) bool, ss string) []bool {
-	out := make([]bool, len([]rune(ss)))
-	for i, elem := range ss {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapError returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapError(f func(int) int64, g func() (int, error)) (int64, error) {
-	v, err := g()
-	if err != nil {
-		return 0, err
-	}
-	return f(v), nil
-}
-
-// deriveFmapEE returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapEE(f func(string) (int, error), g func() (string, error)) (func() (int, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple(f(v)), nil
-}
-
-// deriveFmapPrint returns an error if g returns one, otherwise it applies f to g's result.
-func deriveFmapPrint(f func(string), g func() (string, error)) error {
-	v, err := g()
-	if err != nil {
-		return err
-	}
-	f(v)
-	return nil
-}
-
-// deriveFmapMore returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapMore(f func(string) (int, string, error), g func() (string, error)) (func() (int, string, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple_(f(v)), nil
-}
-
-// deriveFmapChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
-func deriveFmapChan(f func(string) int, in <-chan string) <-chan int {
-	out := make(chan int, cap(in))
-	go func() {
-		for a := range in {
-			b := f(a)
-			out <- b
-		}
-		close(out)
-	}()
-	return out
-}
-
-// deriveFmapSS returns a list where each element of the input list has been morphed by the input function.
-func deriveFmapSS(f func(string) []string, list []string) [][]string {
-	out := make([][]string, len(list))
-	for i, elem := range list {
-		out[i] = f(elem)
-	}
-	return out
-}
-
-// deriveFmapEE64 returns an error if g returns one, otherwise it applies f to g's result and returns it.
-func deriveFmapEE64(f func(string) (int64, error), g func() (string, error)) (func() (int64, error), error) {
-	v, err := g()
-	if err != nil {
-		return nil, err
-	}
-	return deriveTuple_i(f(v)), nil
-}
-
-// deriveFmapChanChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
-func deriveFmapChanChan(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
-	out := make(chan (<-chan int), cap(in))
-	go func() {
-		for a := range in {
-			b := f(a)
-			out <- b
-		}
-		close(out)
-	}()
-	return out
-}
-
-// deriveFlipMarshal returns the input function, but where first two parameters are flipped.
-func deriveFlipMarshal(f func(data []byte, v any) error) func(v any, data []byte) error {
-	return func(v any, data []byte) error {
-		return f(data, v)
-	}
-}
-
-// deriveFlip3 returns the input function, but where first two parameters are flipped.
-func deriveFlip3(f func(a int, b string, c bool) string) func(b string, a int, c bool) string {
-	return func(b string, a int, c bool) string {
-		return f(a, b, c)
-	}
-}
-
-// deriveFlipBlankIdentifier returns the input function, but where first two parameters are flipped.
-func deriveFlipBlankIdentifier(f func(a string, param_1 bool, c int) string) func(param_1 bool, a string, c int) string {
-	return func(param_1 bool, a string, c int) string {
-		return f(a, param_1, c)
-	}
-}
-
-// deriveSetInt64s returns the input list as a map with the items of the list as the keys of the map.
-//
-// Deprecated: In favour of generics.
-func deriveSetInt64s(list []int64) map[int64]struct{} {
-	set := make(map[int64]struct{}, len(list))
-	for _, v := range list {
-		set[v] = struct{}{}
-	}
-	return set
-}
-
-// deriveMinInt64s returns the minimum value from the list, or the default value if the list is empty.
-//
-// Deprecated: In favour of generics.
-func deriveMinInt64s(list []int64, def int64) int64 {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if v < m {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveMinInt returns the minimum of the two input values.
-//
-// Deprecated: In favour of generics.
-func deriveMinInt(a, b int) int {
-	if a < b {
-		return a
-	}
-	return b
-}
-
-// deriveMinStructs returns the minimum value from the list, or the default value if the list is empty.
-//
-// Deprecated: In favour of generics.
-func deriveMinStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if deriveComparePtrToBuiltInTypes(v, m) < 0 {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveMemGet returns a memoized version of the input function.
-func deriveMemGet(f func() *BuiltInTypes) func() *BuiltInTypes {
-	memoized := false
-	var res0 *BuiltInTypes
-	return func() *BuiltInTypes {
-		if !memoized {
-			res0 = f()
-			memoized = true
-		}
-		return res0
-	}
-}
-
-// deriveMemInc returns a memoized version of the input function.
-func deriveMemInc(f func(n int) int) func(n int) int {
-	m := make(map[int]int)
-	return func(param0 int) int {
-		if v, ok := m[param0]; ok {
-			return v
-		}
-		v := f(param0)
-		m[param0] = v
-		return v
-	}
-}
-
-// deriveMemIncTo returns a memoized version of the input function.
-func deriveMemIncTo(f func(a Adder) int) func(a Adder) int {
-	m := make(map[Adder]int)
-	return func(param0 Adder) int {
-		if v, ok := m[param0]; ok {
-			return v
-		}
-		v := f(param0)
-		m[param0] = v
-		return v
-	}
-}
-
-// deriveMemAdd returns a memoized version of the input function.
-func deriveMemAdd(f func(a int, b int) int) func(a int, b int) int {
-	type input struct {
-		Param0 int
-		Param1 int
-	}
-	m := make(map[input]int)
-	return func(param0 int, param1 int) int {
-		in := input{param0, param1}
-		if v, ok := m[in]; ok {
-			return v
-		}
-		v := f(param0, param1)
-		m[in] = v
-		return v
-	}
-}
-
-// deriveMemAddTo returns a memoized version of the input function.
-func deriveMemAddTo(f func(a Adder, b int) int) func(a Adder, b int) int {
-	type input struct {
-		Param0 Adder
-		Param1 int
-	}
-	m := make(map[input]int)
-	return func(param0 Adder, param1 int) int {
-		in := input{param0, param1}
-		if v, ok := m[in]; ok {
-			return v
-		}
-		v := f(param0, param1)
-		m[in] = v
-		return v
-	}
-}
-
-// deriveMemSet returns a memoized version of the input function.
-func deriveMemSet(f func(a *BuiltInTypes, b int) *BuiltInTypes) func(a *BuiltInTypes, b int) *BuiltInTypes {
-	type input struct {
-		Param0 *BuiltInTypes
-		Param1 int
-	}
-	type mem struct {
-		in  input
-		out *BuiltInTypes
-	}
-	m := make(map[uint64][]mem)
-	return func(param0 *BuiltInTypes, param1 int) *BuiltInTypes {
-		in := input{param0, param1}
-		h := deriveHash_133(in)
-		vs, ok := m[h]
-		if ok {
-			for _, v := range vs {
-				if deriveEqual_95(v.in, in) {
-					return v.out
-				}
-			}
-		}
-		res0 := f(param0, param1)
-		m[h] = append(m[h], mem{in, res0})
-		return res0
-	}
-}
-
-// deriveMemSetErr returns a memoized version of the input function.
-func deriveMemSetErr(f func(a *BuiltInTypes, b int) (*BuiltInTypes, error)) func(a *BuiltInTypes, b int) (*BuiltInTypes, error) {
-	type input struct {
-		Param0 *BuiltInTypes
-		Param1 int
-	}
-	type output struct {
-		Res0 *BuiltInTypes
-		Res1 error
-	}
-	type mem struct {
-		in  input
-		out output
-	}
-	m := make(map[uint64][]mem)
-	return func(param0 *BuiltInTypes, param1 int) (*BuiltInTypes, error) {
-		in := input{param0, param1}
-		h := deriveHash_133(in)
-		vs, ok := m[h]
-		if ok {
-			for _, v := range vs {
-				if deriveEqual_95(v.in, in) {
-					return v.out.Res0, v.out.Res1
-				}
-			}
-		}
-		res0, res1 := f(param0, param1)
-		m[h] = append(m[h], mem{in, output{res0, res1}})
-		return res0, res1
-	}
-}
-
-// deriveMaxInt64s returns the maximum value from the input list and the default value, if the list is empty.
-//
-// Deprecated: In favour of generics.
-func deriveMaxInt64s(list []int64, def int64) int64 {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if v > m {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveMaxInt returns the maximum of the two input values.
-//
-// Deprecated: In favour of generics.
-func deriveMaxInt(a, b int) int {
-	if a > b {
-		return a
-	}
-	return b
-}
-
-// deriveMaxStructs returns the maximum value from the input list and the default value, if the list is empty.
-//
-// Deprecated: In favour of generics.
-func deriveMaxStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
-	if len(list) == 0 {
-		return def
-	}
-	m := list[0]
-	list = list[1:]
-	for i, v := range list {
-		if deriveComparePtrToBuiltInTypes(v, m) > 0 {
-			m = list[i]
-		}
-	}
-	return m
-}
-
-// deriveDup duplicates messages received on c to both c1 and c2.
-func deriveDup(c chan int) (c1, c2 <-chan int) {
-	cc1, cc2 := make(chan int, cap(c)), make(chan int, cap(c))
-	go func() {
-		for v := range c {
-			cc1 <- v
-			cc2 <- v
-		}
-		close(cc1)
-		close(cc2)
-	}()
-	return cc1, cc2
-}
-
-// deriveAny reports whether the predicate returns true for any of the elements in the given slice.
-//
-// Deprecated: In favour of generics.
-func deriveAny(pred func(int) bool, list []int) bool {
-	for _, elem := range list {
-		if pred(elem) {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveAnyEqualCurry reports whether the predicate returns true for any of the elements in the given slice.
-//
-// Deprecated: In favour of generics.
-func deriveAnyEqualCurry(pred func(*BuiltInTypes) bool, list []*BuiltInTypes) bool {
-	for _, elem := range list {
-		if pred(elem) {
-			return true
-		}
-	}
-	return false
-}
-
-// deriveAll reports whether the predicate returns true for all of the elements in the given slice.
-//
-// Deprecated: In favour of generics.
-func deriveAll(predicate func(int) bool, slice []int) bool {
-	for _, elem := range slice {
-		if !predicate(elem) {
-			return false
-		}
-	}
-	return true
-}
-
-// deriveDo concurrently executes the input functions f0 and f1 and when all functions are finished the first error, if any, and results are returned.
-func deriveDo(f0 func() (string, error), f1 func() (int, error)) (string, int, error) {
-	errChan := make(chan error)
-	var v0 string
-	go func() {
-		var v0err error
-		v0, v0err = f0()
-		errChan <- v0err
-	}()
-	var v1 int
-	go func() {
-		var v1err error
-		v1, v1err = f1()
-		errChan <- v1err
-	}()
-	var err error
-	for i := 0; i < 2; i++ {
-		errc := <-errChan
-		if errc != nil {
-			if err == nil {
-				err = errc
-			}
-		}
+// deriveHash_PtrTo_Methods returns the hash of the object.
+func deriveHash_PtrTo_Methods(object *Methods) uint64 {
+	if object == nil {
+		return 0
 	}
-	return v0, v1, err
+	h := uint64(17)
+	h = 31*h + deriveHash_string(object.Name)
+	h = 31*h + deriveHash_SliceOf_string(object.Aliases)
+	h = 31*h + deriveHash_PtrTo_Pair_string_int64(object.Pair)
+	h = 31*h + deriveHash_Name(object.Inner)
+	return h
 }
 
-// deriveGoString returns a recursive representation of this as a valid go string.
-func deriveGoString(this []*bool) string {
+// deriveGoString_SliceOf_PtrTo_bool returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_bool(this []*bool) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*bool {\n")
 	if this == nil {
@@ -6062,7 +4825,7 @@ func deriveGoString(this []*bool) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*bool, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_bool(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6070,8 +4833,8 @@ func deriveGoString(this []*bool) string {
 	return buf.String()
 }
 
-// deriveGoString_ returns a recursive representation of this as a valid go string.
-func deriveGoString_(this []*byte) string {
+// deriveGoString_SliceOf_PtrTo_byte returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_byte(this []*byte) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*byte {\n")
 	if this == nil {
@@ -6079,7 +4842,7 @@ func deriveGoString_(this []*byte) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*byte, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_byte(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6087,8 +4850,8 @@ func deriveGoString_(this []*byte) string {
 	return buf.String()
 }
 
-// deriveGoString_1 returns a recursive representation of this as a valid go string.
-func deriveGoString_1(this []*complex128) string {
+// deriveGoString_SliceOf_PtrTo_complex128 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_complex128(this []*complex128) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*complex128 {\n")
 	if this == nil {
@@ -6096,7 +4859,7 @@ func deriveGoString_1(this []*complex128) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*complex128, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_complex128(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6104,8 +4867,8 @@ func deriveGoString_1(this []*complex128) string {
 	return buf.String()
 }
 
-// deriveGoString_2 returns a recursive representation of this as a valid go string.
-func deriveGoString_2(this []*complex64) string {
+// deriveGoString_SliceOf_PtrTo_complex64 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_complex64(this []*complex64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*complex64 {\n")
 	if this == nil {
@@ -6113,7 +4876,7 @@ func deriveGoString_2(this []*complex64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*complex64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_complex64(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6121,8 +4884,8 @@ func deriveGoString_2(this []*complex64) string {
 	return buf.String()
 }
 
-// deriveGoString_3 returns a recursive representation of this as a valid go string.
-func deriveGoString_3(this []*float64) string {
+// deriveGoString_SliceOf_PtrTo_float64 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_float64(this []*float64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*float64 {\n")
 	if this == nil {
@@ -6130,7 +4893,7 @@ func deriveGoString_3(this []*float64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*float64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_float64(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6138,8 +4901,8 @@ func deriveGoString_3(this []*float64) string {
 	return buf.String()
 }
 
-// deriveGoString_4 returns a recursive representation of this as a valid go string.
-func deriveGoString_4(this []*float32) string {
+// deriveGoString_SliceOf_PtrTo_float32 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_float32(this []*float32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*float32 {\n")
 	if this == nil {
@@ -6147,7 +4910,7 @@ func deriveGoString_4(this []*float32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*float32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_float32(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6155,8 +4918,8 @@ func deriveGoString_4(this []*float32) string {
 	return buf.String()
 }
 
-// deriveGoString_5 returns a recursive representation of this as a valid go string.
-func deriveGoString_5(this []*int) string {
+// deriveGoString_SliceOf_PtrTo_int returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_int(this []*int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*int {\n")
 	if this == nil {
@@ -6172,8 +4935,8 @@ func deriveGoString_5(this []*int) string {
 	return buf.String()
 }
 
-// deriveGoString_6 returns a recursive representation of this as a valid go string.
-func deriveGoString_6(this []*int16) string {
+// deriveGoString_SliceOf_PtrTo_int16 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_int16(this []*int16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*int16 {\n")
 	if this == nil {
@@ -6181,7 +4944,7 @@ func deriveGoString_6(this []*int16) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int16, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int16(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6189,8 +4952,8 @@ func deriveGoString_6(this []*int16) string {
 	return buf.String()
 }
 
-// deriveGoString_7 returns a recursive representation of this as a valid go string.
-func deriveGoString_7(this []*int32) string {
+// deriveGoString_SliceOf_PtrTo_int32 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_int32(this []*int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*int32 {\n")
 	if this == nil {
@@ -6198,7 +4961,7 @@ func deriveGoString_7(this []*int32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int32(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6206,8 +4969,8 @@ func deriveGoString_7(this []*int32) string {
 	return buf.String()
 }
 
-// deriveGoString_8 returns a recursive representation of this as a valid go string.
-func deriveGoString_8(this []*int64) string {
+// deriveGoString_SliceOf_PtrTo_int64 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_int64(this []*int64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*int64 {\n")
 	if this == nil {
@@ -6215,7 +4978,7 @@ func deriveGoString_8(this []*int64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_79(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int64(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6223,8 +4986,8 @@ func deriveGoString_8(this []*int64) string {
 	return buf.String()
 }
 
-// deriveGoString_9 returns a recursive representation of this as a valid go string.
-func deriveGoString_9(this []*int8) string {
+// deriveGoString_SliceOf_PtrTo_int8 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_int8(this []*int8) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*int8 {\n")
 	if this == nil {
@@ -6232,7 +4995,7 @@ func deriveGoString_9(this []*int8) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*int8, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_80(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int8(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6240,8 +5003,8 @@ func deriveGoString_9(this []*int8) string {
 	return buf.String()
 }
 
-// deriveGoString_10 returns a recursive representation of this as a valid go string.
-func deriveGoString_10(this []*string) string {
+// deriveGoString_SliceOf_PtrTo_string returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_string(this []*string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*string {\n")
 	if this == nil {
@@ -6249,7 +5012,7 @@ func deriveGoString_10(this []*string) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*string, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_81(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_string(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6257,8 +5020,8 @@ func deriveGoString_10(this []*string) string {
 	return buf.String()
 }
 
-// deriveGoString_11 returns a recursive representation of this as a valid go string.
-func deriveGoString_11(this []*uint) string {
+// deriveGoString_SliceOf_PtrTo_uint returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_uint(this []*uint) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*uint {\n")
 	if this == nil {
@@ -6266,7 +5029,7 @@ func deriveGoString_11(this []*uint) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_82(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6274,8 +5037,8 @@ func deriveGoString_11(this []*uint) string {
 	return buf.String()
 }
 
-// deriveGoString_12 returns a recursive representation of this as a valid go string.
-func deriveGoString_12(this []*uint16) string {
+// deriveGoString_SliceOf_PtrTo_uint16 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_uint16(this []*uint16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*uint16 {\n")
 	if this == nil {
@@ -6283,7 +5046,7 @@ func deriveGoString_12(this []*uint16) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint16, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_83(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint16(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6291,8 +5054,8 @@ func deriveGoString_12(this []*uint16) string {
 	return buf.String()
 }
 
-// deriveGoString_13 returns a recursive representation of this as a valid go string.
-func deriveGoString_13(this []*uint32) string {
+// deriveGoString_SliceOf_PtrTo_uint32 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_uint32(this []*uint32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*uint32 {\n")
 	if this == nil {
@@ -6300,7 +5063,7 @@ func deriveGoString_13(this []*uint32) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint32, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_84(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint32(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6308,8 +5071,8 @@ func deriveGoString_13(this []*uint32) string {
 	return buf.String()
 }
 
-// deriveGoString_14 returns a recursive representation of this as a valid go string.
-func deriveGoString_14(this []*uint64) string {
+// deriveGoString_SliceOf_PtrTo_uint64 returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_uint64(this []*uint64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*uint64 {\n")
 	if this == nil {
@@ -6317,7 +5080,7 @@ func deriveGoString_14(this []*uint64) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uint64, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_85(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint64(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6325,8 +5088,8 @@ func deriveGoString_14(this []*uint64) string {
 	return buf.String()
 }
 
-// deriveGoString_15 returns a recursive representation of this as a valid go string.
-func deriveGoString_15(this []*uintptr) string {
+// deriveGoString_SliceOf_PtrTo_uintptr returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_uintptr(this []*uintptr) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*uintptr {\n")
 	if this == nil {
@@ -6334,7 +5097,7 @@ func deriveGoString_15(this []*uintptr) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*uintptr, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_86(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uintptr(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6342,86 +5105,86 @@ func deriveGoString_15(this []*uintptr) string {
 	return buf.String()
 }
 
-// deriveGoString_16 returns a recursive representation of this as a valid go string.
-func deriveGoString_16(this [1]*bool) string {
+// deriveGoString_ArrayOf1_PtrTo_bool returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf1_PtrTo_bool(this [1]*bool) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [1]*bool {\n")
 	fmt.Fprintf(buf, "this := [1]*bool{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_bool(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_17 returns a recursive representation of this as a valid go string.
-func deriveGoString_17(this [2]*byte) string {
+// deriveGoString_ArrayOf2_PtrTo_byte returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf2_PtrTo_byte(this [2]*byte) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [2]*byte {\n")
 	fmt.Fprintf(buf, "this := [2]*byte{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_byte(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_18 returns a recursive representation of this as a valid go string.
-func deriveGoString_18(this [3]*complex128) string {
+// deriveGoString_ArrayOf3_PtrTo_complex128 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf3_PtrTo_complex128(this [3]*complex128) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [3]*complex128 {\n")
 	fmt.Fprintf(buf, "this := [3]*complex128{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_73(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_complex128(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_19 returns a recursive representation of this as a valid go string.
-func deriveGoString_19(this [4]*complex64) string {
+// deriveGoString_ArrayOf4_PtrTo_complex64 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf4_PtrTo_complex64(this [4]*complex64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [4]*complex64 {\n")
 	fmt.Fprintf(buf, "this := [4]*complex64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_74(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_complex64(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_20 returns a recursive representation of this as a valid go string.
-func deriveGoString_20(this [5]*float64) string {
+// deriveGoString_ArrayOf5_PtrTo_float64 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf5_PtrTo_float64(this [5]*float64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [5]*float64 {\n")
 	fmt.Fprintf(buf, "this := [5]*float64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_75(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_float64(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_21 returns a recursive representation of this as a valid go string.
-func deriveGoString_21(this [6]*float32) string {
+// deriveGoString_ArrayOf6_PtrTo_float32 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf6_PtrTo_float32(this [6]*float32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [6]*float32 {\n")
 	fmt.Fprintf(buf, "this := [6]*float32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_76(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_float32(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_22 returns a recursive representation of this as a valid go string.
-func deriveGoString_22(this [7]*int) string {
+// deriveGoString_ArrayOf7_PtrTo_int returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf7_PtrTo_int(this [7]*int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [7]*int {\n")
 	fmt.Fprintf(buf, "this := [7]*int{}\n")
@@ -6433,177 +5196,177 @@ func deriveGoString_22(this [7]*int) string {
 	return buf.String()
 }
 
-// deriveGoString_23 returns a recursive representation of this as a valid go string.
-func deriveGoString_23(this [8]*int16) string {
+// deriveGoString_ArrayOf8_PtrTo_int16 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf8_PtrTo_int16(this [8]*int16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [8]*int16 {\n")
 	fmt.Fprintf(buf, "this := [8]*int16{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_77(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int16(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_24 returns a recursive representation of this as a valid go string.
-func deriveGoString_24(this [9]*int32) string {
+// deriveGoString_ArrayOf9_PtrTo_int32 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf9_PtrTo_int32(this [9]*int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [9]*int32 {\n")
 	fmt.Fprintf(buf, "this := [9]*int32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int32(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_25 returns a recursive representation of this as a valid go string.
-func deriveGoString_25(this [10]*int64) string {
+// deriveGoString_ArrayOf10_PtrTo_int64 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf10_PtrTo_int64(this [10]*int64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [10]*int64 {\n")
 	fmt.Fprintf(buf, "this := [10]*int64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_79(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int64(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_26 returns a recursive representation of this as a valid go string.
-func deriveGoString_26(this [11]*int8) string {
+// deriveGoString_ArrayOf11_PtrTo_int8 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf11_PtrTo_int8(this [11]*int8) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [11]*int8 {\n")
 	fmt.Fprintf(buf, "this := [11]*int8{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_80(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int8(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_27 returns a recursive representation of this as a valid go string.
-func deriveGoString_27(this [12]*rune) string {
+// deriveGoString_ArrayOf12_PtrTo_rune returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf12_PtrTo_rune(this [12]*rune) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [12]*rune {\n")
 	fmt.Fprintf(buf, "this := [12]*rune{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_78(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_int32(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_28 returns a recursive representation of this as a valid go string.
-func deriveGoString_28(this [13]*string) string {
+// deriveGoString_ArrayOf13_PtrTo_string returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf13_PtrTo_string(this [13]*string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [13]*string {\n")
 	fmt.Fprintf(buf, "this := [13]*string{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_81(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_string(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_29 returns a recursive representation of this as a valid go string.
-func deriveGoString_29(this [14]*uint) string {
+// deriveGoString_ArrayOf14_PtrTo_uint returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf14_PtrTo_uint(this [14]*uint) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [14]*uint {\n")
 	fmt.Fprintf(buf, "this := [14]*uint{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_82(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_30 returns a recursive representation of this as a valid go string.
-func deriveGoString_30(this [15]*uint16) string {
+// deriveGoString_ArrayOf15_PtrTo_uint16 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf15_PtrTo_uint16(this [15]*uint16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [15]*uint16 {\n")
 	fmt.Fprintf(buf, "this := [15]*uint16{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_83(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint16(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_31 returns a recursive representation of this as a valid go string.
-func deriveGoString_31(this [16]*uint32) string {
+// deriveGoString_ArrayOf16_PtrTo_uint32 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf16_PtrTo_uint32(this [16]*uint32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [16]*uint32 {\n")
 	fmt.Fprintf(buf, "this := [16]*uint32{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_84(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint32(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_32 returns a recursive representation of this as a valid go string.
-func deriveGoString_32(this [17]*uint64) string {
+// deriveGoString_ArrayOf17_PtrTo_uint64 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf17_PtrTo_uint64(this [17]*uint64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [17]*uint64 {\n")
 	fmt.Fprintf(buf, "this := [17]*uint64{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_85(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uint64(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_33 returns a recursive representation of this as a valid go string.
-func deriveGoString_33(this [18]*uint8) string {
+// deriveGoString_ArrayOf18_PtrTo_uint8 returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf18_PtrTo_uint8(this [18]*uint8) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [18]*uint8 {\n")
 	fmt.Fprintf(buf, "this := [18]*uint8{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_72(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_byte(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_34 returns a recursive representation of this as a valid go string.
-func deriveGoString_34(this [19]*uintptr) string {
+// deriveGoString_ArrayOf19_PtrTo_uintptr returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf19_PtrTo_uintptr(this [19]*uintptr) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [19]*uintptr {\n")
 	fmt.Fprintf(buf, "this := [19]*uintptr{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_86(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_uintptr(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_35 returns a recursive representation of this as a valid go string.
-func deriveGoString_35(this [10]*bool) string {
+// deriveGoString_ArrayOf10_PtrTo_bool returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf10_PtrTo_bool(this [10]*bool) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [10]*bool {\n")
 	fmt.Fprintf(buf, "this := [10]*bool{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_71(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_bool(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_36 returns a recursive representation of this as a valid go string.
-func deriveGoString_36(this [][]int) string {
+// deriveGoString_SliceOf_SliceOf_int returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_SliceOf_int(this [][]int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [][]int {\n")
 	if this == nil {
@@ -6619,8 +5382,8 @@ func deriveGoString_36(this [][]int) string {
 	return buf.String()
 }
 
-// deriveGoString_37 returns a recursive representation of this as a valid go string.
-func deriveGoString_37(this [][]string) string {
+// deriveGoString_SliceOf_SliceOf_string returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_SliceOf_string(this [][]string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [][]string {\n")
 	if this == nil {
@@ -6628,7 +5391,7 @@ func deriveGoString_37(this [][]string) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([][]string, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_87(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_SliceOf_string(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6636,8 +5399,8 @@ func deriveGoString_37(this [][]string) string {
 	return buf.String()
 }
 
-// deriveGoString_38 returns a recursive representation of this as a valid go string.
-func deriveGoString_38(this [][]*int) string {
+// deriveGoString_SliceOf_SliceOf_PtrTo_int returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_SliceOf_PtrTo_int(this [][]*int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [][]*int {\n")
 	if this == nil {
@@ -6645,7 +5408,7 @@ func deriveGoString_38(this [][]*int) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([][]*int, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_5(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_SliceOf_PtrTo_int(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6653,8 +5416,8 @@ func deriveGoString_38(this [][]*int) string {
 	return buf.String()
 }
 
-// deriveGoString_39 returns a recursive representation of this as a valid go string.
-func deriveGoString_39(this *[4]int) string {
+// deriveGoString_PtrTo_ArrayOf4_int returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_ArrayOf4_int(this *[4]int) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *[4]int {\n")
 	if this == nil {
@@ -6668,8 +5431,8 @@ func deriveGoString_39(this *[4]int) string {
 	return buf.String()
 }
 
-// deriveGoString_N returns a recursive representation of this as a valid go string.
-func deriveGoString_N(this Name) string {
+// deriveGoString_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_Name(this Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.Name {\n")
 	fmt.Fprintf(buf, "this := &test.Name{}\n")
@@ -6679,8 +5442,8 @@ func deriveGoString_N(this Name) string {
 	return buf.String()
 }
 
-// deriveGoString_40 returns a recursive representation of this as a valid go string.
-func deriveGoString_40(this []Name) string {
+// deriveGoString_SliceOf_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_Name(this []Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.Name {\n")
 	if this == nil {
@@ -6688,7 +5451,7 @@ func deriveGoString_40(this []Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]test.Name, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_N(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_Name(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6696,8 +5459,8 @@ func deriveGoString_40(this []Name) string {
 	return buf.String()
 }
 
-// deriveGoString_41 returns a recursive representation of this as a valid go string.
-func deriveGoString_41(this []*Name) string {
+// deriveGoString_SliceOf_PtrTo_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_Name(this []*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*test.Name {\n")
 	if this == nil {
@@ -6713,8 +5476,8 @@ func deriveGoString_41(this []*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_S returns a recursive representation of this as a valid go string.
-func deriveGoString_S(this StructWithoutMethod) string {
+// deriveGoString_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_StructWithoutMethod(this StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.StructWithoutMethod {\n")
 	fmt.Fprintf(buf, "this := &test.StructWithoutMethod{}\n")
@@ -6724,8 +5487,8 @@ func deriveGoString_S(this StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_42 returns a recursive representation of this as a valid go string.
-func deriveGoString_42(this *StructWithoutMethod) string {
+// deriveGoString_PtrTo_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_StructWithoutMethod(this *StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6739,8 +5502,8 @@ func deriveGoString_42(this *StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_43 returns a recursive representation of this as a valid go string.
-func deriveGoString_43(this []StructWithoutMethod) string {
+// deriveGoString_SliceOf_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_StructWithoutMethod(this []StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6748,7 +5511,7 @@ func deriveGoString_43(this []StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]test.StructWithoutMethod, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_S(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_StructWithoutMethod(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6756,8 +5519,8 @@ func deriveGoString_43(this []StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_44 returns a recursive representation of this as a valid go string.
-func deriveGoString_44(this []*StructWithoutMethod) string {
+// deriveGoString_SliceOf_PtrTo_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_StructWithoutMethod(this []*StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6765,7 +5528,7 @@ func deriveGoString_44(this []*StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*test.StructWithoutMethod, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_42(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_StructWithoutMethod(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6773,8 +5536,8 @@ func deriveGoString_44(this []*StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_45 returns a recursive representation of this as a valid go string.
-func deriveGoString_45(this map[Name]string) string {
+// deriveGoString_MapOf_Name_To_string returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_Name_To_string(this map[Name]string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[test.Name]string {\n")
 	if this == nil {
@@ -6783,8 +5546,8 @@ func deriveGoString_45(this map[Name]string) string {
 		fmt.Fprintf(buf, "this := make(map[test.Name]string)\n")
 		i := 0
 		for k, v := range this {
-			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_N(k))
-			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_s(v))
+			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_Name(k))
+			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_string(v))
 			i++
 		}
 		fmt.Fprintf(buf, "return this\n")
@@ -6793,8 +5556,8 @@ func deriveGoString_45(this map[Name]string) string {
 	return buf.String()
 }
 
-// deriveGoString_46 returns a recursive representation of this as a valid go string.
-func deriveGoString_46(this map[string]Name) string {
+// deriveGoString_MapOf_string_To_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_Name(this map[string]Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]test.Name {\n")
 	if this == nil {
@@ -6802,7 +5565,7 @@ func deriveGoString_46(this map[string]Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string]test.Name)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_N(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_Name(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6810,8 +5573,8 @@ func deriveGoString_46(this map[string]Name) string {
 	return buf.String()
 }
 
-// deriveGoString_47 returns a recursive representation of this as a valid go string.
-func deriveGoString_47(this map[string]*Name) string {
+// deriveGoString_MapOf_string_To_PtrTo_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_PtrTo_Name(this map[string]*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]*test.Name {\n")
 	if this == nil {
@@ -6827,8 +5590,8 @@ func deriveGoString_47(this map[string]*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_48 returns a recursive representation of this as a valid go string.
-func deriveGoString_48(this map[string][]Name) string {
+// deriveGoString_MapOf_string_To_SliceOf_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_SliceOf_Name(this map[string][]Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]test.Name {\n")
 	if this == nil {
@@ -6836,7 +5599,7 @@ func deriveGoString_48(this map[string][]Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]test.Name)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_40(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_SliceOf_Name(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6844,8 +5607,8 @@ func deriveGoString_48(this map[string][]Name) string {
 	return buf.String()
 }
 
-// deriveGoString_49 returns a recursive representation of this as a valid go string.
-func deriveGoString_49(this map[string][]*Name) string {
+// deriveGoString_MapOf_string_To_SliceOf_PtrTo_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_SliceOf_PtrTo_Name(this map[string][]*Name) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]*test.Name {\n")
 	if this == nil {
@@ -6853,7 +5616,7 @@ func deriveGoString_49(this map[string][]*Name) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]*test.Name)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_41(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_SliceOf_PtrTo_Name(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6861,8 +5624,8 @@ func deriveGoString_49(this map[string][]*Name) string {
 	return buf.String()
 }
 
-// deriveGoString_50 returns a recursive representation of this as a valid go string.
-func deriveGoString_50(this map[string]StructWithoutMethod) string {
+// deriveGoString_MapOf_string_To_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_StructWithoutMethod(this map[string]StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6870,7 +5633,7 @@ func deriveGoString_50(this map[string]StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string]test.StructWithoutMethod)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_S(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_StructWithoutMethod(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6878,8 +5641,8 @@ func deriveGoString_50(this map[string]StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_51 returns a recursive representation of this as a valid go string.
-func deriveGoString_51(this map[StructWithoutMethod]string) string {
+// deriveGoString_MapOf_StructWithoutMethod_To_string returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_StructWithoutMethod_To_string(this map[StructWithoutMethod]string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[test.StructWithoutMethod]string {\n")
 	if this == nil {
@@ -6888,8 +5651,8 @@ func deriveGoString_51(this map[StructWithoutMethod]string) string {
 		fmt.Fprintf(buf, "this := make(map[test.StructWithoutMethod]string)\n")
 		i := 0
 		for k, v := range this {
-			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_S(k))
-			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_s(v))
+			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_StructWithoutMethod(k))
+			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_string(v))
 			i++
 		}
 		fmt.Fprintf(buf, "return this\n")
@@ -6898,8 +5661,8 @@ func deriveGoString_51(this map[StructWithoutMethod]string) string {
 	return buf.String()
 }
 
-// deriveGoString_52 returns a recursive representation of this as a valid go string.
-func deriveGoString_52(this map[string]*StructWithoutMethod) string {
+// deriveGoString_MapOf_string_To_PtrTo_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_PtrTo_StructWithoutMethod(this map[string]*StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string]*test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6907,7 +5670,7 @@ func deriveGoString_52(this map[string]*StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string]*test.StructWithoutMethod)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_42(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_PtrTo_StructWithoutMethod(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6915,8 +5678,8 @@ func deriveGoString_52(this map[string]*StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_53 returns a recursive representation of this as a valid go string.
-func deriveGoString_53(this map[string][]StructWithoutMethod) string {
+// deriveGoString_MapOf_string_To_SliceOf_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_SliceOf_StructWithoutMethod(this map[string][]StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6924,7 +5687,7 @@ func deriveGoString_53(this map[string][]StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]test.StructWithoutMethod)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_43(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_SliceOf_StructWithoutMethod(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6932,8 +5695,8 @@ func deriveGoString_53(this map[string][]StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_54 returns a recursive representation of this as a valid go string.
-func deriveGoString_54(this map[string][]*StructWithoutMethod) string {
+// deriveGoString_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this map[string][]*StructWithoutMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]*test.StructWithoutMethod {\n")
 	if this == nil {
@@ -6941,7 +5704,7 @@ func deriveGoString_54(this map[string][]*StructWithoutMethod) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]*test.StructWithoutMethod)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_44(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_SliceOf_PtrTo_StructWithoutMethod(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6949,8 +5712,8 @@ func deriveGoString_54(this map[string][]*StructWithoutMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_55 returns a recursive representation of this as a valid go string.
-func deriveGoString_55(this map[int]RecursiveType) string {
+// deriveGoString_MapOf_int_To_RecursiveType returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_int_To_RecursiveType(this map[int]RecursiveType) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int]test.RecursiveType {\n")
 	if this == nil {
@@ -6958,7 +5721,7 @@ func deriveGoString_55(this map[int]RecursiveType) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[int]test.RecursiveType)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_R(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_RecursiveType(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -6966,38 +5729,38 @@ func deriveGoString_55(this map[int]RecursiveType) string {
 	return buf.String()
 }
 
-// deriveGoString_St returns a recursive representation of this as a valid go string.
-func deriveGoString_St(this Structs) string {
+// deriveGoString_Structs returns a recursive representation of this as a valid go string.
+func deriveGoString_Structs(this Structs) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.Structs {\n")
 	fmt.Fprintf(buf, "this := &test.Structs{}\n")
-	fmt.Fprintf(buf, "this.Struct = %s\n", deriveGoString_N(this.Struct))
+	fmt.Fprintf(buf, "this.Struct = %s\n", deriveGoString_Name(this.Struct))
 	if this.PtrToStruct != nil {
 		fmt.Fprintf(buf, "this.PtrToStruct = %s\n", deriveGoStringName(this.PtrToStruct))
 	}
 	if this.SliceOfStructs != nil {
-		fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_40(this.SliceOfStructs))
+		fmt.Fprintf(buf, "this.SliceOfStructs = %s\n", deriveGoString_SliceOf_Name(this.SliceOfStructs))
 	}
 	if this.SliceToPtrOfStruct != nil {
-		fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_41(this.SliceToPtrOfStruct))
+		fmt.Fprintf(buf, "this.SliceToPtrOfStruct = %s\n", deriveGoString_SliceOf_PtrTo_Name(this.SliceToPtrOfStruct))
 	}
-	fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_S(this.StructWithoutMethod))
+	fmt.Fprintf(buf, "this.StructWithoutMethod = %s\n", deriveGoString_StructWithoutMethod(this.StructWithoutMethod))
 	if this.PtrToStructWithoutMethod != nil {
-		fmt.Fprintf(buf, "this.PtrToStructWithoutMethod = %s\n", deriveGoString_42(this.PtrToStructWithoutMethod))
+		fmt.Fprintf(buf, "this.PtrToStructWithoutMethod = %s\n", deriveGoString_PtrTo_StructWithoutMethod(this.PtrToStructWithoutMethod))
 	}
 	if this.SliceOfStructWithoutMethod != nil {
-		fmt.Fprintf(buf, "this.SliceOfStructWithoutMethod = %s\n", deriveGoString_43(this.SliceOfStructWithoutMethod))
+		fmt.Fprintf(buf, "this.SliceOfStructWithoutMethod = %s\n", deriveGoString_SliceOf_StructWithoutMethod(this.SliceOfStructWithoutMethod))
 	}
 	if this.SliceToPtrOfStructWithoutMethod != nil {
-		fmt.Fprintf(buf, "this.SliceToPtrOfStructWithoutMethod = %s\n", deriveGoString_44(this.SliceToPtrOfStructWithoutMethod))
+		fmt.Fprintf(buf, "this.SliceToPtrOfStructWithoutMethod = %s\n", deriveGoString_SliceOf_PtrTo_StructWithoutMethod(this.SliceToPtrOfStructWithoutMethod))
 	}
 	fmt.Fprintf(buf, "return *this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_56 returns a recursive representation of this as a valid go string.
-func deriveGoString_56(this *StructWithoutEqualMethod) string {
+// deriveGoString_PtrTo_StructWithoutEqualMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_StructWithoutEqualMethod(this *StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.StructWithoutEqualMethod {\n")
 	if this == nil {
@@ -7011,8 +5774,8 @@ func deriveGoString_56(this *StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_Str returns a recursive representation of this as a valid go string.
-func deriveGoString_Str(this StructWithoutEqualMethod) string {
+// deriveGoString_StructWithoutEqualMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_StructWithoutEqualMethod(this StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.StructWithoutEqualMethod {\n")
 	fmt.Fprintf(buf, "this := &test.StructWithoutEqualMethod{}\n")
@@ -7022,8 +5785,8 @@ func deriveGoString_Str(this StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_57 returns a recursive representation of this as a valid go string.
-func deriveGoString_57(this *extra.StructWithoutEqualMethod) string {
+// deriveGoString_PtrTo_extra_StructWithoutEqualMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_extra_StructWithoutEqualMethod(this *extra.StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *extra.StructWithoutEqualMethod {\n")
 	if this == nil {
@@ -7037,8 +5800,8 @@ func deriveGoString_57(this *extra.StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_Stru returns a recursive representation of this as a valid go string.
-func deriveGoString_Stru(this extra.StructWithoutEqualMethod) string {
+// deriveGoString_extra_StructWithoutEqualMethod returns a recursive representation of this as a valid go string.
+func deriveGoString_extra_StructWithoutEqualMethod(this extra.StructWithoutEqualMethod) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() extra.StructWithoutEqualMethod {\n")
 	fmt.Fprintf(buf, "this := &extra.StructWithoutEqualMethod{}\n")
@@ -7048,8 +5811,8 @@ func deriveGoString_Stru(this extra.StructWithoutEqualMethod) string {
 	return buf.String()
 }
 
-// deriveGoString_58 returns a recursive representation of this as a valid go string.
-func deriveGoString_58(this *MyEnum) string {
+// deriveGoString_PtrTo_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_MyEnum(this *MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.MyEnum {\n")
 	if this == nil {
@@ -7063,8 +5826,8 @@ func deriveGoString_58(this *MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_59 returns a recursive representation of this as a valid go string.
-func deriveGoString_59(this []MyEnum) string {
+// deriveGoString_SliceOf_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_MyEnum(this []MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.MyEnum {\n")
 	if this == nil {
@@ -7072,7 +5835,7 @@ func deriveGoString_59(this []MyEnum) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]test.MyEnum, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_M(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_MyEnum(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7080,8 +5843,8 @@ func deriveGoString_59(this []MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_60 returns a recursive representation of this as a valid go string.
-func deriveGoString_60(this []*MyEnum) string {
+// deriveGoString_SliceOf_PtrTo_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_MyEnum(this []*MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*test.MyEnum {\n")
 	if this == nil {
@@ -7089,7 +5852,7 @@ func deriveGoString_60(this []*MyEnum) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*test.MyEnum, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_58(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_MyEnum(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7097,8 +5860,8 @@ func deriveGoString_60(this []*MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_61 returns a recursive representation of this as a valid go string.
-func deriveGoString_61(this map[int32]MyEnum) string {
+// deriveGoString_MapOf_int32_To_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_int32_To_MyEnum(this map[int32]MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int32]test.MyEnum {\n")
 	if this == nil {
@@ -7106,7 +5869,7 @@ func deriveGoString_61(this map[int32]MyEnum) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[int32]test.MyEnum)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_M(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_MyEnum(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7114,8 +5877,8 @@ func deriveGoString_61(this map[int32]MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_62 returns a recursive representation of this as a valid go string.
-func deriveGoString_62(this map[MyEnum]int32) string {
+// deriveGoString_MapOf_MyEnum_To_int32 returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_MyEnum_To_int32(this map[MyEnum]int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[test.MyEnum]int32 {\n")
 	if this == nil {
@@ -7124,8 +5887,8 @@ func deriveGoString_62(this map[MyEnum]int32) string {
 		fmt.Fprintf(buf, "this := make(map[test.MyEnum]int32)\n")
 		i := 0
 		for k, v := range this {
-			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_M(k))
-			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_i(v))
+			fmt.Fprintf(buf, "key%d := %s\n", i, deriveGoString_MyEnum(k))
+			fmt.Fprintf(buf, "this[key%d] = %s\n", i, deriveGoString_int32(v))
 			i++
 		}
 		fmt.Fprintf(buf, "return this\n")
@@ -7134,21 +5897,21 @@ func deriveGoString_62(this map[MyEnum]int32) string {
 	return buf.String()
 }
 
-// deriveGoString_63 returns a recursive representation of this as a valid go string.
-func deriveGoString_63(this [2]MyEnum) string {
+// deriveGoString_ArrayOf2_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_ArrayOf2_MyEnum(this [2]MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() [2]test.MyEnum {\n")
 	fmt.Fprintf(buf, "this := [2]test.MyEnum{}\n")
 	for i := range this {
-		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_M(this[i]))
+		fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_MyEnum(this[i]))
 	}
 	fmt.Fprintf(buf, "return this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_64 returns a recursive representation of this as a valid go string.
-func deriveGoString_64(this *MySlice) string {
+// deriveGoString_PtrTo_MySlice returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_MySlice(this *MySlice) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *test.MySlice {\n")
 	if this == nil {
@@ -7164,8 +5927,8 @@ func deriveGoString_64(this *MySlice) string {
 	return buf.String()
 }
 
-// deriveGoString_65 returns a recursive representation of this as a valid go string.
-func deriveGoString_65(this []MySlice) string {
+// deriveGoString_SliceOf_MySlice returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_MySlice(this []MySlice) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []test.MySlice {\n")
 	if this == nil {
@@ -7173,7 +5936,7 @@ func deriveGoString_65(this []MySlice) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]test.MySlice, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_My(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_MySlice(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7181,8 +5944,8 @@ func deriveGoString_65(this []MySlice) string {
 	return buf.String()
 }
 
-// deriveGoString_66 returns a recursive representation of this as a valid go string.
-func deriveGoString_66(this *time.Duration) string {
+// deriveGoString_PtrTo_time_Duration returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_time_Duration(this *time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *time.Duration {\n")
 	if this == nil {
@@ -7196,8 +5959,8 @@ func deriveGoString_66(this *time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_67 returns a recursive representation of this as a valid go string.
-func deriveGoString_67(this []time.Duration) string {
+// deriveGoString_SliceOf_time_Duration returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_time_Duration(this []time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []time.Duration {\n")
 	if this == nil {
@@ -7205,7 +5968,7 @@ func deriveGoString_67(this []time.Duration) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]time.Duration, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_D(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_time_Duration(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7213,8 +5976,8 @@ func deriveGoString_67(this []time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_68 returns a recursive representation of this as a valid go string.
-func deriveGoString_68(this []*time.Duration) string {
+// deriveGoString_SliceOf_PtrTo_time_Duration returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_time_Duration(this []*time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*time.Duration {\n")
 	if this == nil {
@@ -7222,7 +5985,7 @@ func deriveGoString_68(this []*time.Duration) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*time.Duration, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_66(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_time_Duration(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7230,8 +5993,8 @@ func deriveGoString_68(this []*time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_69 returns a recursive representation of this as a valid go string.
-func deriveGoString_69(this map[int]time.Duration) string {
+// deriveGoString_MapOf_int_To_time_Duration returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_int_To_time_Duration(this map[int]time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[int]time.Duration {\n")
 	if this == nil {
@@ -7239,7 +6002,7 @@ func deriveGoString_69(this map[int]time.Duration) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[int]time.Duration)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_D(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_time_Duration(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7247,8 +6010,8 @@ func deriveGoString_69(this map[int]time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_70 returns a recursive representation of this as a valid go string.
-func deriveGoString_70(this map[string][]*pickle.Rick) string {
+// deriveGoString_MapOf_string_To_SliceOf_PtrTo_pickle_Rick returns a recursive representation of this as a valid go string.
+func deriveGoString_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this map[string][]*pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() map[string][]*pickle.Rick {\n")
 	if this == nil {
@@ -7256,7 +6019,7 @@ func deriveGoString_70(this map[string][]*pickle.Rick) string {
 	} else {
 		fmt.Fprintf(buf, "this := make(map[string][]*pickle.Rick)\n")
 		for k, v := range this {
-			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_88(v))
+			fmt.Fprintf(buf, "this[%#v] = %s\n", k, deriveGoString_SliceOf_PtrTo_pickle_Rick(v))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -7264,21 +6027,50 @@ func deriveGoString_70(this map[string][]*pickle.Rick) string {
 	return buf.String()
 }
 
-// deriveGoString_p returns a recursive representation of this as a valid go string.
-func deriveGoString_p(this privateStruct) string {
+// deriveGoString_privateStruct returns a recursive representation of this as a valid go string.
+func deriveGoString_privateStruct(this privateStruct) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.privateStruct {\n")
+	fmt.Fprintf(buf, "this := &test.privateStruct{}\n")
+	if this.ptrfield != nil {
+		fmt.Fprintf(buf, "this.ptrfield = func (v int) *int { return &v }(%#v)\n", *this.ptrfield)
+	}
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_CacheEntry returns a recursive representation of this as a valid go string.
+func deriveGoString_CacheEntry(this CacheEntry) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.CacheEntry {\n")
+	fmt.Fprintf(buf, "this := &test.CacheEntry{}\n")
+	fmt.Fprintf(buf, "this.Value = %#v\n", this.Value)
+	fmt.Fprintf(buf, "this.memo = %#v\n", this.memo)
+	fmt.Fprintf(buf, "this.Token = %#v\n", this.Token)
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_PtrTo_Pair_string_int64 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_Pair_string_int64(this *Pair[string, int64]) string {
 	buf := bytes.NewBuffer(nil)
-	fmt.Fprintf(buf, "func() test.privateStruct {\n")
-	fmt.Fprintf(buf, "this := &test.privateStruct{}\n")
-	if this.ptrfield != nil {
-		fmt.Fprintf(buf, "this.ptrfield = func (v int) *int { return &v }(%#v)\n", *this.ptrfield)
+	fmt.Fprintf(buf, "func() *test.Pair[string, int64] {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &test.Pair[string, int64]{}\n")
+		fmt.Fprintf(buf, "this.Key = %#v\n", this.Key)
+		fmt.Fprintf(buf, "this.Value = %#v\n", this.Value)
+		fmt.Fprintf(buf, "return this\n")
 	}
-	fmt.Fprintf(buf, "return *this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveDeepCopy recursively copies the contents of src into dst.
-func deriveDeepCopy(dst, src []*bool) {
+// deriveDeepCopy_SliceOf_PtrTo_bool recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_bool(dst, src []*bool) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7289,8 +6081,8 @@ func deriveDeepCopy(dst, src []*bool) {
 	}
 }
 
-// deriveDeepCopy_ recursively copies the contents of src into dst.
-func deriveDeepCopy_(dst, src []*byte) {
+// deriveDeepCopy_SliceOf_PtrTo_byte recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_byte(dst, src []*byte) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7301,8 +6093,8 @@ func deriveDeepCopy_(dst, src []*byte) {
 	}
 }
 
-// deriveDeepCopy_1 recursively copies the contents of src into dst.
-func deriveDeepCopy_1(dst, src []*complex128) {
+// deriveDeepCopy_SliceOf_PtrTo_complex128 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_complex128(dst, src []*complex128) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7313,8 +6105,8 @@ func deriveDeepCopy_1(dst, src []*complex128) {
 	}
 }
 
-// deriveDeepCopy_2 recursively copies the contents of src into dst.
-func deriveDeepCopy_2(dst, src []*complex64) {
+// deriveDeepCopy_SliceOf_PtrTo_complex64 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_complex64(dst, src []*complex64) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7325,8 +6117,8 @@ func deriveDeepCopy_2(dst, src []*complex64) {
 	}
 }
 
-// deriveDeepCopy_3 recursively copies the contents of src into dst.
-func deriveDeepCopy_3(dst, src []*float64) {
+// deriveDeepCopy_SliceOf_PtrTo_float64 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_float64(dst, src []*float64) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7337,8 +6129,8 @@ func deriveDeepCopy_3(dst, src []*float64) {
 	}
 }
 
-// deriveDeepCopy_4 recursively copies the contents of src into dst.
-func deriveDeepCopy_4(dst, src []*float32) {
+// deriveDeepCopy_SliceOf_PtrTo_float32 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_float32(dst, src []*float32) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7349,8 +6141,8 @@ func deriveDeepCopy_4(dst, src []*float32) {
 	}
 }
 
-// deriveDeepCopy_5 recursively copies the contents of src into dst.
-func deriveDeepCopy_5(dst, src []*int) {
+// deriveDeepCopy_SliceOf_PtrTo_int recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_int(dst, src []*int) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7361,8 +6153,8 @@ func deriveDeepCopy_5(dst, src []*int) {
 	}
 }
 
-// deriveDeepCopy_6 recursively copies the contents of src into dst.
-func deriveDeepCopy_6(dst, src []*int16) {
+// deriveDeepCopy_SliceOf_PtrTo_int16 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_int16(dst, src []*int16) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7373,8 +6165,8 @@ func deriveDeepCopy_6(dst, src []*int16) {
 	}
 }
 
-// deriveDeepCopy_7 recursively copies the contents of src into dst.
-func deriveDeepCopy_7(dst, src []*int32) {
+// deriveDeepCopy_SliceOf_PtrTo_int32 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_int32(dst, src []*int32) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7385,8 +6177,8 @@ func deriveDeepCopy_7(dst, src []*int32) {
 	}
 }
 
-// deriveDeepCopy_8 recursively copies the contents of src into dst.
-func deriveDeepCopy_8(dst, src []*int64) {
+// deriveDeepCopy_SliceOf_PtrTo_int64 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_int64(dst, src []*int64) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7397,8 +6189,8 @@ func deriveDeepCopy_8(dst, src []*int64) {
 	}
 }
 
-// deriveDeepCopy_9 recursively copies the contents of src into dst.
-func deriveDeepCopy_9(dst, src []*int8) {
+// deriveDeepCopy_SliceOf_PtrTo_int8 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_int8(dst, src []*int8) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7409,8 +6201,8 @@ func deriveDeepCopy_9(dst, src []*int8) {
 	}
 }
 
-// deriveDeepCopy_10 recursively copies the contents of src into dst.
-func deriveDeepCopy_10(dst, src []*string) {
+// deriveDeepCopy_SliceOf_PtrTo_string recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_string(dst, src []*string) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7421,8 +6213,8 @@ func deriveDeepCopy_10(dst, src []*string) {
 	}
 }
 
-// deriveDeepCopy_11 recursively copies the contents of src into dst.
-func deriveDeepCopy_11(dst, src []*uint) {
+// deriveDeepCopy_SliceOf_PtrTo_uint recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_uint(dst, src []*uint) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7433,8 +6225,8 @@ func deriveDeepCopy_11(dst, src []*uint) {
 	}
 }
 
-// deriveDeepCopy_12 recursively copies the contents of src into dst.
-func deriveDeepCopy_12(dst, src []*uint16) {
+// deriveDeepCopy_SliceOf_PtrTo_uint16 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_uint16(dst, src []*uint16) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7445,8 +6237,8 @@ func deriveDeepCopy_12(dst, src []*uint16) {
 	}
 }
 
-// deriveDeepCopy_13 recursively copies the contents of src into dst.
-func deriveDeepCopy_13(dst, src []*uint32) {
+// deriveDeepCopy_SliceOf_PtrTo_uint32 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_uint32(dst, src []*uint32) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7457,8 +6249,8 @@ func deriveDeepCopy_13(dst, src []*uint32) {
 	}
 }
 
-// deriveDeepCopy_14 recursively copies the contents of src into dst.
-func deriveDeepCopy_14(dst, src []*uint64) {
+// deriveDeepCopy_SliceOf_PtrTo_uint64 recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_uint64(dst, src []*uint64) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7469,8 +6261,8 @@ func deriveDeepCopy_14(dst, src []*uint64) {
 	}
 }
 
-// deriveDeepCopy_15 recursively copies the contents of src into dst.
-func deriveDeepCopy_15(dst, src []*uintptr) {
+// deriveDeepCopy_SliceOf_PtrTo_uintptr recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_uintptr(dst, src []*uintptr) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7481,57 +6273,57 @@ func deriveDeepCopy_15(dst, src []*uintptr) {
 	}
 }
 
-// deriveDeepCopy_16 recursively copies the contents of src into dst.
-func deriveDeepCopy_16(dst, src map[string]uint32) {
+// deriveDeepCopy_MapOf_string_To_uint32 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_uint32(dst, src map[string]uint32) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_17 recursively copies the contents of src into dst.
-func deriveDeepCopy_17(dst, src map[uint8]int64) {
+// deriveDeepCopy_MapOf_uint8_To_int64 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_uint8_To_int64(dst, src map[uint8]int64) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_18 recursively copies the contents of src into dst.
-func deriveDeepCopy_18(dst, src map[bool]string) {
+// deriveDeepCopy_MapOf_bool_To_string recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_bool_To_string(dst, src map[bool]string) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_19 recursively copies the contents of src into dst.
-func deriveDeepCopy_19(dst, src map[string]bool) {
+// deriveDeepCopy_MapOf_string_To_bool recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_bool(dst, src map[string]bool) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_20 recursively copies the contents of src into dst.
-func deriveDeepCopy_20(dst, src map[complex128]complex64) {
+// deriveDeepCopy_MapOf_complex128_To_complex64 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_complex128_To_complex64(dst, src map[complex128]complex64) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_21 recursively copies the contents of src into dst.
-func deriveDeepCopy_21(dst, src map[float64]uint32) {
+// deriveDeepCopy_MapOf_float64_To_uint32 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_float64_To_uint32(dst, src map[float64]uint32) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_22 recursively copies the contents of src into dst.
-func deriveDeepCopy_22(dst, src map[uint16]uint8) {
+// deriveDeepCopy_MapOf_uint16_To_uint8 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_uint16_To_uint8(dst, src map[uint16]uint8) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_23 recursively copies the contents of src into dst.
-func deriveDeepCopy_23(dst, src [][]int) {
+// deriveDeepCopy_SliceOf_SliceOf_int recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_SliceOf_int(dst, src [][]int) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7554,8 +6346,8 @@ func deriveDeepCopy_23(dst, src [][]int) {
 	}
 }
 
-// deriveDeepCopy_24 recursively copies the contents of src into dst.
-func deriveDeepCopy_24(dst, src [][]string) {
+// deriveDeepCopy_SliceOf_SliceOf_string recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_SliceOf_string(dst, src [][]string) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7578,8 +6370,8 @@ func deriveDeepCopy_24(dst, src [][]string) {
 	}
 }
 
-// deriveDeepCopy_25 recursively copies the contents of src into dst.
-func deriveDeepCopy_25(dst, src [][]*int) {
+// deriveDeepCopy_SliceOf_SliceOf_PtrTo_int recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_SliceOf_PtrTo_int(dst, src [][]*int) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7597,13 +6389,13 @@ func deriveDeepCopy_25(dst, src [][]*int) {
 			} else {
 				dst[src_i] = make([]*int, len(src_value))
 			}
-			deriveDeepCopy_5(dst[src_i], src_value)
+			deriveDeepCopy_SliceOf_PtrTo_int(dst[src_i], src_value)
 		}
 	}
 }
 
-// deriveDeepCopy_26 recursively copies the contents of src into dst.
-func deriveDeepCopy_26(dst, src *[]int) {
+// deriveDeepCopy_PtrTo_SliceOf_int recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_SliceOf_int(dst, src *[]int) {
 	if *src == nil {
 		*dst = nil
 	} else {
@@ -7624,18 +6416,18 @@ func deriveDeepCopy_26(dst, src *[]int) {
 	}
 }
 
-// deriveDeepCopy_27 recursively copies the contents of src into dst.
-func deriveDeepCopy_27(dst, src *map[int]int) {
+// deriveDeepCopy_PtrTo_MapOf_int_To_int recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_MapOf_int_To_int(dst, src *map[int]int) {
 	if *src != nil {
 		*dst = make(map[int]int, len(*src))
-		deriveDeepCopy_52(*dst, *src)
+		deriveDeepCopy_MapOf_int_To_int(*dst, *src)
 	} else {
 		*dst = nil
 	}
 }
 
-// deriveDeepCopy_28 recursively copies the contents of src into dst.
-func deriveDeepCopy_28(dst, src []*Name) {
+// deriveDeepCopy_SliceOf_PtrTo_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_Name(dst, src []*Name) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7646,8 +6438,8 @@ func deriveDeepCopy_28(dst, src []*Name) {
 	}
 }
 
-// deriveDeepCopy_29 recursively copies the contents of src into dst.
-func deriveDeepCopy_29(dst, src []*StructWithoutMethod) {
+// deriveDeepCopy_SliceOf_PtrTo_StructWithoutMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_StructWithoutMethod(dst, src []*StructWithoutMethod) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7658,22 +6450,22 @@ func deriveDeepCopy_29(dst, src []*StructWithoutMethod) {
 	}
 }
 
-// deriveDeepCopy_30 recursively copies the contents of src into dst.
-func deriveDeepCopy_30(dst, src map[Name]string) {
+// deriveDeepCopy_MapOf_Name_To_string recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_Name_To_string(dst, src map[Name]string) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_31 recursively copies the contents of src into dst.
-func deriveDeepCopy_31(dst, src map[string]Name) {
+// deriveDeepCopy_MapOf_string_To_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_Name(dst, src map[string]Name) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_32 recursively copies the contents of src into dst.
-func deriveDeepCopy_32(dst, src map[string]*Name) {
+// deriveDeepCopy_MapOf_string_To_PtrTo_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_PtrTo_Name(dst, src map[string]*Name) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7687,8 +6479,8 @@ func deriveDeepCopy_32(dst, src map[string]*Name) {
 	}
 }
 
-// deriveDeepCopy_33 recursively copies the contents of src into dst.
-func deriveDeepCopy_33(dst, src map[string][]Name) {
+// deriveDeepCopy_MapOf_string_To_SliceOf_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_SliceOf_Name(dst, src map[string][]Name) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7714,8 +6506,8 @@ func deriveDeepCopy_33(dst, src map[string][]Name) {
 	}
 }
 
-// deriveDeepCopy_34 recursively copies the contents of src into dst.
-func deriveDeepCopy_34(dst, src map[string][]*Name) {
+// deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_Name(dst, src map[string][]*Name) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7736,27 +6528,27 @@ func deriveDeepCopy_34(dst, src map[string][]*Name) {
 			} else {
 				dst[src_key] = make([]*Name, len(src_value))
 			}
-			deriveDeepCopy_28(dst[src_key], src_value)
+			deriveDeepCopy_SliceOf_PtrTo_Name(dst[src_key], src_value)
 		}
 	}
 }
 
-// deriveDeepCopy_35 recursively copies the contents of src into dst.
-func deriveDeepCopy_35(dst, src map[string]StructWithoutMethod) {
+// deriveDeepCopy_MapOf_string_To_StructWithoutMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_StructWithoutMethod(dst, src map[string]StructWithoutMethod) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_36 recursively copies the contents of src into dst.
-func deriveDeepCopy_36(dst, src map[StructWithoutMethod]string) {
+// deriveDeepCopy_MapOf_StructWithoutMethod_To_string recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_StructWithoutMethod_To_string(dst, src map[StructWithoutMethod]string) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_37 recursively copies the contents of src into dst.
-func deriveDeepCopy_37(dst, src map[string]*StructWithoutMethod) {
+// deriveDeepCopy_MapOf_string_To_PtrTo_StructWithoutMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_PtrTo_StructWithoutMethod(dst, src map[string]*StructWithoutMethod) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7770,8 +6562,8 @@ func deriveDeepCopy_37(dst, src map[string]*StructWithoutMethod) {
 	}
 }
 
-// deriveDeepCopy_38 recursively copies the contents of src into dst.
-func deriveDeepCopy_38(dst, src map[string][]StructWithoutMethod) {
+// deriveDeepCopy_MapOf_string_To_SliceOf_StructWithoutMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_SliceOf_StructWithoutMethod(dst, src map[string][]StructWithoutMethod) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7797,8 +6589,8 @@ func deriveDeepCopy_38(dst, src map[string][]StructWithoutMethod) {
 	}
 }
 
-// deriveDeepCopy_39 recursively copies the contents of src into dst.
-func deriveDeepCopy_39(dst, src map[string][]*StructWithoutMethod) {
+// deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(dst, src map[string][]*StructWithoutMethod) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -7819,13 +6611,13 @@ func deriveDeepCopy_39(dst, src map[string][]*StructWithoutMethod) {
 			} else {
 				dst[src_key] = make([]*StructWithoutMethod, len(src_value))
 			}
-			deriveDeepCopy_29(dst[src_key], src_value)
+			deriveDeepCopy_SliceOf_PtrTo_StructWithoutMethod(dst[src_key], src_value)
 		}
 	}
 }
 
-// deriveDeepCopy_40 recursively copies the contents of src into dst.
-func deriveDeepCopy_40(dst, src map[int]RecursiveType) {
+// deriveDeepCopy_MapOf_int_To_RecursiveType recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_int_To_RecursiveType(dst, src map[int]RecursiveType) {
 	for src_key, src_value := range src {
 		{
 			field := new(RecursiveType)
@@ -7835,8 +6627,8 @@ func deriveDeepCopy_40(dst, src map[int]RecursiveType) {
 	}
 }
 
-// deriveDeepCopy_41 recursively copies the contents of src into dst.
-func deriveDeepCopy_41(dst, src *extra.PrivateFieldAndNoEqualMethod) {
+// deriveDeepCopy_PtrTo_extra_PrivateFieldAndNoEqualMethod recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_extra_PrivateFieldAndNoEqualMethod(dst, src *extra.PrivateFieldAndNoEqualMethod) {
 	src_v := reflect.Indirect(reflect.ValueOf(src))
 	dst_v := reflect.Indirect(reflect.ValueOf(dst))
 	*(*int64)(unsafe.Pointer(dst_v.FieldByName("number").UnsafeAddr())) = *(*int64)(unsafe.Pointer(src_v.FieldByName("number").UnsafeAddr()))
@@ -7880,7 +6672,7 @@ func deriveDeepCopy_41(dst, src *extra.PrivateFieldAndNoEqualMethod) {
 		} else {
 			*(*[]*int64)(unsafe.Pointer(dst_v.FieldByName("numberpts").UnsafeAddr())) = make([]*int64, len(*(*[]*int64)(unsafe.Pointer(src_v.FieldByName("numberpts").UnsafeAddr()))))
 		}
-		deriveDeepCopy_8(*(*[]*int64)(unsafe.Pointer(dst_v.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(src_v.FieldByName("numberpts").UnsafeAddr())))
+		deriveDeepCopy_SliceOf_PtrTo_int64(*(*[]*int64)(unsafe.Pointer(dst_v.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(src_v.FieldByName("numberpts").UnsafeAddr())))
 	}
 	if *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(src_v.FieldByName("strct").UnsafeAddr())) == nil {
 		*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(dst_v.FieldByName("strct").UnsafeAddr())) = nil
@@ -7890,8 +6682,8 @@ func deriveDeepCopy_41(dst, src *extra.PrivateFieldAndNoEqualMethod) {
 	}
 }
 
-// deriveDeepCopy_42 recursively copies the contents of src into dst.
-func deriveDeepCopy_42(dst, src []*MyEnum) {
+// deriveDeepCopy_SliceOf_PtrTo_MyEnum recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_MyEnum(dst, src []*MyEnum) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7902,22 +6694,22 @@ func deriveDeepCopy_42(dst, src []*MyEnum) {
 	}
 }
 
-// deriveDeepCopy_43 recursively copies the contents of src into dst.
-func deriveDeepCopy_43(dst, src map[int32]MyEnum) {
+// deriveDeepCopy_MapOf_int32_To_MyEnum recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_int32_To_MyEnum(dst, src map[int32]MyEnum) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_44 recursively copies the contents of src into dst.
-func deriveDeepCopy_44(dst, src map[MyEnum]int32) {
+// deriveDeepCopy_MapOf_MyEnum_To_int32 recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_MyEnum_To_int32(dst, src map[MyEnum]int32) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_45 recursively copies the contents of src into dst.
-func deriveDeepCopy_45(dst, src *MySlice) {
+// deriveDeepCopy_PtrTo_MySlice recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_MySlice(dst, src *MySlice) {
 	if *src == nil {
 		*dst = nil
 	} else {
@@ -7938,8 +6730,8 @@ func deriveDeepCopy_45(dst, src *MySlice) {
 	}
 }
 
-// deriveDeepCopy_46 recursively copies the contents of src into dst.
-func deriveDeepCopy_46(dst, src []MySlice) {
+// deriveDeepCopy_SliceOf_MySlice recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_MySlice(dst, src []MySlice) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7962,8 +6754,8 @@ func deriveDeepCopy_46(dst, src []MySlice) {
 	}
 }
 
-// deriveDeepCopy_47 recursively copies the contents of src into dst.
-func deriveDeepCopy_47(dst, src []*time.Duration) {
+// deriveDeepCopy_SliceOf_PtrTo_time_Duration recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_time_Duration(dst, src []*time.Duration) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -7974,15 +6766,15 @@ func deriveDeepCopy_47(dst, src []*time.Duration) {
 	}
 }
 
-// deriveDeepCopy_48 recursively copies the contents of src into dst.
-func deriveDeepCopy_48(dst, src map[int]time.Duration) {
+// deriveDeepCopy_MapOf_int_To_time_Duration recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_int_To_time_Duration(dst, src map[int]time.Duration) {
 	for src_key, src_value := range src {
 		dst[src_key] = src_value
 	}
 }
 
-// deriveDeepCopy_49 recursively copies the contents of src into dst.
-func deriveDeepCopy_49(dst, src map[string][]*pickle.Rick) {
+// deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_pickle_Rick recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(dst, src map[string][]*pickle.Rick) {
 	for src_key, src_value := range src {
 		if src_value == nil {
 			dst[src_key] = nil
@@ -8003,13 +6795,13 @@ func deriveDeepCopy_49(dst, src map[string][]*pickle.Rick) {
 			} else {
 				dst[src_key] = make([]*pickle.Rick, len(src_value))
 			}
-			deriveDeepCopy_55(dst[src_key], src_value)
+			deriveDeepCopy_SliceOf_PtrTo_pickle_Rick(dst[src_key], src_value)
 		}
 	}
 }
 
-// deriveDeepCopy_50 recursively copies the contents of src into dst.
-func deriveDeepCopy_50(dst, src *privateStruct) {
+// deriveDeepCopy_PtrTo_privateStruct recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_privateStruct(dst, src *privateStruct) {
 	if src.ptrfield == nil {
 		dst.ptrfield = nil
 	} else {
@@ -8018,33 +6810,86 @@ func deriveDeepCopy_50(dst, src *privateStruct) {
 	}
 }
 
-// deriveDeepCopy_51 recursively copies the contents of src into dst.
-func deriveDeepCopy_51(dst, src []int) {
-	copy(dst, src)
+// deriveDeepCopy_PtrTo_Tree_int recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Tree_int(dst, src *Tree[int]) {
+	dst.Value = src.Value
+	if src.Children == nil {
+		dst.Children = nil
+	} else {
+		if dst.Children != nil {
+			if len(src.Children) > len(dst.Children) {
+				if cap(dst.Children) >= len(src.Children) {
+					dst.Children = (dst.Children)[:len(src.Children)]
+				} else {
+					dst.Children = make([]*Tree[int], len(src.Children))
+				}
+			} else if len(src.Children) < len(dst.Children) {
+				dst.Children = (dst.Children)[:len(src.Children)]
+			}
+		} else {
+			dst.Children = make([]*Tree[int], len(src.Children))
+		}
+		deriveDeepCopy_SliceOf_PtrTo_Tree_int(dst.Children, src.Children)
+	}
+}
+
+// deriveDeepCopy_PtrTo_Tree_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Tree_Name(dst, src *Tree[Name]) {
+	dst.Value = src.Value
+	if src.Children == nil {
+		dst.Children = nil
+	} else {
+		if dst.Children != nil {
+			if len(src.Children) > len(dst.Children) {
+				if cap(dst.Children) >= len(src.Children) {
+					dst.Children = (dst.Children)[:len(src.Children)]
+				} else {
+					dst.Children = make([]*Tree[Name], len(src.Children))
+				}
+			} else if len(src.Children) < len(dst.Children) {
+				dst.Children = (dst.Children)[:len(src.Children)]
+			}
+		} else {
+			dst.Children = make([]*Tree[Name], len(src.Children))
+		}
+		deriveDeepCopy_SliceOf_PtrTo_Tree_Name(dst.Children, src.Children)
+	}
 }
 
-// deriveDeepCopy_52 recursively copies the contents of src into dst.
-func deriveDeepCopy_52(dst, src map[int]int) {
-	for src_key, src_value := range src {
-		dst[src_key] = src_value
+// deriveDeepCopy_SliceOf_Pair_string_PtrTo_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_Pair_string_PtrTo_Name(dst, src []Pair[string, *Name]) {
+	for src_i, src_value := range src {
+		{
+			field := new(Pair[string, *Name])
+			deriveDeepCopy_PtrTo_Pair_string_PtrTo_Name(field, &src_value)
+			dst[src_i] = *field
+		}
 	}
 }
 
-// deriveDeepCopy_53 recursively copies the contents of src into dst.
-func deriveDeepCopy_53(dst, src *int) {
-	*dst = *src
+// deriveDeepCopy_MapOf_string_To_Pair_int64_SliceOf_string recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_string_To_Pair_int64_SliceOf_string(dst, src map[string]Pair[int64, []string]) {
+	for src_key, src_value := range src {
+		{
+			field := new(Pair[int64, []string])
+			deriveDeepCopy_PtrTo_Pair_int64_SliceOf_string(field, &src_value)
+			dst[src_key] = *field
+		}
+	}
 }
 
-// deriveDeepCopy_54 recursively copies the contents of src into dst.
-func deriveDeepCopy_54(dst, src *[10]int) {
-	*dst = *src
+// deriveDeepCopy_MapOf_int_To_int recursively copies the contents of src into dst.
+func deriveDeepCopy_MapOf_int_To_int(dst, src map[int]int) {
+	for src_key, src_value := range src {
+		dst[src_key] = src_value
+	}
 }
 
-// deriveCompare returns:
+// deriveCompare_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare(this, that bool) int {
+func deriveCompare_bool(this, that bool) int {
 	if this == that {
 		return 0
 	}
@@ -8054,11 +6899,11 @@ func deriveCompare(this, that bool) int {
 	return 1
 }
 
-// deriveCompare_ returns:
+// deriveCompare_byte returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_(this, that byte) int {
+func deriveCompare_byte(this, that byte) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8069,11 +6914,11 @@ func deriveCompare_(this, that byte) int {
 	return 0
 }
 
-// deriveCompare_f returns:
+// deriveCompare_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_f(this, that float64) int {
+func deriveCompare_float64(this, that float64) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8084,11 +6929,11 @@ func deriveCompare_f(this, that float64) int {
 	return 0
 }
 
-// deriveCompare_fl returns:
+// deriveCompare_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_fl(this, that float32) int {
+func deriveCompare_float32(this, that float32) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8099,11 +6944,11 @@ func deriveCompare_fl(this, that float32) int {
 	return 0
 }
 
-// deriveCompare_i returns:
+// deriveCompare_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_i(this, that int) int {
+func deriveCompare_int(this, that int) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8114,11 +6959,11 @@ func deriveCompare_i(this, that int) int {
 	return 0
 }
 
-// deriveCompare_in returns:
+// deriveCompare_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_in(this, that int16) int {
+func deriveCompare_int16(this, that int16) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8129,11 +6974,11 @@ func deriveCompare_in(this, that int16) int {
 	return 0
 }
 
-// deriveCompare_int returns:
+// deriveCompare_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_int(this, that int32) int {
+func deriveCompare_int32(this, that int32) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8144,11 +6989,11 @@ func deriveCompare_int(this, that int32) int {
 	return 0
 }
 
-// deriveCompare_int6 returns:
+// deriveCompare_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_int6(this, that int64) int {
+func deriveCompare_int64(this, that int64) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8174,11 +7019,11 @@ func deriveCompare_int8(this, that int8) int {
 	return 0
 }
 
-// deriveCompare_u returns:
+// deriveCompare_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_u(this, that uint) int {
+func deriveCompare_uint(this, that uint) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8189,11 +7034,11 @@ func deriveCompare_u(this, that uint) int {
 	return 0
 }
 
-// deriveCompare_ui returns:
+// deriveCompare_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_ui(this, that uint16) int {
+func deriveCompare_uint16(this, that uint16) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8204,11 +7049,11 @@ func deriveCompare_ui(this, that uint16) int {
 	return 0
 }
 
-// deriveCompare_uin returns:
+// deriveCompare_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_uin(this, that uint32) int {
+func deriveCompare_uint32(this, that uint32) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8219,11 +7064,11 @@ func deriveCompare_uin(this, that uint32) int {
 	return 0
 }
 
-// deriveCompare_uint returns:
+// deriveCompare_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_uint(this, that uint64) int {
+func deriveCompare_uint64(this, that uint64) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8234,11 +7079,11 @@ func deriveCompare_uint(this, that uint64) int {
 	return 0
 }
 
-// deriveCompare_1 returns:
+// deriveCompare_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_1(this, that uintptr) int {
+func deriveCompare_uintptr(this, that uintptr) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -8249,11 +7094,11 @@ func deriveCompare_1(this, that uintptr) int {
 	return 0
 }
 
-// deriveCompare_2 returns:
+// deriveCompare_PtrTo_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_2(this, that *bool) int {
+func deriveCompare_PtrTo_bool(this, that *bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8263,14 +7108,14 @@ func deriveCompare_2(this, that *bool) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare(*this, *that)
+	return deriveCompare_bool(*this, *that)
 }
 
-// deriveCompare_3 returns:
+// deriveCompare_PtrTo_byte returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_3(this, that *byte) int {
+func deriveCompare_PtrTo_byte(this, that *byte) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8280,14 +7125,14 @@ func deriveCompare_3(this, that *byte) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_(*this, *that)
+	return deriveCompare_byte(*this, *that)
 }
 
-// deriveCompare_4 returns:
+// deriveCompare_PtrTo_complex128 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_4(this, that *complex128) int {
+func deriveCompare_PtrTo_complex128(this, that *complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8300,11 +7145,11 @@ func deriveCompare_4(this, that *complex128) int {
 	return deriveCompareComplex64(*this, *that)
 }
 
-// deriveCompare_5 returns:
+// deriveCompare_PtrTo_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_5(this, that *complex64) int {
+func deriveCompare_PtrTo_complex64(this, that *complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8317,11 +7162,11 @@ func deriveCompare_5(this, that *complex64) int {
 	return deriveCompareComplex32(*this, *that)
 }
 
-// deriveCompare_6 returns:
+// deriveCompare_PtrTo_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_6(this, that *float64) int {
+func deriveCompare_PtrTo_float64(this, that *float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8331,14 +7176,14 @@ func deriveCompare_6(this, that *float64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_f(*this, *that)
+	return deriveCompare_float64(*this, *that)
 }
 
-// deriveCompare_7 returns:
+// deriveCompare_PtrTo_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_7(this, that *float32) int {
+func deriveCompare_PtrTo_float32(this, that *float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8348,14 +7193,14 @@ func deriveCompare_7(this, that *float32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_fl(*this, *that)
+	return deriveCompare_float32(*this, *that)
 }
 
-// deriveCompare_8 returns:
+// deriveCompare_PtrTo_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_8(this, that *int) int {
+func deriveCompare_PtrTo_int(this, that *int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8365,14 +7210,14 @@ func deriveCompare_8(this, that *int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_i(*this, *that)
+	return deriveCompare_int(*this, *that)
 }
 
-// deriveCompare_9 returns:
+// deriveCompare_PtrTo_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_9(this, that *int16) int {
+func deriveCompare_PtrTo_int16(this, that *int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8382,14 +7227,14 @@ func deriveCompare_9(this, that *int16) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_in(*this, *that)
+	return deriveCompare_int16(*this, *that)
 }
 
-// deriveCompare_10 returns:
+// deriveCompare_PtrTo_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_10(this, that *int32) int {
+func deriveCompare_PtrTo_int32(this, that *int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8399,14 +7244,14 @@ func deriveCompare_10(this, that *int32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_int(*this, *that)
+	return deriveCompare_int32(*this, *that)
 }
 
-// deriveCompare_11 returns:
+// deriveCompare_PtrTo_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_11(this, that *int64) int {
+func deriveCompare_PtrTo_int64(this, that *int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8416,14 +7261,14 @@ func deriveCompare_11(this, that *int64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_int6(*this, *that)
+	return deriveCompare_int64(*this, *that)
 }
 
-// deriveCompare_12 returns:
+// deriveCompare_PtrTo_int8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_12(this, that *int8) int {
+func deriveCompare_PtrTo_int8(this, that *int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8436,11 +7281,11 @@ func deriveCompare_12(this, that *int8) int {
 	return deriveCompare_int8(*this, *that)
 }
 
-// deriveCompare_13 returns:
+// deriveCompare_PtrTo_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_13(this, that *string) int {
+func deriveCompare_PtrTo_string(this, that *string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8450,14 +7295,14 @@ func deriveCompare_13(this, that *string) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_s(*this, *that)
+	return deriveCompare_string(*this, *that)
 }
 
-// deriveCompare_14 returns:
+// deriveCompare_PtrTo_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_14(this, that *uint) int {
+func deriveCompare_PtrTo_uint(this, that *uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8467,14 +7312,14 @@ func deriveCompare_14(this, that *uint) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_u(*this, *that)
+	return deriveCompare_uint(*this, *that)
 }
 
-// deriveCompare_15 returns:
+// deriveCompare_PtrTo_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_15(this, that *uint16) int {
+func deriveCompare_PtrTo_uint16(this, that *uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8484,14 +7329,14 @@ func deriveCompare_15(this, that *uint16) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_ui(*this, *that)
+	return deriveCompare_uint16(*this, *that)
 }
 
-// deriveCompare_16 returns:
+// deriveCompare_PtrTo_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_16(this, that *uint32) int {
+func deriveCompare_PtrTo_uint32(this, that *uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8501,14 +7346,14 @@ func deriveCompare_16(this, that *uint32) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_uin(*this, *that)
+	return deriveCompare_uint32(*this, *that)
 }
 
-// deriveCompare_17 returns:
+// deriveCompare_PtrTo_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_17(this, that *uint64) int {
+func deriveCompare_PtrTo_uint64(this, that *uint64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8518,14 +7363,14 @@ func deriveCompare_17(this, that *uint64) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_uint(*this, *that)
+	return deriveCompare_uint64(*this, *that)
 }
 
-// deriveCompare_18 returns:
+// deriveCompare_PtrTo_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_18(this, that *uintptr) int {
+func deriveCompare_PtrTo_uintptr(this, that *uintptr) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8535,14 +7380,14 @@ func deriveCompare_18(this, that *uintptr) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_1(*this, *that)
+	return deriveCompare_uintptr(*this, *that)
 }
 
-// deriveCompare_19 returns:
+// deriveCompare_SliceOf_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_19(this, that []bool) int {
+func deriveCompare_SliceOf_bool(this, that []bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8559,18 +7404,18 @@ func deriveCompare_19(this, that []bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_20 returns:
+// deriveCompare_SliceOf_complex128 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_20(this, that []complex128) int {
+func deriveCompare_SliceOf_complex128(this, that []complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8594,11 +7439,11 @@ func deriveCompare_20(this, that []complex128) int {
 	return 0
 }
 
-// deriveCompare_21 returns:
+// deriveCompare_SliceOf_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_21(this, that []complex64) int {
+func deriveCompare_SliceOf_complex64(this, that []complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8622,11 +7467,11 @@ func deriveCompare_21(this, that []complex64) int {
 	return 0
 }
 
-// deriveCompare_22 returns:
+// deriveCompare_SliceOf_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_22(this, that []float64) int {
+func deriveCompare_SliceOf_float64(this, that []float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8643,18 +7488,18 @@ func deriveCompare_22(this, that []float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_f(this[i], that[i]); c != 0 {
+		if c := deriveCompare_float64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_23 returns:
+// deriveCompare_SliceOf_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_23(this, that []float32) int {
+func deriveCompare_SliceOf_float32(this, that []float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8671,18 +7516,18 @@ func deriveCompare_23(this, that []float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
+		if c := deriveCompare_float32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_24 returns:
+// deriveCompare_SliceOf_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_24(this, that []int) int {
+func deriveCompare_SliceOf_int(this, that []int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8699,18 +7544,18 @@ func deriveCompare_24(this, that []int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_25 returns:
+// deriveCompare_SliceOf_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_25(this, that []int16) int {
+func deriveCompare_SliceOf_int16(this, that []int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8727,18 +7572,18 @@ func deriveCompare_25(this, that []int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_in(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_26 returns:
+// deriveCompare_SliceOf_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_26(this, that []int32) int {
+func deriveCompare_SliceOf_int32(this, that []int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8755,18 +7600,18 @@ func deriveCompare_26(this, that []int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_27 returns:
+// deriveCompare_SliceOf_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_27(this, that []int64) int {
+func deriveCompare_SliceOf_int64(this, that []int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8783,18 +7628,18 @@ func deriveCompare_27(this, that []int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_28 returns:
+// deriveCompare_SliceOf_int8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_28(this, that []int8) int {
+func deriveCompare_SliceOf_int8(this, that []int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8818,11 +7663,11 @@ func deriveCompare_28(this, that []int8) int {
 	return 0
 }
 
-// deriveCompare_29 returns:
+// deriveCompare_SliceOf_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_29(this, that []string) int {
+func deriveCompare_SliceOf_string(this, that []string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8846,11 +7691,11 @@ func deriveCompare_29(this, that []string) int {
 	return 0
 }
 
-// deriveCompare_30 returns:
+// deriveCompare_SliceOf_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_30(this, that []uint) int {
+func deriveCompare_SliceOf_uint(this, that []uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8867,18 +7712,18 @@ func deriveCompare_30(this, that []uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_u(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_31 returns:
+// deriveCompare_SliceOf_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_31(this, that []uint16) int {
+func deriveCompare_SliceOf_uint16(this, that []uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8895,18 +7740,18 @@ func deriveCompare_31(this, that []uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_32 returns:
+// deriveCompare_SliceOf_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_32(this, that []uint32) int {
+func deriveCompare_SliceOf_uint32(this, that []uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8923,18 +7768,18 @@ func deriveCompare_32(this, that []uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_33 returns:
+// deriveCompare_SliceOf_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_33(this, that []uint64) int {
+func deriveCompare_SliceOf_uint64(this, that []uint64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8951,18 +7796,18 @@ func deriveCompare_33(this, that []uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_34 returns:
+// deriveCompare_SliceOf_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_34(this, that []uintptr) int {
+func deriveCompare_SliceOf_uintptr(this, that []uintptr) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -8979,18 +7824,18 @@ func deriveCompare_34(this, that []uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_1(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uintptr(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_35 returns:
+// deriveCompare_SliceOf_PtrTo_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_35(this, that []*bool) int {
+func deriveCompare_SliceOf_PtrTo_bool(this, that []*bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9007,18 +7852,18 @@ func deriveCompare_35(this, that []*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_36 returns:
+// deriveCompare_SliceOf_PtrTo_byte returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_36(this, that []*byte) int {
+func deriveCompare_SliceOf_PtrTo_byte(this, that []*byte) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9035,18 +7880,18 @@ func deriveCompare_36(this, that []*byte) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_3(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_byte(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_37 returns:
+// deriveCompare_SliceOf_PtrTo_complex128 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_37(this, that []*complex128) int {
+func deriveCompare_SliceOf_PtrTo_complex128(this, that []*complex128) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9063,18 +7908,18 @@ func deriveCompare_37(this, that []*complex128) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_4(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_complex128(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_38 returns:
+// deriveCompare_SliceOf_PtrTo_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_38(this, that []*complex64) int {
+func deriveCompare_SliceOf_PtrTo_complex64(this, that []*complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9091,18 +7936,18 @@ func deriveCompare_38(this, that []*complex64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_5(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_complex64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_39 returns:
+// deriveCompare_SliceOf_PtrTo_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_39(this, that []*float64) int {
+func deriveCompare_SliceOf_PtrTo_float64(this, that []*float64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9119,18 +7964,18 @@ func deriveCompare_39(this, that []*float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_float64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_40 returns:
+// deriveCompare_SliceOf_PtrTo_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_40(this, that []*float32) int {
+func deriveCompare_SliceOf_PtrTo_float32(this, that []*float32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9147,18 +7992,18 @@ func deriveCompare_40(this, that []*float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_7(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_float32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_41 returns:
+// deriveCompare_SliceOf_PtrTo_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_41(this, that []*int) int {
+func deriveCompare_SliceOf_PtrTo_int(this, that []*int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9175,18 +8020,18 @@ func deriveCompare_41(this, that []*int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_8(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_42 returns:
+// deriveCompare_SliceOf_PtrTo_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_42(this, that []*int16) int {
+func deriveCompare_SliceOf_PtrTo_int16(this, that []*int16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9203,18 +8048,18 @@ func deriveCompare_42(this, that []*int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_9(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_43 returns:
+// deriveCompare_SliceOf_PtrTo_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_43(this, that []*int32) int {
+func deriveCompare_SliceOf_PtrTo_int32(this, that []*int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9231,18 +8076,18 @@ func deriveCompare_43(this, that []*int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_10(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_44 returns:
+// deriveCompare_SliceOf_PtrTo_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_44(this, that []*int64) int {
+func deriveCompare_SliceOf_PtrTo_int64(this, that []*int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9259,18 +8104,18 @@ func deriveCompare_44(this, that []*int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_11(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_45 returns:
+// deriveCompare_SliceOf_PtrTo_int8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_45(this, that []*int8) int {
+func deriveCompare_SliceOf_PtrTo_int8(this, that []*int8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9287,18 +8132,18 @@ func deriveCompare_45(this, that []*int8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_12(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int8(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_46 returns:
+// deriveCompare_SliceOf_PtrTo_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_46(this, that []*string) int {
+func deriveCompare_SliceOf_PtrTo_string(this, that []*string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9315,18 +8160,18 @@ func deriveCompare_46(this, that []*string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_13(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_string(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_47 returns:
+// deriveCompare_SliceOf_PtrTo_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_47(this, that []*uint) int {
+func deriveCompare_SliceOf_PtrTo_uint(this, that []*uint) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9343,18 +8188,18 @@ func deriveCompare_47(this, that []*uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_14(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_48 returns:
+// deriveCompare_SliceOf_PtrTo_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_48(this, that []*uint16) int {
+func deriveCompare_SliceOf_PtrTo_uint16(this, that []*uint16) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9371,18 +8216,18 @@ func deriveCompare_48(this, that []*uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_15(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_49 returns:
+// deriveCompare_SliceOf_PtrTo_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_49(this, that []*uint32) int {
+func deriveCompare_SliceOf_PtrTo_uint32(this, that []*uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9399,18 +8244,18 @@ func deriveCompare_49(this, that []*uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_16(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_50 returns:
+// deriveCompare_SliceOf_PtrTo_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_50(this, that []*uint64) int {
+func deriveCompare_SliceOf_PtrTo_uint64(this, that []*uint64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9427,18 +8272,18 @@ func deriveCompare_50(this, that []*uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_17(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_51 returns:
+// deriveCompare_SliceOf_PtrTo_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_51(this, that []*uintptr) int {
+func deriveCompare_SliceOf_PtrTo_uintptr(this, that []*uintptr) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -9455,18 +8300,18 @@ func deriveCompare_51(this, that []*uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_18(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uintptr(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_52 returns:
+// deriveCompare_ArrayOf1_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_52(this, that [1]bool) int {
+func deriveCompare_ArrayOf1_bool(this, that [1]bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9474,18 +8319,18 @@ func deriveCompare_52(this, that [1]bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_53 returns:
+// deriveCompare_ArrayOf2_byte returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_53(this, that [2]byte) int {
+func deriveCompare_ArrayOf2_byte(this, that [2]byte) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9493,18 +8338,18 @@ func deriveCompare_53(this, that [2]byte) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_(this[i], that[i]); c != 0 {
+		if c := deriveCompare_byte(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_54 returns:
+// deriveCompare_ArrayOf3_complex128 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_54(this, that [3]complex128) int {
+func deriveCompare_ArrayOf3_complex128(this, that [3]complex128) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9519,11 +8364,11 @@ func deriveCompare_54(this, that [3]complex128) int {
 	return 0
 }
 
-// deriveCompare_55 returns:
+// deriveCompare_ArrayOf4_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_55(this, that [4]complex64) int {
+func deriveCompare_ArrayOf4_complex64(this, that [4]complex64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9538,11 +8383,11 @@ func deriveCompare_55(this, that [4]complex64) int {
 	return 0
 }
 
-// deriveCompare_56 returns:
+// deriveCompare_ArrayOf5_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_56(this, that [5]float64) int {
+func deriveCompare_ArrayOf5_float64(this, that [5]float64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9550,18 +8395,18 @@ func deriveCompare_56(this, that [5]float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_f(this[i], that[i]); c != 0 {
+		if c := deriveCompare_float64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_57 returns:
+// deriveCompare_ArrayOf6_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_57(this, that [6]float32) int {
+func deriveCompare_ArrayOf6_float32(this, that [6]float32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9569,18 +8414,18 @@ func deriveCompare_57(this, that [6]float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_fl(this[i], that[i]); c != 0 {
+		if c := deriveCompare_float32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_58 returns:
+// deriveCompare_ArrayOf7_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_58(this, that [7]int) int {
+func deriveCompare_ArrayOf7_int(this, that [7]int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9588,18 +8433,18 @@ func deriveCompare_58(this, that [7]int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_59 returns:
+// deriveCompare_ArrayOf8_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_59(this, that [8]int16) int {
+func deriveCompare_ArrayOf8_int16(this, that [8]int16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9607,18 +8452,18 @@ func deriveCompare_59(this, that [8]int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_in(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_60 returns:
+// deriveCompare_ArrayOf9_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_60(this, that [9]int32) int {
+func deriveCompare_ArrayOf9_int32(this, that [9]int32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9626,18 +8471,18 @@ func deriveCompare_60(this, that [9]int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_61 returns:
+// deriveCompare_ArrayOf10_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_61(this, that [10]int64) int {
+func deriveCompare_ArrayOf10_int64(this, that [10]int64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9645,18 +8490,18 @@ func deriveCompare_61(this, that [10]int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_62 returns:
+// deriveCompare_ArrayOf11_int8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_62(this, that [11]int8) int {
+func deriveCompare_ArrayOf11_int8(this, that [11]int8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9671,11 +8516,11 @@ func deriveCompare_62(this, that [11]int8) int {
 	return 0
 }
 
-// deriveCompare_63 returns:
+// deriveCompare_ArrayOf12_rune returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_63(this, that [12]rune) int {
+func deriveCompare_ArrayOf12_rune(this, that [12]rune) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9683,18 +8528,18 @@ func deriveCompare_63(this, that [12]rune) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_int(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_64 returns:
+// deriveCompare_ArrayOf13_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_64(this, that [13]string) int {
+func deriveCompare_ArrayOf13_string(this, that [13]string) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9709,11 +8554,11 @@ func deriveCompare_64(this, that [13]string) int {
 	return 0
 }
 
-// deriveCompare_65 returns:
+// deriveCompare_ArrayOf14_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_65(this, that [14]uint) int {
+func deriveCompare_ArrayOf14_uint(this, that [14]uint) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9721,18 +8566,18 @@ func deriveCompare_65(this, that [14]uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_u(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_66 returns:
+// deriveCompare_ArrayOf15_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_66(this, that [15]uint16) int {
+func deriveCompare_ArrayOf15_uint16(this, that [15]uint16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9740,18 +8585,18 @@ func deriveCompare_66(this, that [15]uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_ui(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_67 returns:
+// deriveCompare_ArrayOf16_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_67(this, that [16]uint32) int {
+func deriveCompare_ArrayOf16_uint32(this, that [16]uint32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9759,18 +8604,18 @@ func deriveCompare_67(this, that [16]uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uin(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_68 returns:
+// deriveCompare_ArrayOf17_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_68(this, that [17]uint64) int {
+func deriveCompare_ArrayOf17_uint64(this, that [17]uint64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9778,18 +8623,18 @@ func deriveCompare_68(this, that [17]uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_uint(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uint64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_69 returns:
+// deriveCompare_ArrayOf18_uint8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_69(this, that [18]uint8) int {
+func deriveCompare_ArrayOf18_uint8(this, that [18]uint8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9797,18 +8642,18 @@ func deriveCompare_69(this, that [18]uint8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_(this[i], that[i]); c != 0 {
+		if c := deriveCompare_byte(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_70 returns:
+// deriveCompare_ArrayOf19_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_70(this, that [19]uintptr) int {
+func deriveCompare_ArrayOf19_uintptr(this, that [19]uintptr) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9816,18 +8661,18 @@ func deriveCompare_70(this, that [19]uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_1(this[i], that[i]); c != 0 {
+		if c := deriveCompare_uintptr(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_71 returns:
+// deriveCompare_ArrayOf10_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_71(this, that [10]bool) int {
+func deriveCompare_ArrayOf10_bool(this, that [10]bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9835,18 +8680,18 @@ func deriveCompare_71(this, that [10]bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare(this[i], that[i]); c != 0 {
+		if c := deriveCompare_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_72 returns:
+// deriveCompare_ArrayOf1_PtrTo_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_72(this, that [1]*bool) int {
+func deriveCompare_ArrayOf1_PtrTo_bool(this, that [1]*bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9854,18 +8699,18 @@ func deriveCompare_72(this, that [1]*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_73 returns:
+// deriveCompare_ArrayOf2_PtrTo_byte returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_73(this, that [2]*byte) int {
+func deriveCompare_ArrayOf2_PtrTo_byte(this, that [2]*byte) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9873,18 +8718,18 @@ func deriveCompare_73(this, that [2]*byte) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_3(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_byte(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_74 returns:
+// deriveCompare_ArrayOf3_PtrTo_complex128 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_74(this, that [3]*complex128) int {
+func deriveCompare_ArrayOf3_PtrTo_complex128(this, that [3]*complex128) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9892,18 +8737,18 @@ func deriveCompare_74(this, that [3]*complex128) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_4(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_complex128(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_75 returns:
+// deriveCompare_ArrayOf4_PtrTo_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_75(this, that [4]*complex64) int {
+func deriveCompare_ArrayOf4_PtrTo_complex64(this, that [4]*complex64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9911,18 +8756,18 @@ func deriveCompare_75(this, that [4]*complex64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_5(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_complex64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_76 returns:
+// deriveCompare_ArrayOf5_PtrTo_float64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_76(this, that [5]*float64) int {
+func deriveCompare_ArrayOf5_PtrTo_float64(this, that [5]*float64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9930,18 +8775,18 @@ func deriveCompare_76(this, that [5]*float64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_6(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_float64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_77 returns:
+// deriveCompare_ArrayOf6_PtrTo_float32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_77(this, that [6]*float32) int {
+func deriveCompare_ArrayOf6_PtrTo_float32(this, that [6]*float32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9949,18 +8794,18 @@ func deriveCompare_77(this, that [6]*float32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_7(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_float32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_78 returns:
+// deriveCompare_ArrayOf7_PtrTo_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_78(this, that [7]*int) int {
+func deriveCompare_ArrayOf7_PtrTo_int(this, that [7]*int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9968,18 +8813,18 @@ func deriveCompare_78(this, that [7]*int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_8(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_79 returns:
+// deriveCompare_ArrayOf8_PtrTo_int16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_79(this, that [8]*int16) int {
+func deriveCompare_ArrayOf8_PtrTo_int16(this, that [8]*int16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -9987,18 +8832,18 @@ func deriveCompare_79(this, that [8]*int16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_9(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_80 returns:
+// deriveCompare_ArrayOf9_PtrTo_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_80(this, that [9]*int32) int {
+func deriveCompare_ArrayOf9_PtrTo_int32(this, that [9]*int32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10006,18 +8851,18 @@ func deriveCompare_80(this, that [9]*int32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_10(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_81 returns:
+// deriveCompare_ArrayOf10_PtrTo_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_81(this, that [10]*int64) int {
+func deriveCompare_ArrayOf10_PtrTo_int64(this, that [10]*int64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10025,18 +8870,18 @@ func deriveCompare_81(this, that [10]*int64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_11(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_82 returns:
+// deriveCompare_ArrayOf11_PtrTo_int8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_82(this, that [11]*int8) int {
+func deriveCompare_ArrayOf11_PtrTo_int8(this, that [11]*int8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10044,18 +8889,18 @@ func deriveCompare_82(this, that [11]*int8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_12(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int8(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_83 returns:
+// deriveCompare_ArrayOf12_PtrTo_rune returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_83(this, that [12]*rune) int {
+func deriveCompare_ArrayOf12_PtrTo_rune(this, that [12]*rune) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10063,18 +8908,18 @@ func deriveCompare_83(this, that [12]*rune) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_10(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_int32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_84 returns:
+// deriveCompare_ArrayOf13_PtrTo_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_84(this, that [13]*string) int {
+func deriveCompare_ArrayOf13_PtrTo_string(this, that [13]*string) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10082,18 +8927,18 @@ func deriveCompare_84(this, that [13]*string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_13(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_string(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_85 returns:
+// deriveCompare_ArrayOf14_PtrTo_uint returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_85(this, that [14]*uint) int {
+func deriveCompare_ArrayOf14_PtrTo_uint(this, that [14]*uint) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10101,18 +8946,18 @@ func deriveCompare_85(this, that [14]*uint) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_14(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_86 returns:
+// deriveCompare_ArrayOf15_PtrTo_uint16 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_86(this, that [15]*uint16) int {
+func deriveCompare_ArrayOf15_PtrTo_uint16(this, that [15]*uint16) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10120,18 +8965,18 @@ func deriveCompare_86(this, that [15]*uint16) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_15(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint16(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_87 returns:
+// deriveCompare_ArrayOf16_PtrTo_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_87(this, that [16]*uint32) int {
+func deriveCompare_ArrayOf16_PtrTo_uint32(this, that [16]*uint32) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10139,18 +8984,18 @@ func deriveCompare_87(this, that [16]*uint32) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_16(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint32(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_88 returns:
+// deriveCompare_ArrayOf17_PtrTo_uint64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_88(this, that [17]*uint64) int {
+func deriveCompare_ArrayOf17_PtrTo_uint64(this, that [17]*uint64) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10158,18 +9003,18 @@ func deriveCompare_88(this, that [17]*uint64) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_17(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uint64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_89 returns:
+// deriveCompare_ArrayOf18_PtrTo_uint8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_89(this, that [18]*uint8) int {
+func deriveCompare_ArrayOf18_PtrTo_uint8(this, that [18]*uint8) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10177,18 +9022,18 @@ func deriveCompare_89(this, that [18]*uint8) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_3(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_byte(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_90 returns:
+// deriveCompare_ArrayOf19_PtrTo_uintptr returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_90(this, that [19]*uintptr) int {
+func deriveCompare_ArrayOf19_PtrTo_uintptr(this, that [19]*uintptr) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10196,18 +9041,18 @@ func deriveCompare_90(this, that [19]*uintptr) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_18(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_uintptr(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_91 returns:
+// deriveCompare_ArrayOf10_PtrTo_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_91(this, that [10]*bool) int {
+func deriveCompare_ArrayOf10_PtrTo_bool(this, that [10]*bool) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -10215,18 +9060,18 @@ func deriveCompare_91(this, that [10]*bool) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_2(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_bool(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_92 returns:
+// deriveCompare_MapOf_string_To_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_92(this, that map[string]uint32) int {
+func deriveCompare_MapOf_string_To_uint32(this, that map[string]uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10242,14 +9087,14 @@ func deriveCompare_92(this, that map[string]uint32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_(this))
-	thatkeys := deriveSortedStrings(deriveKeys_(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_uint32(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_uint32(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_uin(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_uint32(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10261,11 +9106,11 @@ func deriveCompare_92(this, that map[string]uint32) int {
 	return 0
 }
 
-// deriveCompare_93 returns:
+// deriveCompare_MapOf_uint8_To_int64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_93(this, that map[uint8]int64) int {
+func deriveCompare_MapOf_uint8_To_int64(this, that map[uint8]int64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10281,18 +9126,18 @@ func deriveCompare_93(this, that map[uint8]int64) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort(deriveKeys_1(this))
-	thatkeys := deriveSort(deriveKeys_1(that))
+	thiskeys := deriveSort_SliceOf_uint8(deriveKeys_MapOf_uint8_To_int64(this))
+	thatkeys := deriveSort_SliceOf_uint8(deriveKeys_MapOf_uint8_To_int64(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_int6(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_int64(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_byte(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -10300,11 +9145,11 @@ func deriveCompare_93(this, that map[uint8]int64) int {
 	return 0
 }
 
-// deriveCompare_94 returns:
+// deriveCompare_MapOf_bool_To_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_94(this, that map[bool]string) int {
+func deriveCompare_MapOf_bool_To_string(this, that map[bool]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10320,8 +9165,8 @@ func deriveCompare_94(this, that map[bool]string) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_(deriveKeys_2(this))
-	thatkeys := deriveSort_(deriveKeys_2(that))
+	thiskeys := deriveSort_SliceOf_bool(deriveKeys_MapOf_bool_To_string(this))
+	thatkeys := deriveSort_SliceOf_bool(deriveKeys_MapOf_bool_To_string(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10331,7 +9176,7 @@ func deriveCompare_94(this, that map[bool]string) int {
 				return c
 			}
 		} else {
-			if c := deriveCompare(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_bool(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -10339,11 +9184,11 @@ func deriveCompare_94(this, that map[bool]string) int {
 	return 0
 }
 
-// deriveCompare_95 returns:
+// deriveCompare_MapOf_string_To_bool returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_95(this, that map[string]bool) int {
+func deriveCompare_MapOf_string_To_bool(this, that map[string]bool) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10359,14 +9204,14 @@ func deriveCompare_95(this, that map[string]bool) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_3(this))
-	thatkeys := deriveSortedStrings(deriveKeys_3(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_bool(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_bool(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_bool(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10378,11 +9223,11 @@ func deriveCompare_95(this, that map[string]bool) int {
 	return 0
 }
 
-// deriveCompare_96 returns:
+// deriveCompare_MapOf_complex128_To_complex64 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_96(this, that map[complex128]complex64) int {
+func deriveCompare_MapOf_complex128_To_complex64(this, that map[complex128]complex64) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10398,8 +9243,8 @@ func deriveCompare_96(this, that map[complex128]complex64) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_1(deriveKeys_4(this))
-	thatkeys := deriveSort_1(deriveKeys_4(that))
+	thiskeys := deriveSort_SliceOf_complex128(deriveKeys_MapOf_complex128_To_complex64(this))
+	thatkeys := deriveSort_SliceOf_complex128(deriveKeys_MapOf_complex128_To_complex64(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10417,11 +9262,11 @@ func deriveCompare_96(this, that map[complex128]complex64) int {
 	return 0
 }
 
-// deriveCompare_97 returns:
+// deriveCompare_MapOf_float64_To_uint32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_97(this, that map[float64]uint32) int {
+func deriveCompare_MapOf_float64_To_uint32(this, that map[float64]uint32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10437,18 +9282,18 @@ func deriveCompare_97(this, that map[float64]uint32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_2(deriveKeys_5(this))
-	thatkeys := deriveSort_2(deriveKeys_5(that))
+	thiskeys := deriveSort_SliceOf_float64(deriveKeys_MapOf_float64_To_uint32(this))
+	thatkeys := deriveSort_SliceOf_float64(deriveKeys_MapOf_float64_To_uint32(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_uin(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_uint32(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_f(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_float64(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -10456,11 +9301,11 @@ func deriveCompare_97(this, that map[float64]uint32) int {
 	return 0
 }
 
-// deriveCompare_98 returns:
+// deriveCompare_MapOf_uint16_To_uint8 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_98(this, that map[uint16]uint8) int {
+func deriveCompare_MapOf_uint16_To_uint8(this, that map[uint16]uint8) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10476,18 +9321,18 @@ func deriveCompare_98(this, that map[uint16]uint8) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_3(deriveKeys_6(this))
-	thatkeys := deriveSort_3(deriveKeys_6(that))
+	thiskeys := deriveSort_SliceOf_uint16(deriveKeys_MapOf_uint16_To_uint8(this))
+	thatkeys := deriveSort_SliceOf_uint16(deriveKeys_MapOf_uint16_To_uint8(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_byte(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_ui(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_uint16(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -10495,11 +9340,11 @@ func deriveCompare_98(this, that map[uint16]uint8) int {
 	return 0
 }
 
-// deriveCompare_99 returns:
+// deriveCompare_SliceOf_SliceOf_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_99(this, that [][]int) int {
+func deriveCompare_SliceOf_SliceOf_int(this, that [][]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10516,18 +9361,18 @@ func deriveCompare_99(this, that [][]int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_24(this[i], that[i]); c != 0 {
+		if c := deriveCompare_SliceOf_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_100 returns:
+// deriveCompare_SliceOf_SliceOf_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_100(this, that [][]string) int {
+func deriveCompare_SliceOf_SliceOf_string(this, that [][]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10544,18 +9389,18 @@ func deriveCompare_100(this, that [][]string) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_29(this[i], that[i]); c != 0 {
+		if c := deriveCompare_SliceOf_string(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_101 returns:
+// deriveCompare_SliceOf_SliceOf_PtrTo_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_101(this, that [][]*int) int {
+func deriveCompare_SliceOf_SliceOf_PtrTo_int(this, that [][]*int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10572,18 +9417,18 @@ func deriveCompare_101(this, that [][]*int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_41(this[i], that[i]); c != 0 {
+		if c := deriveCompare_SliceOf_PtrTo_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_102 returns:
+// deriveCompare_PtrTo_SliceOf_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_102(this, that *[]int) int {
+func deriveCompare_PtrTo_SliceOf_int(this, that *[]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10593,14 +9438,14 @@ func deriveCompare_102(this, that *[]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_24(*this, *that)
+	return deriveCompare_SliceOf_int(*this, *that)
 }
 
-// deriveCompare_103 returns:
+// deriveCompare_PtrTo_ArrayOf4_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_103(this, that *[4]int) int {
+func deriveCompare_PtrTo_ArrayOf4_int(this, that *[4]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10610,14 +9455,14 @@ func deriveCompare_103(this, that *[4]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_139(*this, *that)
+	return deriveCompare_ArrayOf4_int(*this, *that)
 }
 
-// deriveCompare_104 returns:
+// deriveCompare_PtrTo_MapOf_int_To_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_104(this, that *map[int]int) int {
+func deriveCompare_PtrTo_MapOf_int_To_int(this, that *map[int]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10627,14 +9472,14 @@ func deriveCompare_104(this, that *map[int]int) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_140(*this, *that)
+	return deriveCompare_MapOf_int_To_int(*this, *that)
 }
 
-// deriveCompare_105 returns:
+// deriveCompare_SliceOf_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_105(this, that []Name) int {
+func deriveCompare_SliceOf_Name(this, that []Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10658,11 +9503,11 @@ func deriveCompare_105(this, that []Name) int {
 	return 0
 }
 
-// deriveCompare_106 returns:
+// deriveCompare_SliceOf_PtrTo_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_106(this, that []*Name) int {
+func deriveCompare_SliceOf_PtrTo_Name(this, that []*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10686,11 +9531,11 @@ func deriveCompare_106(this, that []*Name) int {
 	return 0
 }
 
-// deriveCompare_107 returns:
+// deriveCompare_PtrTo_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_107(this, that *StructWithoutMethod) int {
+func deriveCompare_PtrTo_StructWithoutMethod(this, that *StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10706,11 +9551,11 @@ func deriveCompare_107(this, that *StructWithoutMethod) int {
 	return 0
 }
 
-// deriveCompare_108 returns:
+// deriveCompare_SliceOf_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_108(this, that []StructWithoutMethod) int {
+func deriveCompare_SliceOf_StructWithoutMethod(this, that []StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10727,18 +9572,18 @@ func deriveCompare_108(this, that []StructWithoutMethod) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_107(&this[i], &that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_StructWithoutMethod(&this[i], &that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_109 returns:
+// deriveCompare_SliceOf_PtrTo_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_109(this, that []*StructWithoutMethod) int {
+func deriveCompare_SliceOf_PtrTo_StructWithoutMethod(this, that []*StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10755,18 +9600,18 @@ func deriveCompare_109(this, that []*StructWithoutMethod) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_107(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_StructWithoutMethod(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_110 returns:
+// deriveCompare_MapOf_Name_To_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_110(this, that map[Name]string) int {
+func deriveCompare_MapOf_Name_To_string(this, that map[Name]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10782,8 +9627,8 @@ func deriveCompare_110(this, that map[Name]string) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_4(deriveKeys_7(this))
-	thatkeys := deriveSort_4(deriveKeys_7(that))
+	thiskeys := deriveSort_SliceOf_Name(deriveKeys_MapOf_Name_To_string(this))
+	thatkeys := deriveSort_SliceOf_Name(deriveKeys_MapOf_Name_To_string(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10801,11 +9646,11 @@ func deriveCompare_110(this, that map[Name]string) int {
 	return 0
 }
 
-// deriveCompare_111 returns:
+// deriveCompare_MapOf_string_To_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_111(this, that map[string]Name) int {
+func deriveCompare_MapOf_string_To_Name(this, that map[string]Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10821,8 +9666,8 @@ func deriveCompare_111(this, that map[string]Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_8(this))
-	thatkeys := deriveSortedStrings(deriveKeys_8(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_Name(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_Name(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10840,11 +9685,11 @@ func deriveCompare_111(this, that map[string]Name) int {
 	return 0
 }
 
-// deriveCompare_112 returns:
+// deriveCompare_MapOf_string_To_PtrTo_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_112(this, that map[string]*Name) int {
+func deriveCompare_MapOf_string_To_PtrTo_Name(this, that map[string]*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10860,8 +9705,8 @@ func deriveCompare_112(this, that map[string]*Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_9(this))
-	thatkeys := deriveSortedStrings(deriveKeys_9(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_Name(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_Name(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -10879,11 +9724,11 @@ func deriveCompare_112(this, that map[string]*Name) int {
 	return 0
 }
 
-// deriveCompare_113 returns:
+// deriveCompare_MapOf_string_To_SliceOf_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_113(this, that map[string][]Name) int {
+func deriveCompare_MapOf_string_To_SliceOf_Name(this, that map[string][]Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10899,14 +9744,14 @@ func deriveCompare_113(this, that map[string][]Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_10(this))
-	thatkeys := deriveSortedStrings(deriveKeys_10(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_Name(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_Name(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_105(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_SliceOf_Name(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10918,11 +9763,11 @@ func deriveCompare_113(this, that map[string][]Name) int {
 	return 0
 }
 
-// deriveCompare_114 returns:
+// deriveCompare_MapOf_string_To_SliceOf_PtrTo_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_114(this, that map[string][]*Name) int {
+func deriveCompare_MapOf_string_To_SliceOf_PtrTo_Name(this, that map[string][]*Name) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10938,14 +9783,14 @@ func deriveCompare_114(this, that map[string][]*Name) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_11(this))
-	thatkeys := deriveSortedStrings(deriveKeys_11(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_Name(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_Name(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_106(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_SliceOf_PtrTo_Name(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10957,11 +9802,11 @@ func deriveCompare_114(this, that map[string][]*Name) int {
 	return 0
 }
 
-// deriveCompare_115 returns:
+// deriveCompare_MapOf_string_To_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_115(this, that map[string]StructWithoutMethod) int {
+func deriveCompare_MapOf_string_To_StructWithoutMethod(this, that map[string]StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -10977,14 +9822,14 @@ func deriveCompare_115(this, that map[string]StructWithoutMethod) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_12(this))
-	thatkeys := deriveSortedStrings(deriveKeys_12(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_StructWithoutMethod(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_StructWithoutMethod(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_107(&thisvalue, &thatvalue); c != 0 {
+			if c := deriveCompare_PtrTo_StructWithoutMethod(&thisvalue, &thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -10996,11 +9841,11 @@ func deriveCompare_115(this, that map[string]StructWithoutMethod) int {
 	return 0
 }
 
-// deriveCompare_116 returns:
+// deriveCompare_MapOf_StructWithoutMethod_To_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_116(this, that map[StructWithoutMethod]string) int {
+func deriveCompare_MapOf_StructWithoutMethod_To_string(this, that map[StructWithoutMethod]string) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11016,8 +9861,8 @@ func deriveCompare_116(this, that map[StructWithoutMethod]string) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_5(deriveKeys_13(this))
-	thatkeys := deriveSort_5(deriveKeys_13(that))
+	thiskeys := deriveSort_SliceOf_StructWithoutMethod(deriveKeys_MapOf_StructWithoutMethod_To_string(this))
+	thatkeys := deriveSort_SliceOf_StructWithoutMethod(deriveKeys_MapOf_StructWithoutMethod_To_string(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -11027,7 +9872,7 @@ func deriveCompare_116(this, that map[StructWithoutMethod]string) int {
 				return c
 			}
 		} else {
-			if c := deriveCompare_107(&thiskey, &thatkey); c != 0 {
+			if c := deriveCompare_PtrTo_StructWithoutMethod(&thiskey, &thatkey); c != 0 {
 				return c
 			}
 		}
@@ -11035,11 +9880,11 @@ func deriveCompare_116(this, that map[StructWithoutMethod]string) int {
 	return 0
 }
 
-// deriveCompare_117 returns:
+// deriveCompare_MapOf_string_To_PtrTo_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_117(this, that map[string]*StructWithoutMethod) int {
+func deriveCompare_MapOf_string_To_PtrTo_StructWithoutMethod(this, that map[string]*StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11055,14 +9900,14 @@ func deriveCompare_117(this, that map[string]*StructWithoutMethod) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_14(this))
-	thatkeys := deriveSortedStrings(deriveKeys_14(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_StructWithoutMethod(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_StructWithoutMethod(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_107(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_PtrTo_StructWithoutMethod(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -11074,11 +9919,11 @@ func deriveCompare_117(this, that map[string]*StructWithoutMethod) int {
 	return 0
 }
 
-// deriveCompare_118 returns:
+// deriveCompare_MapOf_string_To_SliceOf_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_118(this, that map[string][]StructWithoutMethod) int {
+func deriveCompare_MapOf_string_To_SliceOf_StructWithoutMethod(this, that map[string][]StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11094,14 +9939,14 @@ func deriveCompare_118(this, that map[string][]StructWithoutMethod) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_15(this))
-	thatkeys := deriveSortedStrings(deriveKeys_15(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_StructWithoutMethod(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_StructWithoutMethod(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_108(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_SliceOf_StructWithoutMethod(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -11113,11 +9958,11 @@ func deriveCompare_118(this, that map[string][]StructWithoutMethod) int {
 	return 0
 }
 
-// deriveCompare_119 returns:
+// deriveCompare_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_119(this, that map[string][]*StructWithoutMethod) int {
+func deriveCompare_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this, that map[string][]*StructWithoutMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11133,14 +9978,14 @@ func deriveCompare_119(this, that map[string][]*StructWithoutMethod) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_16(this))
-	thatkeys := deriveSortedStrings(deriveKeys_16(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_109(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_SliceOf_PtrTo_StructWithoutMethod(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -11152,11 +9997,11 @@ func deriveCompare_119(this, that map[string][]*StructWithoutMethod) int {
 	return 0
 }
 
-// deriveCompare_120 returns:
+// deriveCompare_MapOf_int_To_RecursiveType returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_120(this, that map[int]RecursiveType) int {
+func deriveCompare_MapOf_int_To_RecursiveType(this, that map[int]RecursiveType) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11172,8 +10017,8 @@ func deriveCompare_120(this, that map[int]RecursiveType) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys_17(this))
-	thatkeys := deriveSortedInts(deriveKeys_17(that))
+	thiskeys := deriveSortedInts(deriveKeys_MapOf_int_To_RecursiveType(this))
+	thatkeys := deriveSortedInts(deriveKeys_MapOf_int_To_RecursiveType(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
@@ -11183,7 +10028,7 @@ func deriveCompare_120(this, that map[int]RecursiveType) int {
 				return c
 			}
 		} else {
-			if c := deriveCompare_i(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_int(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -11191,11 +10036,11 @@ func deriveCompare_120(this, that map[int]RecursiveType) int {
 	return 0
 }
 
-// deriveCompare_121 returns:
+// deriveCompare_PtrTo_StructWithoutEqualMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_121(this, that *StructWithoutEqualMethod) int {
+func deriveCompare_PtrTo_StructWithoutEqualMethod(this, that *StructWithoutEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11205,17 +10050,17 @@ func deriveCompare_121(this, that *StructWithoutEqualMethod) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_int6(this.Num, that.Num); c != 0 {
+	if c := deriveCompare_int64(this.Num, that.Num); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompare_122 returns:
+// deriveCompare_PtrTo_extra_StructWithoutEqualMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_122(this, that *extra.StructWithoutEqualMethod) int {
+func deriveCompare_PtrTo_extra_StructWithoutEqualMethod(this, that *extra.StructWithoutEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11225,17 +10070,17 @@ func deriveCompare_122(this, that *extra.StructWithoutEqualMethod) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_int6(this.Number, that.Number); c != 0 {
+	if c := deriveCompare_int64(this.Number, that.Number); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompare_123 returns:
+// deriveCompare_PtrTo_extra_PrivateFieldAndNoEqualMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_123(this, that *extra.PrivateFieldAndNoEqualMethod) int {
+func deriveCompare_PtrTo_extra_PrivateFieldAndNoEqualMethod(this, that *extra.PrivateFieldAndNoEqualMethod) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11247,29 +10092,29 @@ func deriveCompare_123(this, that *extra.PrivateFieldAndNoEqualMethod) int {
 	}
 	thisv := reflect.Indirect(reflect.ValueOf(this))
 	thatv := reflect.Indirect(reflect.ValueOf(that))
-	if c := deriveCompare_int6(*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())), *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_int64(*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())), *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_27(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_SliceOf_int64(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_11(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())), *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_PtrTo_int64(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())), *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_44(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_SliceOf_PtrTo_int64(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))); c != 0 {
 		return c
 	}
-	if c := deriveCompare_122(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr()))); c != 0 {
+	if c := deriveCompare_PtrTo_extra_StructWithoutEqualMethod(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr()))); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveCompare_M returns:
+// deriveCompare_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_M(this, that MyEnum) int {
+func deriveCompare_MyEnum(this, that MyEnum) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -11280,11 +10125,11 @@ func deriveCompare_M(this, that MyEnum) int {
 	return 0
 }
 
-// deriveCompare_124 returns:
+// deriveCompare_PtrTo_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_124(this, that *MyEnum) int {
+func deriveCompare_PtrTo_MyEnum(this, that *MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11294,14 +10139,14 @@ func deriveCompare_124(this, that *MyEnum) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_M(*this, *that)
+	return deriveCompare_MyEnum(*this, *that)
 }
 
-// deriveCompare_125 returns:
+// deriveCompare_SliceOf_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_125(this, that []MyEnum) int {
+func deriveCompare_SliceOf_MyEnum(this, that []MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11318,18 +10163,18 @@ func deriveCompare_125(this, that []MyEnum) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_M(this[i], that[i]); c != 0 {
+		if c := deriveCompare_MyEnum(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_126 returns:
+// deriveCompare_SliceOf_PtrTo_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_126(this, that []*MyEnum) int {
+func deriveCompare_SliceOf_PtrTo_MyEnum(this, that []*MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11346,18 +10191,18 @@ func deriveCompare_126(this, that []*MyEnum) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_124(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_MyEnum(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_127 returns:
+// deriveCompare_MapOf_int32_To_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_127(this, that map[int32]MyEnum) int {
+func deriveCompare_MapOf_int32_To_MyEnum(this, that map[int32]MyEnum) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11373,18 +10218,18 @@ func deriveCompare_127(this, that map[int32]MyEnum) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_6(deriveKeys_18(this))
-	thatkeys := deriveSort_6(deriveKeys_18(that))
+	thiskeys := deriveSort_SliceOf_int32(deriveKeys_MapOf_int32_To_MyEnum(this))
+	thatkeys := deriveSort_SliceOf_int32(deriveKeys_MapOf_int32_To_MyEnum(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_M(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_MyEnum(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_int(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_int32(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -11392,11 +10237,11 @@ func deriveCompare_127(this, that map[int32]MyEnum) int {
 	return 0
 }
 
-// deriveCompare_128 returns:
+// deriveCompare_MapOf_MyEnum_To_int32 returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_128(this, that map[MyEnum]int32) int {
+func deriveCompare_MapOf_MyEnum_To_int32(this, that map[MyEnum]int32) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11412,18 +10257,18 @@ func deriveCompare_128(this, that map[MyEnum]int32) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSort_7(deriveKeys_19(this))
-	thatkeys := deriveSort_7(deriveKeys_19(that))
+	thiskeys := deriveSort_SliceOf_MyEnum(deriveKeys_MapOf_MyEnum_To_int32(this))
+	thatkeys := deriveSort_SliceOf_MyEnum(deriveKeys_MapOf_MyEnum_To_int32(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_int(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_int32(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_M(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_MyEnum(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -11431,11 +10276,11 @@ func deriveCompare_128(this, that map[MyEnum]int32) int {
 	return 0
 }
 
-// deriveCompare_129 returns:
+// deriveCompare_ArrayOf2_MyEnum returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_129(this, that [2]MyEnum) int {
+func deriveCompare_ArrayOf2_MyEnum(this, that [2]MyEnum) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -11443,18 +10288,18 @@ func deriveCompare_129(this, that [2]MyEnum) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_M(this[i], that[i]); c != 0 {
+		if c := deriveCompare_MyEnum(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_130 returns:
+// deriveCompare_PtrTo_MySlice returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_130(this, that *MySlice) int {
+func deriveCompare_PtrTo_MySlice(this, that *MySlice) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11464,14 +10309,14 @@ func deriveCompare_130(this, that *MySlice) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_27(*this, *that)
+	return deriveCompare_SliceOf_int64(*this, *that)
 }
 
-// deriveCompare_131 returns:
+// deriveCompare_SliceOf_MySlice returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_131(this, that []MySlice) int {
+func deriveCompare_SliceOf_MySlice(this, that []MySlice) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11488,18 +10333,18 @@ func deriveCompare_131(this, that []MySlice) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_27(this[i], that[i]); c != 0 {
+		if c := deriveCompare_SliceOf_int64(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_D returns:
+// deriveCompare_time_Duration returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_D(this, that time.Duration) int {
+func deriveCompare_time_Duration(this, that time.Duration) int {
 	if this != that {
 		if this < that {
 			return -1
@@ -11510,11 +10355,11 @@ func deriveCompare_D(this, that time.Duration) int {
 	return 0
 }
 
-// deriveCompare_132 returns:
+// deriveCompare_PtrTo_time_Duration returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_132(this, that *time.Duration) int {
+func deriveCompare_PtrTo_time_Duration(this, that *time.Duration) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11524,14 +10369,14 @@ func deriveCompare_132(this, that *time.Duration) int {
 	if that == nil {
 		return 1
 	}
-	return deriveCompare_D(*this, *that)
+	return deriveCompare_time_Duration(*this, *that)
 }
 
-// deriveCompare_133 returns:
+// deriveCompare_SliceOf_time_Duration returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_133(this, that []time.Duration) int {
+func deriveCompare_SliceOf_time_Duration(this, that []time.Duration) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11548,18 +10393,18 @@ func deriveCompare_133(this, that []time.Duration) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_D(this[i], that[i]); c != 0 {
+		if c := deriveCompare_time_Duration(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_134 returns:
+// deriveCompare_SliceOf_PtrTo_time_Duration returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_134(this, that []*time.Duration) int {
+func deriveCompare_SliceOf_PtrTo_time_Duration(this, that []*time.Duration) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11576,18 +10421,18 @@ func deriveCompare_134(this, that []*time.Duration) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_132(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_time_Duration(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_135 returns:
+// deriveCompare_MapOf_int_To_time_Duration returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_135(this, that map[int]time.Duration) int {
+func deriveCompare_MapOf_int_To_time_Duration(this, that map[int]time.Duration) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11603,18 +10448,18 @@ func deriveCompare_135(this, that map[int]time.Duration) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys_20(this))
-	thatkeys := deriveSortedInts(deriveKeys_20(that))
+	thiskeys := deriveSortedInts(deriveKeys_MapOf_int_To_time_Duration(this))
+	thatkeys := deriveSortedInts(deriveKeys_MapOf_int_To_time_Duration(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_D(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_time_Duration(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_i(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_int(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -11622,11 +10467,11 @@ func deriveCompare_135(this, that map[int]time.Duration) int {
 	return 0
 }
 
-// deriveCompare_136 returns:
+// deriveCompare_MapOf_string_To_SliceOf_PtrTo_pickle_Rick returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_136(this, that map[string][]*pickle.Rick) int {
+func deriveCompare_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this, that map[string][]*pickle.Rick) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11642,14 +10487,14 @@ func deriveCompare_136(this, that map[string][]*pickle.Rick) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedStrings(deriveKeys_21(this))
-	thatkeys := deriveSortedStrings(deriveKeys_21(that))
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_141(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_SliceOf_PtrTo_pickle_Rick(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
@@ -11661,11 +10506,31 @@ func deriveCompare_136(this, that map[string][]*pickle.Rick) int {
 	return 0
 }
 
-// deriveCompare_137 returns:
+// deriveCompare_PtrTo_privateStruct returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_privateStruct(this, that *privateStruct) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_PtrTo_int(this.ptrfield, that.ptrfield); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_PtrTo_Tree_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_137(this, that *privateStruct) int {
+func deriveCompare_PtrTo_Tree_Name(this, that *Tree[Name]) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11675,17 +10540,87 @@ func deriveCompare_137(this, that *privateStruct) int {
 	if that == nil {
 		return 1
 	}
-	if c := deriveCompare_8(this.ptrfield, that.ptrfield); c != 0 {
+	if c := this.Value.Compare(&that.Value); c != 0 {
 		return c
 	}
+	if c := deriveCompare_SliceOf_PtrTo_Tree_Name(this.Children, that.Children); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_SliceOf_Pair_string_PtrTo_Name returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_SliceOf_Pair_string_PtrTo_Name(this, that []Pair[string, *Name]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_PtrTo_Pair_string_PtrTo_Name(&this[i], &that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_MapOf_string_To_Pair_int64_SliceOf_string returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_MapOf_string_To_Pair_int64_SliceOf_string(this, that map[string]Pair[int64, []string]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	thiskeys := deriveSortedStrings(deriveKeys_MapOf_string_To_Pair_int64_SliceOf_string(this))
+	thatkeys := deriveSortedStrings(deriveKeys_MapOf_string_To_Pair_int64_SliceOf_string(that))
+	for i, thiskey := range thiskeys {
+		thatkey := thatkeys[i]
+		if thiskey == thatkey {
+			thisvalue := this[thiskey]
+			thatvalue := that[thatkey]
+			if c := deriveCompare_PtrTo_Pair_int64_SliceOf_string(&thisvalue, &thatvalue); c != 0 {
+				return c
+			}
+		} else {
+			if c := strings.Compare(thiskey, thatkey); c != 0 {
+				return c
+			}
+		}
+	}
 	return 0
 }
 
-// deriveCompare_138 returns:
+// deriveCompare_PtrTo_extra_Box_Pair_string_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_138(this, that *StructWithStringAlias) int {
+func deriveCompare_PtrTo_extra_Box_Pair_string_int(this, that *extra.Box[Pair[string, int]]) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -11695,38 +10630,94 @@ func deriveCompare_138(this, that *StructWithStringAlias) int {
 	if that == nil {
 		return 1
 	}
-	if c := strings.Compare(string(this.Field), string(that.Field)); c != 0 {
+	thisv := reflect.Indirect(reflect.ValueOf(this))
+	thatv := reflect.Indirect(reflect.ValueOf(that))
+	if c := deriveCompare_PtrTo_Pair_string_int(&this.Contents, &that.Contents); c != 0 {
+		return c
+	}
+	if c := strings.Compare(*(*string)(unsafe.Pointer(thisv.FieldByName("label").UnsafeAddr())), *(*string)(unsafe.Pointer(thatv.FieldByName("label").UnsafeAddr()))); c != 0 {
 		return c
 	}
 	return 0
 }
 
-// deriveTuple returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple(v0 int, v1 error) func() (int, error) {
-	return func() (int, error) {
-		return v0, v1
+// deriveCompare_PtrTo_CacheEntry returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_CacheEntry(this, that *CacheEntry) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	// memo is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	if c := deriveCompare_int(this.Value, that.Value); c != 0 {
+		return c
+	}
+	if c := strings.Compare(this.Token, that.Token); c != 0 {
+		return c
 	}
+	return 0
 }
 
-// deriveTuple_ returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple_(v0 int, v1 string, v2 error) func() (int, string, error) {
-	return func() (int, string, error) {
-		return v0, v1, v2
+// deriveCompare_SliceOf_PtrTo_Tree_int returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_SliceOf_PtrTo_Tree_int(this, that []*Tree[int]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompareTreeOfInt(this[i], that[i]); c != 0 {
+			return c
+		}
 	}
+	return 0
 }
 
-// deriveTuple_i returns a function, which returns the input values.
-// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
-func deriveTuple_i(v0 int64, v1 error) func() (int64, error) {
-	return func() (int64, error) {
-		return v0, v1
+// deriveCompare_PtrTo_Pair_string_int64 returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_Pair_string_int64(this, that *Pair[string, int64]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := strings.Compare(this.Key, that.Key); c != 0 {
+		return c
 	}
+	if c := deriveCompare_int64(this.Value, that.Value); c != 0 {
+		return c
+	}
+	return 0
 }
 
-// deriveEqual_ returns whether this and that are equal.
-func deriveEqual_(this, that []bool) bool {
+// deriveEqual_SliceOf_bool returns whether this and that are equal.
+func deriveEqual_SliceOf_bool(this, that []bool) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11741,8 +10732,8 @@ func deriveEqual_(this, that []bool) bool {
 	return true
 }
 
-// deriveEqual_1 returns whether this and that are equal.
-func deriveEqual_1(this, that []complex128) bool {
+// deriveEqual_SliceOf_complex128 returns whether this and that are equal.
+func deriveEqual_SliceOf_complex128(this, that []complex128) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11757,8 +10748,8 @@ func deriveEqual_1(this, that []complex128) bool {
 	return true
 }
 
-// deriveEqual_2 returns whether this and that are equal.
-func deriveEqual_2(this, that []complex64) bool {
+// deriveEqual_SliceOf_complex64 returns whether this and that are equal.
+func deriveEqual_SliceOf_complex64(this, that []complex64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11773,8 +10764,8 @@ func deriveEqual_2(this, that []complex64) bool {
 	return true
 }
 
-// deriveEqual_3 returns whether this and that are equal.
-func deriveEqual_3(this, that []float64) bool {
+// deriveEqual_SliceOf_float64 returns whether this and that are equal.
+func deriveEqual_SliceOf_float64(this, that []float64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11789,8 +10780,8 @@ func deriveEqual_3(this, that []float64) bool {
 	return true
 }
 
-// deriveEqual_4 returns whether this and that are equal.
-func deriveEqual_4(this, that []float32) bool {
+// deriveEqual_SliceOf_float32 returns whether this and that are equal.
+func deriveEqual_SliceOf_float32(this, that []float32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11805,8 +10796,8 @@ func deriveEqual_4(this, that []float32) bool {
 	return true
 }
 
-// deriveEqual_5 returns whether this and that are equal.
-func deriveEqual_5(this, that []int16) bool {
+// deriveEqual_SliceOf_int16 returns whether this and that are equal.
+func deriveEqual_SliceOf_int16(this, that []int16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11821,8 +10812,8 @@ func deriveEqual_5(this, that []int16) bool {
 	return true
 }
 
-// deriveEqual_6 returns whether this and that are equal.
-func deriveEqual_6(this, that []int32) bool {
+// deriveEqual_SliceOf_int32 returns whether this and that are equal.
+func deriveEqual_SliceOf_int32(this, that []int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11837,8 +10828,8 @@ func deriveEqual_6(this, that []int32) bool {
 	return true
 }
 
-// deriveEqual_7 returns whether this and that are equal.
-func deriveEqual_7(this, that []int64) bool {
+// deriveEqual_SliceOf_int64 returns whether this and that are equal.
+func deriveEqual_SliceOf_int64(this, that []int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11853,8 +10844,8 @@ func deriveEqual_7(this, that []int64) bool {
 	return true
 }
 
-// deriveEqual_8 returns whether this and that are equal.
-func deriveEqual_8(this, that []int8) bool {
+// deriveEqual_SliceOf_int8 returns whether this and that are equal.
+func deriveEqual_SliceOf_int8(this, that []int8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11869,8 +10860,8 @@ func deriveEqual_8(this, that []int8) bool {
 	return true
 }
 
-// deriveEqual_9 returns whether this and that are equal.
-func deriveEqual_9(this, that []string) bool {
+// deriveEqual_SliceOf_string returns whether this and that are equal.
+func deriveEqual_SliceOf_string(this, that []string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11885,8 +10876,8 @@ func deriveEqual_9(this, that []string) bool {
 	return true
 }
 
-// deriveEqual_10 returns whether this and that are equal.
-func deriveEqual_10(this, that []uint) bool {
+// deriveEqual_SliceOf_uint returns whether this and that are equal.
+func deriveEqual_SliceOf_uint(this, that []uint) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11901,8 +10892,8 @@ func deriveEqual_10(this, that []uint) bool {
 	return true
 }
 
-// deriveEqual_11 returns whether this and that are equal.
-func deriveEqual_11(this, that []uint16) bool {
+// deriveEqual_SliceOf_uint16 returns whether this and that are equal.
+func deriveEqual_SliceOf_uint16(this, that []uint16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11917,8 +10908,8 @@ func deriveEqual_11(this, that []uint16) bool {
 	return true
 }
 
-// deriveEqual_12 returns whether this and that are equal.
-func deriveEqual_12(this, that []uint32) bool {
+// deriveEqual_SliceOf_uint32 returns whether this and that are equal.
+func deriveEqual_SliceOf_uint32(this, that []uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11933,8 +10924,8 @@ func deriveEqual_12(this, that []uint32) bool {
 	return true
 }
 
-// deriveEqual_13 returns whether this and that are equal.
-func deriveEqual_13(this, that []uint64) bool {
+// deriveEqual_SliceOf_uint64 returns whether this and that are equal.
+func deriveEqual_SliceOf_uint64(this, that []uint64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11949,8 +10940,8 @@ func deriveEqual_13(this, that []uint64) bool {
 	return true
 }
 
-// deriveEqual_14 returns whether this and that are equal.
-func deriveEqual_14(this, that []uintptr) bool {
+// deriveEqual_SliceOf_uintptr returns whether this and that are equal.
+func deriveEqual_SliceOf_uintptr(this, that []uintptr) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11965,8 +10956,8 @@ func deriveEqual_14(this, that []uintptr) bool {
 	return true
 }
 
-// deriveEqual_15 returns whether this and that are equal.
-func deriveEqual_15(this, that []*bool) bool {
+// deriveEqual_SliceOf_PtrTo_bool returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_bool(this, that []*bool) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11981,8 +10972,8 @@ func deriveEqual_15(this, that []*bool) bool {
 	return true
 }
 
-// deriveEqual_16 returns whether this and that are equal.
-func deriveEqual_16(this, that []*byte) bool {
+// deriveEqual_SliceOf_PtrTo_byte returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_byte(this, that []*byte) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -11997,8 +10988,8 @@ func deriveEqual_16(this, that []*byte) bool {
 	return true
 }
 
-// deriveEqual_17 returns whether this and that are equal.
-func deriveEqual_17(this, that []*complex128) bool {
+// deriveEqual_SliceOf_PtrTo_complex128 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_complex128(this, that []*complex128) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12013,8 +11004,8 @@ func deriveEqual_17(this, that []*complex128) bool {
 	return true
 }
 
-// deriveEqual_18 returns whether this and that are equal.
-func deriveEqual_18(this, that []*complex64) bool {
+// deriveEqual_SliceOf_PtrTo_complex64 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_complex64(this, that []*complex64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12029,8 +11020,8 @@ func deriveEqual_18(this, that []*complex64) bool {
 	return true
 }
 
-// deriveEqual_19 returns whether this and that are equal.
-func deriveEqual_19(this, that []*float64) bool {
+// deriveEqual_SliceOf_PtrTo_float64 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_float64(this, that []*float64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12045,8 +11036,8 @@ func deriveEqual_19(this, that []*float64) bool {
 	return true
 }
 
-// deriveEqual_20 returns whether this and that are equal.
-func deriveEqual_20(this, that []*float32) bool {
+// deriveEqual_SliceOf_PtrTo_float32 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_float32(this, that []*float32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12061,8 +11052,8 @@ func deriveEqual_20(this, that []*float32) bool {
 	return true
 }
 
-// deriveEqual_21 returns whether this and that are equal.
-func deriveEqual_21(this, that []*int) bool {
+// deriveEqual_SliceOf_PtrTo_int returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_int(this, that []*int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12077,8 +11068,8 @@ func deriveEqual_21(this, that []*int) bool {
 	return true
 }
 
-// deriveEqual_22 returns whether this and that are equal.
-func deriveEqual_22(this, that []*int16) bool {
+// deriveEqual_SliceOf_PtrTo_int16 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_int16(this, that []*int16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12093,8 +11084,8 @@ func deriveEqual_22(this, that []*int16) bool {
 	return true
 }
 
-// deriveEqual_23 returns whether this and that are equal.
-func deriveEqual_23(this, that []*int32) bool {
+// deriveEqual_SliceOf_PtrTo_int32 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_int32(this, that []*int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12109,8 +11100,8 @@ func deriveEqual_23(this, that []*int32) bool {
 	return true
 }
 
-// deriveEqual_24 returns whether this and that are equal.
-func deriveEqual_24(this, that []*int64) bool {
+// deriveEqual_SliceOf_PtrTo_int64 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_int64(this, that []*int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12125,8 +11116,8 @@ func deriveEqual_24(this, that []*int64) bool {
 	return true
 }
 
-// deriveEqual_25 returns whether this and that are equal.
-func deriveEqual_25(this, that []*int8) bool {
+// deriveEqual_SliceOf_PtrTo_int8 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_int8(this, that []*int8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12141,8 +11132,8 @@ func deriveEqual_25(this, that []*int8) bool {
 	return true
 }
 
-// deriveEqual_26 returns whether this and that are equal.
-func deriveEqual_26(this, that []*string) bool {
+// deriveEqual_SliceOf_PtrTo_string returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_string(this, that []*string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12157,8 +11148,8 @@ func deriveEqual_26(this, that []*string) bool {
 	return true
 }
 
-// deriveEqual_27 returns whether this and that are equal.
-func deriveEqual_27(this, that []*uint) bool {
+// deriveEqual_SliceOf_PtrTo_uint returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_uint(this, that []*uint) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12173,8 +11164,8 @@ func deriveEqual_27(this, that []*uint) bool {
 	return true
 }
 
-// deriveEqual_28 returns whether this and that are equal.
-func deriveEqual_28(this, that []*uint16) bool {
+// deriveEqual_SliceOf_PtrTo_uint16 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_uint16(this, that []*uint16) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12189,8 +11180,8 @@ func deriveEqual_28(this, that []*uint16) bool {
 	return true
 }
 
-// deriveEqual_29 returns whether this and that are equal.
-func deriveEqual_29(this, that []*uint32) bool {
+// deriveEqual_SliceOf_PtrTo_uint32 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_uint32(this, that []*uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12205,8 +11196,8 @@ func deriveEqual_29(this, that []*uint32) bool {
 	return true
 }
 
-// deriveEqual_30 returns whether this and that are equal.
-func deriveEqual_30(this, that []*uint64) bool {
+// deriveEqual_SliceOf_PtrTo_uint64 returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_uint64(this, that []*uint64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12221,8 +11212,8 @@ func deriveEqual_30(this, that []*uint64) bool {
 	return true
 }
 
-// deriveEqual_31 returns whether this and that are equal.
-func deriveEqual_31(this, that []*uintptr) bool {
+// deriveEqual_SliceOf_PtrTo_uintptr returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_uintptr(this, that []*uintptr) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12237,8 +11228,8 @@ func deriveEqual_31(this, that []*uintptr) bool {
 	return true
 }
 
-// deriveEqual_32 returns whether this and that are equal.
-func deriveEqual_32(this, that [1]*bool) bool {
+// deriveEqual_ArrayOf1_PtrTo_bool returns whether this and that are equal.
+func deriveEqual_ArrayOf1_PtrTo_bool(this, that [1]*bool) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12247,8 +11238,8 @@ func deriveEqual_32(this, that [1]*bool) bool {
 	return true
 }
 
-// deriveEqual_33 returns whether this and that are equal.
-func deriveEqual_33(this, that [2]*byte) bool {
+// deriveEqual_ArrayOf2_PtrTo_byte returns whether this and that are equal.
+func deriveEqual_ArrayOf2_PtrTo_byte(this, that [2]*byte) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12257,8 +11248,8 @@ func deriveEqual_33(this, that [2]*byte) bool {
 	return true
 }
 
-// deriveEqual_34 returns whether this and that are equal.
-func deriveEqual_34(this, that [3]*complex128) bool {
+// deriveEqual_ArrayOf3_PtrTo_complex128 returns whether this and that are equal.
+func deriveEqual_ArrayOf3_PtrTo_complex128(this, that [3]*complex128) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12267,8 +11258,8 @@ func deriveEqual_34(this, that [3]*complex128) bool {
 	return true
 }
 
-// deriveEqual_35 returns whether this and that are equal.
-func deriveEqual_35(this, that [4]*complex64) bool {
+// deriveEqual_ArrayOf4_PtrTo_complex64 returns whether this and that are equal.
+func deriveEqual_ArrayOf4_PtrTo_complex64(this, that [4]*complex64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12277,8 +11268,8 @@ func deriveEqual_35(this, that [4]*complex64) bool {
 	return true
 }
 
-// deriveEqual_36 returns whether this and that are equal.
-func deriveEqual_36(this, that [5]*float64) bool {
+// deriveEqual_ArrayOf5_PtrTo_float64 returns whether this and that are equal.
+func deriveEqual_ArrayOf5_PtrTo_float64(this, that [5]*float64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12287,8 +11278,8 @@ func deriveEqual_36(this, that [5]*float64) bool {
 	return true
 }
 
-// deriveEqual_37 returns whether this and that are equal.
-func deriveEqual_37(this, that [6]*float32) bool {
+// deriveEqual_ArrayOf6_PtrTo_float32 returns whether this and that are equal.
+func deriveEqual_ArrayOf6_PtrTo_float32(this, that [6]*float32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12297,8 +11288,8 @@ func deriveEqual_37(this, that [6]*float32) bool {
 	return true
 }
 
-// deriveEqual_38 returns whether this and that are equal.
-func deriveEqual_38(this, that [7]*int) bool {
+// deriveEqual_ArrayOf7_PtrTo_int returns whether this and that are equal.
+func deriveEqual_ArrayOf7_PtrTo_int(this, that [7]*int) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12307,8 +11298,8 @@ func deriveEqual_38(this, that [7]*int) bool {
 	return true
 }
 
-// deriveEqual_39 returns whether this and that are equal.
-func deriveEqual_39(this, that [8]*int16) bool {
+// deriveEqual_ArrayOf8_PtrTo_int16 returns whether this and that are equal.
+func deriveEqual_ArrayOf8_PtrTo_int16(this, that [8]*int16) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12317,8 +11308,8 @@ func deriveEqual_39(this, that [8]*int16) bool {
 	return true
 }
 
-// deriveEqual_40 returns whether this and that are equal.
-func deriveEqual_40(this, that [9]*int32) bool {
+// deriveEqual_ArrayOf9_PtrTo_int32 returns whether this and that are equal.
+func deriveEqual_ArrayOf9_PtrTo_int32(this, that [9]*int32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12327,8 +11318,8 @@ func deriveEqual_40(this, that [9]*int32) bool {
 	return true
 }
 
-// deriveEqual_41 returns whether this and that are equal.
-func deriveEqual_41(this, that [10]*int64) bool {
+// deriveEqual_ArrayOf10_PtrTo_int64 returns whether this and that are equal.
+func deriveEqual_ArrayOf10_PtrTo_int64(this, that [10]*int64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12337,8 +11328,8 @@ func deriveEqual_41(this, that [10]*int64) bool {
 	return true
 }
 
-// deriveEqual_42 returns whether this and that are equal.
-func deriveEqual_42(this, that [11]*int8) bool {
+// deriveEqual_ArrayOf11_PtrTo_int8 returns whether this and that are equal.
+func deriveEqual_ArrayOf11_PtrTo_int8(this, that [11]*int8) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12347,8 +11338,8 @@ func deriveEqual_42(this, that [11]*int8) bool {
 	return true
 }
 
-// deriveEqual_43 returns whether this and that are equal.
-func deriveEqual_43(this, that [12]*rune) bool {
+// deriveEqual_ArrayOf12_PtrTo_rune returns whether this and that are equal.
+func deriveEqual_ArrayOf12_PtrTo_rune(this, that [12]*rune) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12357,8 +11348,8 @@ func deriveEqual_43(this, that [12]*rune) bool {
 	return true
 }
 
-// deriveEqual_44 returns whether this and that are equal.
-func deriveEqual_44(this, that [13]*string) bool {
+// deriveEqual_ArrayOf13_PtrTo_string returns whether this and that are equal.
+func deriveEqual_ArrayOf13_PtrTo_string(this, that [13]*string) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12367,8 +11358,8 @@ func deriveEqual_44(this, that [13]*string) bool {
 	return true
 }
 
-// deriveEqual_45 returns whether this and that are equal.
-func deriveEqual_45(this, that [14]*uint) bool {
+// deriveEqual_ArrayOf14_PtrTo_uint returns whether this and that are equal.
+func deriveEqual_ArrayOf14_PtrTo_uint(this, that [14]*uint) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12377,8 +11368,8 @@ func deriveEqual_45(this, that [14]*uint) bool {
 	return true
 }
 
-// deriveEqual_46 returns whether this and that are equal.
-func deriveEqual_46(this, that [15]*uint16) bool {
+// deriveEqual_ArrayOf15_PtrTo_uint16 returns whether this and that are equal.
+func deriveEqual_ArrayOf15_PtrTo_uint16(this, that [15]*uint16) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12387,8 +11378,8 @@ func deriveEqual_46(this, that [15]*uint16) bool {
 	return true
 }
 
-// deriveEqual_47 returns whether this and that are equal.
-func deriveEqual_47(this, that [16]*uint32) bool {
+// deriveEqual_ArrayOf16_PtrTo_uint32 returns whether this and that are equal.
+func deriveEqual_ArrayOf16_PtrTo_uint32(this, that [16]*uint32) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12397,8 +11388,8 @@ func deriveEqual_47(this, that [16]*uint32) bool {
 	return true
 }
 
-// deriveEqual_48 returns whether this and that are equal.
-func deriveEqual_48(this, that [17]*uint64) bool {
+// deriveEqual_ArrayOf17_PtrTo_uint64 returns whether this and that are equal.
+func deriveEqual_ArrayOf17_PtrTo_uint64(this, that [17]*uint64) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12407,8 +11398,8 @@ func deriveEqual_48(this, that [17]*uint64) bool {
 	return true
 }
 
-// deriveEqual_49 returns whether this and that are equal.
-func deriveEqual_49(this, that [18]*uint8) bool {
+// deriveEqual_ArrayOf18_PtrTo_uint8 returns whether this and that are equal.
+func deriveEqual_ArrayOf18_PtrTo_uint8(this, that [18]*uint8) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12417,8 +11408,8 @@ func deriveEqual_49(this, that [18]*uint8) bool {
 	return true
 }
 
-// deriveEqual_50 returns whether this and that are equal.
-func deriveEqual_50(this, that [19]*uintptr) bool {
+// deriveEqual_ArrayOf19_PtrTo_uintptr returns whether this and that are equal.
+func deriveEqual_ArrayOf19_PtrTo_uintptr(this, that [19]*uintptr) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12427,8 +11418,8 @@ func deriveEqual_50(this, that [19]*uintptr) bool {
 	return true
 }
 
-// deriveEqual_51 returns whether this and that are equal.
-func deriveEqual_51(this, that [10]*bool) bool {
+// deriveEqual_ArrayOf10_PtrTo_bool returns whether this and that are equal.
+func deriveEqual_ArrayOf10_PtrTo_bool(this, that [10]*bool) bool {
 	for i := 0; i < len(this); i++ {
 		if !((this[i] == nil && that[i] == nil) || (this[i] != nil && that[i] != nil && *(this[i]) == *(that[i]))) {
 			return false
@@ -12437,8 +11428,8 @@ func deriveEqual_51(this, that [10]*bool) bool {
 	return true
 }
 
-// deriveEqual_52 returns whether this and that are equal.
-func deriveEqual_52(this, that map[string]uint32) bool {
+// deriveEqual_MapOf_string_To_uint32 returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_uint32(this, that map[string]uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12457,8 +11448,8 @@ func deriveEqual_52(this, that map[string]uint32) bool {
 	return true
 }
 
-// deriveEqual_53 returns whether this and that are equal.
-func deriveEqual_53(this, that map[uint8]int64) bool {
+// deriveEqual_MapOf_uint8_To_int64 returns whether this and that are equal.
+func deriveEqual_MapOf_uint8_To_int64(this, that map[uint8]int64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12477,8 +11468,8 @@ func deriveEqual_53(this, that map[uint8]int64) bool {
 	return true
 }
 
-// deriveEqual_54 returns whether this and that are equal.
-func deriveEqual_54(this, that map[bool]string) bool {
+// deriveEqual_MapOf_bool_To_string returns whether this and that are equal.
+func deriveEqual_MapOf_bool_To_string(this, that map[bool]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12497,8 +11488,8 @@ func deriveEqual_54(this, that map[bool]string) bool {
 	return true
 }
 
-// deriveEqual_55 returns whether this and that are equal.
-func deriveEqual_55(this, that map[string]bool) bool {
+// deriveEqual_MapOf_string_To_bool returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_bool(this, that map[string]bool) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12517,8 +11508,8 @@ func deriveEqual_55(this, that map[string]bool) bool {
 	return true
 }
 
-// deriveEqual_56 returns whether this and that are equal.
-func deriveEqual_56(this, that map[complex128]complex64) bool {
+// deriveEqual_MapOf_complex128_To_complex64 returns whether this and that are equal.
+func deriveEqual_MapOf_complex128_To_complex64(this, that map[complex128]complex64) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12537,8 +11528,8 @@ func deriveEqual_56(this, that map[complex128]complex64) bool {
 	return true
 }
 
-// deriveEqual_57 returns whether this and that are equal.
-func deriveEqual_57(this, that map[float64]uint32) bool {
+// deriveEqual_MapOf_float64_To_uint32 returns whether this and that are equal.
+func deriveEqual_MapOf_float64_To_uint32(this, that map[float64]uint32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12557,8 +11548,8 @@ func deriveEqual_57(this, that map[float64]uint32) bool {
 	return true
 }
 
-// deriveEqual_58 returns whether this and that are equal.
-func deriveEqual_58(this, that map[uint16]uint8) bool {
+// deriveEqual_MapOf_uint16_To_uint8 returns whether this and that are equal.
+func deriveEqual_MapOf_uint16_To_uint8(this, that map[uint16]uint8) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12577,8 +11568,8 @@ func deriveEqual_58(this, that map[uint16]uint8) bool {
 	return true
 }
 
-// deriveEqual_59 returns whether this and that are equal.
-func deriveEqual_59(this, that [][]int) bool {
+// deriveEqual_SliceOf_SliceOf_int returns whether this and that are equal.
+func deriveEqual_SliceOf_SliceOf_int(this, that [][]int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12593,8 +11584,8 @@ func deriveEqual_59(this, that [][]int) bool {
 	return true
 }
 
-// deriveEqual_60 returns whether this and that are equal.
-func deriveEqual_60(this, that [][]string) bool {
+// deriveEqual_SliceOf_SliceOf_string returns whether this and that are equal.
+func deriveEqual_SliceOf_SliceOf_string(this, that [][]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12602,15 +11593,15 @@ func deriveEqual_60(this, that [][]string) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_9(this[i], that[i])) {
+		if !(deriveEqual_SliceOf_string(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_61 returns whether this and that are equal.
-func deriveEqual_61(this, that [][]*int) bool {
+// deriveEqual_SliceOf_SliceOf_PtrTo_int returns whether this and that are equal.
+func deriveEqual_SliceOf_SliceOf_PtrTo_int(this, that [][]*int) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12618,15 +11609,15 @@ func deriveEqual_61(this, that [][]*int) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_21(this[i], that[i])) {
+		if !(deriveEqual_SliceOf_PtrTo_int(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_62 returns whether this and that are equal.
-func deriveEqual_62(this, that []Name) bool {
+// deriveEqual_SliceOf_Name returns whether this and that are equal.
+func deriveEqual_SliceOf_Name(this, that []Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12641,8 +11632,8 @@ func deriveEqual_62(this, that []Name) bool {
 	return true
 }
 
-// deriveEqual_63 returns whether this and that are equal.
-func deriveEqual_63(this, that []*Name) bool {
+// deriveEqual_SliceOf_PtrTo_Name returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_Name(this, that []*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12657,15 +11648,15 @@ func deriveEqual_63(this, that []*Name) bool {
 	return true
 }
 
-// deriveEqual_64 returns whether this and that are equal.
-func deriveEqual_64(this, that *StructWithoutMethod) bool {
+// deriveEqual_PtrTo_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_PtrTo_StructWithoutMethod(this, that *StructWithoutMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Name == that.Name
 }
 
-// deriveEqual_65 returns whether this and that are equal.
-func deriveEqual_65(this, that []StructWithoutMethod) bool {
+// deriveEqual_SliceOf_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_SliceOf_StructWithoutMethod(this, that []StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12680,8 +11671,8 @@ func deriveEqual_65(this, that []StructWithoutMethod) bool {
 	return true
 }
 
-// deriveEqual_66 returns whether this and that are equal.
-func deriveEqual_66(this, that []*StructWithoutMethod) bool {
+// deriveEqual_SliceOf_PtrTo_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_StructWithoutMethod(this, that []*StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12689,15 +11680,15 @@ func deriveEqual_66(this, that []*StructWithoutMethod) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_64(this[i], that[i])) {
+		if !(deriveEqual_PtrTo_StructWithoutMethod(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_67 returns whether this and that are equal.
-func deriveEqual_67(this, that map[Name]string) bool {
+// deriveEqual_MapOf_Name_To_string returns whether this and that are equal.
+func deriveEqual_MapOf_Name_To_string(this, that map[Name]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12716,8 +11707,8 @@ func deriveEqual_67(this, that map[Name]string) bool {
 	return true
 }
 
-// deriveEqual_68 returns whether this and that are equal.
-func deriveEqual_68(this, that map[string]Name) bool {
+// deriveEqual_MapOf_string_To_Name returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_Name(this, that map[string]Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12736,8 +11727,8 @@ func deriveEqual_68(this, that map[string]Name) bool {
 	return true
 }
 
-// deriveEqual_69 returns whether this and that are equal.
-func deriveEqual_69(this, that map[string]*Name) bool {
+// deriveEqual_MapOf_string_To_PtrTo_Name returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_PtrTo_Name(this, that map[string]*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12756,8 +11747,8 @@ func deriveEqual_69(this, that map[string]*Name) bool {
 	return true
 }
 
-// deriveEqual_70 returns whether this and that are equal.
-func deriveEqual_70(this, that map[string][]Name) bool {
+// deriveEqual_MapOf_string_To_SliceOf_Name returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_SliceOf_Name(this, that map[string][]Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12769,15 +11760,15 @@ func deriveEqual_70(this, that map[string][]Name) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_62(v, thatv)) {
+		if !(deriveEqual_SliceOf_Name(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_71 returns whether this and that are equal.
-func deriveEqual_71(this, that map[string][]*Name) bool {
+// deriveEqual_MapOf_string_To_SliceOf_PtrTo_Name returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_SliceOf_PtrTo_Name(this, that map[string][]*Name) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12789,15 +11780,15 @@ func deriveEqual_71(this, that map[string][]*Name) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_63(v, thatv)) {
+		if !(deriveEqual_SliceOf_PtrTo_Name(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_72 returns whether this and that are equal.
-func deriveEqual_72(this, that map[string]StructWithoutMethod) bool {
+// deriveEqual_MapOf_string_To_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_StructWithoutMethod(this, that map[string]StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12816,8 +11807,8 @@ func deriveEqual_72(this, that map[string]StructWithoutMethod) bool {
 	return true
 }
 
-// deriveEqual_73 returns whether this and that are equal.
-func deriveEqual_73(this, that map[StructWithoutMethod]string) bool {
+// deriveEqual_MapOf_StructWithoutMethod_To_string returns whether this and that are equal.
+func deriveEqual_MapOf_StructWithoutMethod_To_string(this, that map[StructWithoutMethod]string) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12836,8 +11827,8 @@ func deriveEqual_73(this, that map[StructWithoutMethod]string) bool {
 	return true
 }
 
-// deriveEqual_74 returns whether this and that are equal.
-func deriveEqual_74(this, that map[string]*StructWithoutMethod) bool {
+// deriveEqual_MapOf_string_To_PtrTo_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_PtrTo_StructWithoutMethod(this, that map[string]*StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12849,15 +11840,15 @@ func deriveEqual_74(this, that map[string]*StructWithoutMethod) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_64(v, thatv)) {
+		if !(deriveEqual_PtrTo_StructWithoutMethod(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_75 returns whether this and that are equal.
-func deriveEqual_75(this, that map[string][]StructWithoutMethod) bool {
+// deriveEqual_MapOf_string_To_SliceOf_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_SliceOf_StructWithoutMethod(this, that map[string][]StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12869,15 +11860,15 @@ func deriveEqual_75(this, that map[string][]StructWithoutMethod) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_65(v, thatv)) {
+		if !(deriveEqual_SliceOf_StructWithoutMethod(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_76 returns whether this and that are equal.
-func deriveEqual_76(this, that map[string][]*StructWithoutMethod) bool {
+// deriveEqual_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(this, that map[string][]*StructWithoutMethod) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12889,15 +11880,15 @@ func deriveEqual_76(this, that map[string][]*StructWithoutMethod) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_66(v, thatv)) {
+		if !(deriveEqual_SliceOf_PtrTo_StructWithoutMethod(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_77 returns whether this and that are equal.
-func deriveEqual_77(this, that map[int]RecursiveType) bool {
+// deriveEqual_MapOf_int_To_RecursiveType returns whether this and that are equal.
+func deriveEqual_MapOf_int_To_RecursiveType(this, that map[int]RecursiveType) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12916,35 +11907,35 @@ func deriveEqual_77(this, that map[int]RecursiveType) bool {
 	return true
 }
 
-// deriveEqual_78 returns whether this and that are equal.
-func deriveEqual_78(this, that *StructWithoutEqualMethod) bool {
+// deriveEqual_PtrTo_StructWithoutEqualMethod returns whether this and that are equal.
+func deriveEqual_PtrTo_StructWithoutEqualMethod(this, that *StructWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Num == that.Num
 }
 
-// deriveEqual_79 returns whether this and that are equal.
-func deriveEqual_79(this, that *extra.StructWithoutEqualMethod) bool {
+// deriveEqual_PtrTo_extra_StructWithoutEqualMethod returns whether this and that are equal.
+func deriveEqual_PtrTo_extra_StructWithoutEqualMethod(this, that *extra.StructWithoutEqualMethod) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Number == that.Number
 }
 
-// deriveEqual_80 returns whether this and that are equal.
-func deriveEqual_80(this, that *extra.PrivateFieldAndNoEqualMethod) bool {
+// deriveEqual_PtrTo_extra_PrivateFieldAndNoEqualMethod returns whether this and that are equal.
+func deriveEqual_PtrTo_extra_PrivateFieldAndNoEqualMethod(this, that *extra.PrivateFieldAndNoEqualMethod) bool {
 	thisv := reflect.Indirect(reflect.ValueOf(this))
 	thatv := reflect.Indirect(reflect.ValueOf(that))
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			*(*int64)(unsafe.Pointer(thisv.FieldByName("number").UnsafeAddr())) == *(*int64)(unsafe.Pointer(thatv.FieldByName("number").UnsafeAddr())) &&
-			deriveEqual_7(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))) &&
+			deriveEqual_SliceOf_int64(*(*[]int64)(unsafe.Pointer(thisv.FieldByName("numbers").UnsafeAddr())), *(*[]int64)(unsafe.Pointer(thatv.FieldByName("numbers").UnsafeAddr()))) &&
 			((*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) == nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) == nil) || (*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr())) != nil && *(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr())) != nil && *(*(**int64)(unsafe.Pointer(thisv.FieldByName("ptr").UnsafeAddr()))) == *(*(**int64)(unsafe.Pointer(thatv.FieldByName("ptr").UnsafeAddr()))))) &&
-			deriveEqual_24(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))) &&
-			deriveEqual_79(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())))
+			deriveEqual_SliceOf_PtrTo_int64(*(*[]*int64)(unsafe.Pointer(thisv.FieldByName("numberpts").UnsafeAddr())), *(*[]*int64)(unsafe.Pointer(thatv.FieldByName("numberpts").UnsafeAddr()))) &&
+			deriveEqual_PtrTo_extra_StructWithoutEqualMethod(*(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thisv.FieldByName("strct").UnsafeAddr())), *(**extra.StructWithoutEqualMethod)(unsafe.Pointer(thatv.FieldByName("strct").UnsafeAddr())))
 }
 
-// deriveEqual_81 returns whether this and that are equal.
-func deriveEqual_81(this, that *MyEnum) bool {
+// deriveEqual_PtrTo_MyEnum returns whether this and that are equal.
+func deriveEqual_PtrTo_MyEnum(this, that *MyEnum) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -12954,8 +11945,8 @@ func deriveEqual_81(this, that *MyEnum) bool {
 	return false
 }
 
-// deriveEqual_82 returns whether this and that are equal.
-func deriveEqual_82(this, that []MyEnum) bool {
+// deriveEqual_SliceOf_MyEnum returns whether this and that are equal.
+func deriveEqual_SliceOf_MyEnum(this, that []MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12970,8 +11961,8 @@ func deriveEqual_82(this, that []MyEnum) bool {
 	return true
 }
 
-// deriveEqual_83 returns whether this and that are equal.
-func deriveEqual_83(this, that []*MyEnum) bool {
+// deriveEqual_SliceOf_PtrTo_MyEnum returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_MyEnum(this, that []*MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -12979,15 +11970,15 @@ func deriveEqual_83(this, that []*MyEnum) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_81(this[i], that[i])) {
+		if !(deriveEqual_PtrTo_MyEnum(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_84 returns whether this and that are equal.
-func deriveEqual_84(this, that map[int32]MyEnum) bool {
+// deriveEqual_MapOf_int32_To_MyEnum returns whether this and that are equal.
+func deriveEqual_MapOf_int32_To_MyEnum(this, that map[int32]MyEnum) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13006,8 +11997,8 @@ func deriveEqual_84(this, that map[int32]MyEnum) bool {
 	return true
 }
 
-// deriveEqual_85 returns whether this and that are equal.
-func deriveEqual_85(this, that map[MyEnum]int32) bool {
+// deriveEqual_MapOf_MyEnum_To_int32 returns whether this and that are equal.
+func deriveEqual_MapOf_MyEnum_To_int32(this, that map[MyEnum]int32) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13026,8 +12017,8 @@ func deriveEqual_85(this, that map[MyEnum]int32) bool {
 	return true
 }
 
-// deriveEqual_86 returns whether this and that are equal.
-func deriveEqual_86(this, that *MySlice) bool {
+// deriveEqual_PtrTo_MySlice returns whether this and that are equal.
+func deriveEqual_PtrTo_MySlice(this, that *MySlice) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -13048,8 +12039,8 @@ func deriveEqual_86(this, that *MySlice) bool {
 	return false
 }
 
-// deriveEqual_87 returns whether this and that are equal.
-func deriveEqual_87(this, that []MySlice) bool {
+// deriveEqual_SliceOf_MySlice returns whether this and that are equal.
+func deriveEqual_SliceOf_MySlice(this, that []MySlice) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13057,15 +12048,15 @@ func deriveEqual_87(this, that []MySlice) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_7(this[i], that[i])) {
+		if !(deriveEqual_SliceOf_int64(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_88 returns whether this and that are equal.
-func deriveEqual_88(this, that *time.Duration) bool {
+// deriveEqual_PtrTo_time_Duration returns whether this and that are equal.
+func deriveEqual_PtrTo_time_Duration(this, that *time.Duration) bool {
 	if this == nil && that == nil {
 		return true
 	}
@@ -13075,8 +12066,8 @@ func deriveEqual_88(this, that *time.Duration) bool {
 	return false
 }
 
-// deriveEqual_89 returns whether this and that are equal.
-func deriveEqual_89(this, that []time.Duration) bool {
+// deriveEqual_SliceOf_time_Duration returns whether this and that are equal.
+func deriveEqual_SliceOf_time_Duration(this, that []time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13091,8 +12082,8 @@ func deriveEqual_89(this, that []time.Duration) bool {
 	return true
 }
 
-// deriveEqual_90 returns whether this and that are equal.
-func deriveEqual_90(this, that []*time.Duration) bool {
+// deriveEqual_SliceOf_PtrTo_time_Duration returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_time_Duration(this, that []*time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13100,15 +12091,15 @@ func deriveEqual_90(this, that []*time.Duration) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_88(this[i], that[i])) {
+		if !(deriveEqual_PtrTo_time_Duration(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_91 returns whether this and that are equal.
-func deriveEqual_91(this, that map[int]time.Duration) bool {
+// deriveEqual_MapOf_int_To_time_Duration returns whether this and that are equal.
+func deriveEqual_MapOf_int_To_time_Duration(this, that map[int]time.Duration) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13127,8 +12118,8 @@ func deriveEqual_91(this, that map[int]time.Duration) bool {
 	return true
 }
 
-// deriveEqual_92 returns whether this and that are equal.
-func deriveEqual_92(this, that map[string][]*pickle.Rick) bool {
+// deriveEqual_MapOf_string_To_SliceOf_PtrTo_pickle_Rick returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(this, that map[string][]*pickle.Rick) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -13140,113 +12131,214 @@ func deriveEqual_92(this, that map[string][]*pickle.Rick) bool {
 		if !ok {
 			return false
 		}
-		if !(deriveEqual_96(v, thatv)) {
+		if !(deriveEqual_SliceOf_PtrTo_pickle_Rick(v, thatv)) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveEqual_93 returns whether this and that are equal.
-func deriveEqual_93(this, that *privateStruct) bool {
+// deriveEqual_PtrTo_privateStruct returns whether this and that are equal.
+func deriveEqual_PtrTo_privateStruct(this, that *privateStruct) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			((this.ptrfield == nil && that.ptrfield == nil) || (this.ptrfield != nil && that.ptrfield != nil && *(this.ptrfield) == *(that.ptrfield)))
 }
 
-// deriveEqual_94 returns whether this and that are equal.
-func deriveEqual_94(this, that *Visitor) bool {
+// deriveEqual_PtrTo_Tree_Name returns whether this and that are equal.
+func deriveEqual_PtrTo_Tree_Name(this, that *Tree[Name]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value.Equal(&that.Value) &&
+			deriveEqual_SliceOf_PtrTo_Tree_Name(this.Children, that.Children)
+}
+
+// deriveEqual_SliceOf_Pair_string_PtrTo_Name returns whether this and that are equal.
+func deriveEqual_SliceOf_Pair_string_PtrTo_Name(this, that []Pair[string, *Name]) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqual_PtrTo_Pair_string_PtrTo_Name(&this[i], &that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_MapOf_string_To_Pair_int64_SliceOf_string returns whether this and that are equal.
+func deriveEqual_MapOf_string_To_Pair_int64_SliceOf_string(this, that map[string]Pair[int64, []string]) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for k, v := range this {
+		thatv, ok := that[k]
+		if !ok {
+			return false
+		}
+		if !(deriveEqual_PtrTo_Pair_int64_SliceOf_string(&v, &thatv)) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_PtrTo_extra_Box_Pair_string_int returns whether this and that are equal.
+func deriveEqual_PtrTo_extra_Box_Pair_string_int(this, that *extra.Box[Pair[string, int]]) bool {
+	thisv := reflect.Indirect(reflect.ValueOf(this))
+	thatv := reflect.Indirect(reflect.ValueOf(that))
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Contents == that.Contents &&
+			*(*string)(unsafe.Pointer(thisv.FieldByName("label").UnsafeAddr())) == *(*string)(unsafe.Pointer(thatv.FieldByName("label").UnsafeAddr()))
+}
+
+// deriveEqual_PtrTo_CacheEntry returns whether this and that are equal.
+func deriveEqual_PtrTo_CacheEntry(this, that *CacheEntry) bool {
+	// memo is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value == that.Value &&
+			this.Token == that.Token
+}
+
+// deriveEqual_SliceOf_PtrTo_Tree_int returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_Tree_int(this, that []*Tree[int]) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqualTreeOfInt(this[i], that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_PtrTo_Pair_string_int64 returns whether this and that are equal.
+func deriveEqual_PtrTo_Pair_string_int64(this, that *Pair[string, int64]) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
-			((this.UserName == nil && that.UserName == nil) || (this.UserName != nil && that.UserName != nil && *(this.UserName) == *(that.UserName))) &&
-			this.RemoteAddr == that.RemoteAddr
-}
-
-// deriveEqual_95 returns whether this and that are equal.
-func deriveEqual_95(this, that struct {
-	Param0 *BuiltInTypes
-	Param1 int
-}) bool {
-	return this.Param0.Equal(that.Param0) &&
-		this.Param1 == that.Param1
+			this.Key == that.Key &&
+			this.Value == that.Value
+}
+
+// deriveClone_PtrTo_Cache recursively copies the contents of src into dst.
+func deriveClone_PtrTo_Cache(dst, src *Cache) {
+	// mu is skipped, since it is tagged with `derive:"-"`.
+	// Updated is skipped, since it is tagged with `derive:"-"`.
+	dst.Key = src.Key
+	if src.Values == nil {
+		dst.Values = nil
+	} else {
+		if dst.Values != nil {
+			if len(src.Values) > len(dst.Values) {
+				if cap(dst.Values) >= len(src.Values) {
+					dst.Values = (dst.Values)[:len(src.Values)]
+				} else {
+					dst.Values = make([]int, len(src.Values))
+				}
+			} else if len(src.Values) < len(dst.Values) {
+				dst.Values = (dst.Values)[:len(src.Values)]
+			}
+		} else {
+			dst.Values = make([]int, len(src.Values))
+		}
+		copy(dst.Values, src.Values)
+	}
+	dst.Hits = src.Hits
+	{
+		field := new(CacheEntry)
+		deriveClone_PtrTo_CacheEntry(field, &src.Entry)
+		dst.Entry = *field
+	}
 }
 
-// deriveSort sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_uint8 sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort(list []uint8) []uint8 {
+func deriveSort_SliceOf_uint8(list []uint8) []uint8 {
 	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
-// deriveSort_ sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_bool sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_(list []bool) []bool {
-	sort.Slice(list, func(i, j int) bool { return deriveCompare(list[i], list[j]) < 0 })
+func deriveSort_SliceOf_bool(list []bool) []bool {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_bool(list[i], list[j]) < 0 })
 	return list
 }
 
-// deriveSort_1 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_complex128 sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_1(list []complex128) []complex128 {
+func deriveSort_SliceOf_complex128(list []complex128) []complex128 {
 	sort.Slice(list, func(i, j int) bool { return deriveCompareComplex64(list[i], list[j]) < 0 })
 	return list
 }
 
-// deriveSort_2 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_float64 sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_2(list []float64) []float64 {
+func deriveSort_SliceOf_float64(list []float64) []float64 {
 	sort.Float64s(list)
 	return list
 }
 
-// deriveSort_3 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_uint16 sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_3(list []uint16) []uint16 {
+func deriveSort_SliceOf_uint16(list []uint16) []uint16 {
 	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
-// deriveSort_4 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_Name sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_4(list []Name) []Name {
-	sort.Slice(list, func(i, j int) bool { return deriveCompare_N(list[i], list[j]) < 0 })
+func deriveSort_SliceOf_Name(list []Name) []Name {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_Name(list[i], list[j]) < 0 })
 	return list
 }
 
-// deriveSort_5 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_StructWithoutMethod sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_5(list []StructWithoutMethod) []StructWithoutMethod {
-	sort.Slice(list, func(i, j int) bool { return deriveCompare_S(list[i], list[j]) < 0 })
+func deriveSort_SliceOf_StructWithoutMethod(list []StructWithoutMethod) []StructWithoutMethod {
+	sort.Slice(list, func(i, j int) bool { return deriveCompare_StructWithoutMethod(list[i], list[j]) < 0 })
 	return list
 }
 
-// deriveSort_6 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_int32 sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_6(list []int32) []int32 {
+func deriveSort_SliceOf_int32(list []int32) []int32 {
 	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
-// deriveSort_7 sorts the slice inplace and also returns it.
+// deriveSort_SliceOf_MyEnum sorts the slice inplace and also returns it.
 //
 // Deprecated: In favour of generics.
-func deriveSort_7(list []MyEnum) []MyEnum {
+func deriveSort_SliceOf_MyEnum(list []MyEnum) []MyEnum {
 	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
 	return list
 }
 
-// deriveKeys returns the keys of the input map as a slice.
+// deriveKeys_MapOf_int_To_int returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys(m map[int]int) []int {
+func deriveKeys_MapOf_int_To_int(m map[int]int) []int {
 	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13254,10 +12346,10 @@ func deriveKeys(m map[int]int) []int {
 	return keys
 }
 
-// deriveKeys_ returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_uint32 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_(m map[string]uint32) []string {
+func deriveKeys_MapOf_string_To_uint32(m map[string]uint32) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13265,10 +12357,10 @@ func deriveKeys_(m map[string]uint32) []string {
 	return keys
 }
 
-// deriveKeys_1 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_uint8_To_int64 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_1(m map[uint8]int64) []uint8 {
+func deriveKeys_MapOf_uint8_To_int64(m map[uint8]int64) []uint8 {
 	keys := make([]uint8, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13276,10 +12368,10 @@ func deriveKeys_1(m map[uint8]int64) []uint8 {
 	return keys
 }
 
-// deriveKeys_2 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_bool_To_string returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_2(m map[bool]string) []bool {
+func deriveKeys_MapOf_bool_To_string(m map[bool]string) []bool {
 	keys := make([]bool, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13287,10 +12379,10 @@ func deriveKeys_2(m map[bool]string) []bool {
 	return keys
 }
 
-// deriveKeys_3 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_bool returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_3(m map[string]bool) []string {
+func deriveKeys_MapOf_string_To_bool(m map[string]bool) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13298,10 +12390,10 @@ func deriveKeys_3(m map[string]bool) []string {
 	return keys
 }
 
-// deriveKeys_4 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_complex128_To_complex64 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_4(m map[complex128]complex64) []complex128 {
+func deriveKeys_MapOf_complex128_To_complex64(m map[complex128]complex64) []complex128 {
 	keys := make([]complex128, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13309,10 +12401,10 @@ func deriveKeys_4(m map[complex128]complex64) []complex128 {
 	return keys
 }
 
-// deriveKeys_5 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_float64_To_uint32 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_5(m map[float64]uint32) []float64 {
+func deriveKeys_MapOf_float64_To_uint32(m map[float64]uint32) []float64 {
 	keys := make([]float64, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13320,10 +12412,10 @@ func deriveKeys_5(m map[float64]uint32) []float64 {
 	return keys
 }
 
-// deriveKeys_6 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_uint16_To_uint8 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_6(m map[uint16]uint8) []uint16 {
+func deriveKeys_MapOf_uint16_To_uint8(m map[uint16]uint8) []uint16 {
 	keys := make([]uint16, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13331,10 +12423,10 @@ func deriveKeys_6(m map[uint16]uint8) []uint16 {
 	return keys
 }
 
-// deriveKeys_7 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_Name_To_string returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_7(m map[Name]string) []Name {
+func deriveKeys_MapOf_Name_To_string(m map[Name]string) []Name {
 	keys := make([]Name, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13342,10 +12434,10 @@ func deriveKeys_7(m map[Name]string) []Name {
 	return keys
 }
 
-// deriveKeys_8 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_Name returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_8(m map[string]Name) []string {
+func deriveKeys_MapOf_string_To_Name(m map[string]Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13353,10 +12445,10 @@ func deriveKeys_8(m map[string]Name) []string {
 	return keys
 }
 
-// deriveKeys_9 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_PtrTo_Name returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_9(m map[string]*Name) []string {
+func deriveKeys_MapOf_string_To_PtrTo_Name(m map[string]*Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13364,10 +12456,10 @@ func deriveKeys_9(m map[string]*Name) []string {
 	return keys
 }
 
-// deriveKeys_10 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_SliceOf_Name returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_10(m map[string][]Name) []string {
+func deriveKeys_MapOf_string_To_SliceOf_Name(m map[string][]Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13375,10 +12467,10 @@ func deriveKeys_10(m map[string][]Name) []string {
 	return keys
 }
 
-// deriveKeys_11 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_SliceOf_PtrTo_Name returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_11(m map[string][]*Name) []string {
+func deriveKeys_MapOf_string_To_SliceOf_PtrTo_Name(m map[string][]*Name) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13386,10 +12478,10 @@ func deriveKeys_11(m map[string][]*Name) []string {
 	return keys
 }
 
-// deriveKeys_12 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_StructWithoutMethod returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_12(m map[string]StructWithoutMethod) []string {
+func deriveKeys_MapOf_string_To_StructWithoutMethod(m map[string]StructWithoutMethod) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13397,10 +12489,10 @@ func deriveKeys_12(m map[string]StructWithoutMethod) []string {
 	return keys
 }
 
-// deriveKeys_13 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_StructWithoutMethod_To_string returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_13(m map[StructWithoutMethod]string) []StructWithoutMethod {
+func deriveKeys_MapOf_StructWithoutMethod_To_string(m map[StructWithoutMethod]string) []StructWithoutMethod {
 	keys := make([]StructWithoutMethod, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13408,10 +12500,10 @@ func deriveKeys_13(m map[StructWithoutMethod]string) []StructWithoutMethod {
 	return keys
 }
 
-// deriveKeys_14 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_PtrTo_StructWithoutMethod returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_14(m map[string]*StructWithoutMethod) []string {
+func deriveKeys_MapOf_string_To_PtrTo_StructWithoutMethod(m map[string]*StructWithoutMethod) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13419,10 +12511,10 @@ func deriveKeys_14(m map[string]*StructWithoutMethod) []string {
 	return keys
 }
 
-// deriveKeys_15 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_SliceOf_StructWithoutMethod returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_15(m map[string][]StructWithoutMethod) []string {
+func deriveKeys_MapOf_string_To_SliceOf_StructWithoutMethod(m map[string][]StructWithoutMethod) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13430,10 +12522,10 @@ func deriveKeys_15(m map[string][]StructWithoutMethod) []string {
 	return keys
 }
 
-// deriveKeys_16 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_16(m map[string][]*StructWithoutMethod) []string {
+func deriveKeys_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(m map[string][]*StructWithoutMethod) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13441,10 +12533,10 @@ func deriveKeys_16(m map[string][]*StructWithoutMethod) []string {
 	return keys
 }
 
-// deriveKeys_17 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_int_To_RecursiveType returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_17(m map[int]RecursiveType) []int {
+func deriveKeys_MapOf_int_To_RecursiveType(m map[int]RecursiveType) []int {
 	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13452,10 +12544,10 @@ func deriveKeys_17(m map[int]RecursiveType) []int {
 	return keys
 }
 
-// deriveKeys_18 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_int32_To_MyEnum returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_18(m map[int32]MyEnum) []int32 {
+func deriveKeys_MapOf_int32_To_MyEnum(m map[int32]MyEnum) []int32 {
 	keys := make([]int32, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13463,10 +12555,10 @@ func deriveKeys_18(m map[int32]MyEnum) []int32 {
 	return keys
 }
 
-// deriveKeys_19 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_MyEnum_To_int32 returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_19(m map[MyEnum]int32) []MyEnum {
+func deriveKeys_MapOf_MyEnum_To_int32(m map[MyEnum]int32) []MyEnum {
 	keys := make([]MyEnum, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13474,10 +12566,10 @@ func deriveKeys_19(m map[MyEnum]int32) []MyEnum {
 	return keys
 }
 
-// deriveKeys_20 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_int_To_time_Duration returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_20(m map[int]time.Duration) []int {
+func deriveKeys_MapOf_int_To_time_Duration(m map[int]time.Duration) []int {
 	keys := make([]int, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13485,10 +12577,21 @@ func deriveKeys_20(m map[int]time.Duration) []int {
 	return keys
 }
 
-// deriveKeys_21 returns the keys of the input map as a slice.
+// deriveKeys_MapOf_string_To_SliceOf_PtrTo_pickle_Rick returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeys_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(m map[string][]*pickle.Rick) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeys_MapOf_string_To_Pair_int64_SliceOf_string returns the keys of the input map as a slice.
 //
 // Deprecated: In favour of generics.
-func deriveKeys_21(m map[string][]*pickle.Rick) []string {
+func deriveKeys_MapOf_string_To_Pair_int64_SliceOf_string(m map[string]Pair[int64, []string]) []string {
 	keys := make([]string, 0, len(m))
 	for key := range m {
 		keys = append(keys, key)
@@ -13496,16 +12599,16 @@ func deriveKeys_21(m map[string][]*pickle.Rick) []string {
 	return keys
 }
 
-// deriveHash_ returns the hash of the object.
-func deriveHash_(object bool) uint64 {
+// deriveHash_bool returns the hash of the object.
+func deriveHash_bool(object bool) uint64 {
 	if object {
 		return 1
 	}
 	return 0
 }
 
-// deriveHash_s returns the hash of the object.
-func deriveHash_s(object string) uint64 {
+// deriveHash_string returns the hash of the object.
+func deriveHash_string(object string) uint64 {
 	var h uint64
 	for _, c := range object {
 		h = 31*h + uint64(c)
@@ -13513,148 +12616,148 @@ func deriveHash_s(object string) uint64 {
 	return h
 }
 
-// deriveHash_1 returns the hash of the object.
-func deriveHash_1(object *bool) uint64 {
+// deriveHash_PtrTo_bool returns the hash of the object.
+func deriveHash_PtrTo_bool(object *bool) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_(*object)
+	return (31 * 17) + deriveHash_bool(*object)
 }
 
-// deriveHash_2 returns the hash of the object.
-func deriveHash_2(object *byte) uint64 {
+// deriveHash_PtrTo_byte returns the hash of the object.
+func deriveHash_PtrTo_byte(object *byte) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_3 returns the hash of the object.
-func deriveHash_3(object *complex128) uint64 {
+// deriveHash_PtrTo_complex128 returns the hash of the object.
+func deriveHash_PtrTo_complex128(object *complex128) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + (31 * ((31 * 17) + math.Float64bits(real(*object)))) + math.Float64bits(imag(*object))
 }
 
-// deriveHash_4 returns the hash of the object.
-func deriveHash_4(object *complex64) uint64 {
+// deriveHash_PtrTo_complex64 returns the hash of the object.
+func deriveHash_PtrTo_complex64(object *complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + (31 * ((31 * 17) + uint64(math.Float32bits(real(*object))))) + uint64(math.Float32bits(imag(*object)))
 }
 
-// deriveHash_5 returns the hash of the object.
-func deriveHash_5(object *float64) uint64 {
+// deriveHash_PtrTo_float64 returns the hash of the object.
+func deriveHash_PtrTo_float64(object *float64) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + math.Float64bits(*object)
 }
 
-// deriveHash_6 returns the hash of the object.
-func deriveHash_6(object *float32) uint64 {
+// deriveHash_PtrTo_float32 returns the hash of the object.
+func deriveHash_PtrTo_float32(object *float32) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(math.Float32bits(*object))
 }
 
-// deriveHash_7 returns the hash of the object.
-func deriveHash_7(object *int16) uint64 {
+// deriveHash_PtrTo_int16 returns the hash of the object.
+func deriveHash_PtrTo_int16(object *int16) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_8 returns the hash of the object.
-func deriveHash_8(object *int32) uint64 {
+// deriveHash_PtrTo_int32 returns the hash of the object.
+func deriveHash_PtrTo_int32(object *int32) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_9 returns the hash of the object.
-func deriveHash_9(object *int64) uint64 {
+// deriveHash_PtrTo_int64 returns the hash of the object.
+func deriveHash_PtrTo_int64(object *int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_10 returns the hash of the object.
-func deriveHash_10(object *int8) uint64 {
+// deriveHash_PtrTo_int8 returns the hash of the object.
+func deriveHash_PtrTo_int8(object *int8) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_11 returns the hash of the object.
-func deriveHash_11(object *string) uint64 {
+// deriveHash_PtrTo_string returns the hash of the object.
+func deriveHash_PtrTo_string(object *string) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_s(*object)
+	return (31 * 17) + deriveHash_string(*object)
 }
 
-// deriveHash_12 returns the hash of the object.
-func deriveHash_12(object *uint) uint64 {
+// deriveHash_PtrTo_uint returns the hash of the object.
+func deriveHash_PtrTo_uint(object *uint) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_13 returns the hash of the object.
-func deriveHash_13(object *uint16) uint64 {
+// deriveHash_PtrTo_uint16 returns the hash of the object.
+func deriveHash_PtrTo_uint16(object *uint16) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_14 returns the hash of the object.
-func deriveHash_14(object *uint32) uint64 {
+// deriveHash_PtrTo_uint32 returns the hash of the object.
+func deriveHash_PtrTo_uint32(object *uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_15 returns the hash of the object.
-func deriveHash_15(object *uint64) uint64 {
+// deriveHash_PtrTo_uint64 returns the hash of the object.
+func deriveHash_PtrTo_uint64(object *uint64) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + *object
 }
 
-// deriveHash_16 returns the hash of the object.
-func deriveHash_16(object *uintptr) uint64 {
+// deriveHash_PtrTo_uintptr returns the hash of the object.
+func deriveHash_PtrTo_uintptr(object *uintptr) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_17 returns the hash of the object.
-func deriveHash_17(object []bool) uint64 {
+// deriveHash_SliceOf_bool returns the hash of the object.
+func deriveHash_SliceOf_bool(object []bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_(object[i])
+		h = 31*h + deriveHash_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_18 returns the hash of the object.
-func deriveHash_18(object []byte) uint64 {
+// deriveHash_SliceOf_byte returns the hash of the object.
+func deriveHash_SliceOf_byte(object []byte) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13665,8 +12768,8 @@ func deriveHash_18(object []byte) uint64 {
 	return h
 }
 
-// deriveHash_19 returns the hash of the object.
-func deriveHash_19(object []complex128) uint64 {
+// deriveHash_SliceOf_complex128 returns the hash of the object.
+func deriveHash_SliceOf_complex128(object []complex128) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13677,8 +12780,8 @@ func deriveHash_19(object []complex128) uint64 {
 	return h
 }
 
-// deriveHash_20 returns the hash of the object.
-func deriveHash_20(object []complex64) uint64 {
+// deriveHash_SliceOf_complex64 returns the hash of the object.
+func deriveHash_SliceOf_complex64(object []complex64) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13689,8 +12792,8 @@ func deriveHash_20(object []complex64) uint64 {
 	return h
 }
 
-// deriveHash_21 returns the hash of the object.
-func deriveHash_21(object []float64) uint64 {
+// deriveHash_SliceOf_float64 returns the hash of the object.
+func deriveHash_SliceOf_float64(object []float64) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13701,8 +12804,8 @@ func deriveHash_21(object []float64) uint64 {
 	return h
 }
 
-// deriveHash_22 returns the hash of the object.
-func deriveHash_22(object []float32) uint64 {
+// deriveHash_SliceOf_float32 returns the hash of the object.
+func deriveHash_SliceOf_float32(object []float32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13713,8 +12816,8 @@ func deriveHash_22(object []float32) uint64 {
 	return h
 }
 
-// deriveHash_23 returns the hash of the object.
-func deriveHash_23(object []int16) uint64 {
+// deriveHash_SliceOf_int16 returns the hash of the object.
+func deriveHash_SliceOf_int16(object []int16) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13725,8 +12828,8 @@ func deriveHash_23(object []int16) uint64 {
 	return h
 }
 
-// deriveHash_24 returns the hash of the object.
-func deriveHash_24(object []int32) uint64 {
+// deriveHash_SliceOf_int32 returns the hash of the object.
+func deriveHash_SliceOf_int32(object []int32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13737,8 +12840,8 @@ func deriveHash_24(object []int32) uint64 {
 	return h
 }
 
-// deriveHash_25 returns the hash of the object.
-func deriveHash_25(object []int64) uint64 {
+// deriveHash_SliceOf_int64 returns the hash of the object.
+func deriveHash_SliceOf_int64(object []int64) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13749,8 +12852,8 @@ func deriveHash_25(object []int64) uint64 {
 	return h
 }
 
-// deriveHash_26 returns the hash of the object.
-func deriveHash_26(object []int8) uint64 {
+// deriveHash_SliceOf_int8 returns the hash of the object.
+func deriveHash_SliceOf_int8(object []int8) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13761,20 +12864,20 @@ func deriveHash_26(object []int8) uint64 {
 	return h
 }
 
-// deriveHash_27 returns the hash of the object.
-func deriveHash_27(object []string) uint64 {
+// deriveHash_SliceOf_string returns the hash of the object.
+func deriveHash_SliceOf_string(object []string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_s(object[i])
+		h = 31*h + deriveHash_string(object[i])
 	}
 	return h
 }
 
-// deriveHash_28 returns the hash of the object.
-func deriveHash_28(object []uint) uint64 {
+// deriveHash_SliceOf_uint returns the hash of the object.
+func deriveHash_SliceOf_uint(object []uint) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13785,8 +12888,8 @@ func deriveHash_28(object []uint) uint64 {
 	return h
 }
 
-// deriveHash_29 returns the hash of the object.
-func deriveHash_29(object []uint16) uint64 {
+// deriveHash_SliceOf_uint16 returns the hash of the object.
+func deriveHash_SliceOf_uint16(object []uint16) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13797,8 +12900,8 @@ func deriveHash_29(object []uint16) uint64 {
 	return h
 }
 
-// deriveHash_30 returns the hash of the object.
-func deriveHash_30(object []uint32) uint64 {
+// deriveHash_SliceOf_uint32 returns the hash of the object.
+func deriveHash_SliceOf_uint32(object []uint32) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13809,8 +12912,8 @@ func deriveHash_30(object []uint32) uint64 {
 	return h
 }
 
-// deriveHash_31 returns the hash of the object.
-func deriveHash_31(object []uint64) uint64 {
+// deriveHash_SliceOf_uint64 returns the hash of the object.
+func deriveHash_SliceOf_uint64(object []uint64) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13821,8 +12924,8 @@ func deriveHash_31(object []uint64) uint64 {
 	return h
 }
 
-// deriveHash_32 returns the hash of the object.
-func deriveHash_32(object []uintptr) uint64 {
+// deriveHash_SliceOf_uintptr returns the hash of the object.
+func deriveHash_SliceOf_uintptr(object []uintptr) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13833,80 +12936,80 @@ func deriveHash_32(object []uintptr) uint64 {
 	return h
 }
 
-// deriveHash_33 returns the hash of the object.
-func deriveHash_33(object []*bool) uint64 {
+// deriveHash_SliceOf_PtrTo_bool returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_bool(object []*bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + deriveHash_PtrTo_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_34 returns the hash of the object.
-func deriveHash_34(object []*byte) uint64 {
+// deriveHash_SliceOf_PtrTo_byte returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_byte(object []*byte) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + deriveHash_PtrTo_byte(object[i])
 	}
 	return h
 }
 
-// deriveHash_35 returns the hash of the object.
-func deriveHash_35(object []*complex128) uint64 {
+// deriveHash_SliceOf_PtrTo_complex128 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_complex128(object []*complex128) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_3(object[i])
+		h = 31*h + deriveHash_PtrTo_complex128(object[i])
 	}
 	return h
 }
 
-// deriveHash_36 returns the hash of the object.
-func deriveHash_36(object []*complex64) uint64 {
+// deriveHash_SliceOf_PtrTo_complex64 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_complex64(object []*complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_4(object[i])
+		h = 31*h + deriveHash_PtrTo_complex64(object[i])
 	}
 	return h
 }
 
-// deriveHash_37 returns the hash of the object.
-func deriveHash_37(object []*float64) uint64 {
+// deriveHash_SliceOf_PtrTo_float64 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_float64(object []*float64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_5(object[i])
+		h = 31*h + deriveHash_PtrTo_float64(object[i])
 	}
 	return h
 }
 
-// deriveHash_38 returns the hash of the object.
-func deriveHash_38(object []*float32) uint64 {
+// deriveHash_SliceOf_PtrTo_float32 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_float32(object []*float32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_6(object[i])
+		h = 31*h + deriveHash_PtrTo_float32(object[i])
 	}
 	return h
 }
 
-// deriveHash_39 returns the hash of the object.
-func deriveHash_39(object []*int) uint64 {
+// deriveHash_SliceOf_PtrTo_int returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_int(object []*int) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -13917,137 +13020,137 @@ func deriveHash_39(object []*int) uint64 {
 	return h
 }
 
-// deriveHash_40 returns the hash of the object.
-func deriveHash_40(object []*int16) uint64 {
+// deriveHash_SliceOf_PtrTo_int16 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_int16(object []*int16) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_7(object[i])
+		h = 31*h + deriveHash_PtrTo_int16(object[i])
 	}
 	return h
 }
 
-// deriveHash_41 returns the hash of the object.
-func deriveHash_41(object []*int32) uint64 {
+// deriveHash_SliceOf_PtrTo_int32 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_int32(object []*int32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_PtrTo_int32(object[i])
 	}
 	return h
 }
 
-// deriveHash_42 returns the hash of the object.
-func deriveHash_42(object []*int64) uint64 {
+// deriveHash_SliceOf_PtrTo_int64 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_int64(object []*int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_9(object[i])
+		h = 31*h + deriveHash_PtrTo_int64(object[i])
 	}
 	return h
 }
 
-// deriveHash_43 returns the hash of the object.
-func deriveHash_43(object []*int8) uint64 {
+// deriveHash_SliceOf_PtrTo_int8 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_int8(object []*int8) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_10(object[i])
+		h = 31*h + deriveHash_PtrTo_int8(object[i])
 	}
 	return h
 }
 
-// deriveHash_44 returns the hash of the object.
-func deriveHash_44(object []*string) uint64 {
+// deriveHash_SliceOf_PtrTo_string returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_string(object []*string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_11(object[i])
+		h = 31*h + deriveHash_PtrTo_string(object[i])
 	}
 	return h
 }
 
-// deriveHash_45 returns the hash of the object.
-func deriveHash_45(object []*uint) uint64 {
+// deriveHash_SliceOf_PtrTo_uint returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_uint(object []*uint) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_12(object[i])
+		h = 31*h + deriveHash_PtrTo_uint(object[i])
 	}
 	return h
 }
 
-// deriveHash_46 returns the hash of the object.
-func deriveHash_46(object []*uint16) uint64 {
+// deriveHash_SliceOf_PtrTo_uint16 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_uint16(object []*uint16) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_13(object[i])
+		h = 31*h + deriveHash_PtrTo_uint16(object[i])
 	}
 	return h
 }
 
-// deriveHash_47 returns the hash of the object.
-func deriveHash_47(object []*uint32) uint64 {
+// deriveHash_SliceOf_PtrTo_uint32 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_uint32(object []*uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_14(object[i])
+		h = 31*h + deriveHash_PtrTo_uint32(object[i])
 	}
 	return h
 }
 
-// deriveHash_48 returns the hash of the object.
-func deriveHash_48(object []*uint64) uint64 {
+// deriveHash_SliceOf_PtrTo_uint64 returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_uint64(object []*uint64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_15(object[i])
+		h = 31*h + deriveHash_PtrTo_uint64(object[i])
 	}
 	return h
 }
 
-// deriveHash_49 returns the hash of the object.
-func deriveHash_49(object []*uintptr) uint64 {
+// deriveHash_SliceOf_PtrTo_uintptr returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_uintptr(object []*uintptr) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_16(object[i])
+		h = 31*h + deriveHash_PtrTo_uintptr(object[i])
 	}
 	return h
 }
 
-// deriveHash_50 returns the hash of the object.
-func deriveHash_50(object [1]bool) uint64 {
+// deriveHash_ArrayOf1_bool returns the hash of the object.
+func deriveHash_ArrayOf1_bool(object [1]bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_(object[i])
+		h = 31*h + deriveHash_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_51 returns the hash of the object.
-func deriveHash_51(object [2]byte) uint64 {
+// deriveHash_ArrayOf2_byte returns the hash of the object.
+func deriveHash_ArrayOf2_byte(object [2]byte) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14055,8 +13158,8 @@ func deriveHash_51(object [2]byte) uint64 {
 	return h
 }
 
-// deriveHash_52 returns the hash of the object.
-func deriveHash_52(object [3]complex128) uint64 {
+// deriveHash_ArrayOf3_complex128 returns the hash of the object.
+func deriveHash_ArrayOf3_complex128(object [3]complex128) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(object[i])))) + math.Float64bits(imag(object[i]))
@@ -14064,8 +13167,8 @@ func deriveHash_52(object [3]complex128) uint64 {
 	return h
 }
 
-// deriveHash_53 returns the hash of the object.
-func deriveHash_53(object [4]complex64) uint64 {
+// deriveHash_ArrayOf4_complex64 returns the hash of the object.
+func deriveHash_ArrayOf4_complex64(object [4]complex64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[i]))))) + uint64(math.Float32bits(imag(object[i])))
@@ -14073,8 +13176,8 @@ func deriveHash_53(object [4]complex64) uint64 {
 	return h
 }
 
-// deriveHash_54 returns the hash of the object.
-func deriveHash_54(object [5]float64) uint64 {
+// deriveHash_ArrayOf5_float64 returns the hash of the object.
+func deriveHash_ArrayOf5_float64(object [5]float64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + math.Float64bits(object[i])
@@ -14082,8 +13185,8 @@ func deriveHash_54(object [5]float64) uint64 {
 	return h
 }
 
-// deriveHash_55 returns the hash of the object.
-func deriveHash_55(object [6]float32) uint64 {
+// deriveHash_ArrayOf6_float32 returns the hash of the object.
+func deriveHash_ArrayOf6_float32(object [6]float32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(math.Float32bits(object[i]))
@@ -14091,8 +13194,8 @@ func deriveHash_55(object [6]float32) uint64 {
 	return h
 }
 
-// deriveHash_56 returns the hash of the object.
-func deriveHash_56(object [7]int) uint64 {
+// deriveHash_ArrayOf7_int returns the hash of the object.
+func deriveHash_ArrayOf7_int(object [7]int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14100,8 +13203,8 @@ func deriveHash_56(object [7]int) uint64 {
 	return h
 }
 
-// deriveHash_57 returns the hash of the object.
-func deriveHash_57(object [8]int16) uint64 {
+// deriveHash_ArrayOf8_int16 returns the hash of the object.
+func deriveHash_ArrayOf8_int16(object [8]int16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14109,8 +13212,8 @@ func deriveHash_57(object [8]int16) uint64 {
 	return h
 }
 
-// deriveHash_58 returns the hash of the object.
-func deriveHash_58(object [9]int32) uint64 {
+// deriveHash_ArrayOf9_int32 returns the hash of the object.
+func deriveHash_ArrayOf9_int32(object [9]int32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14118,8 +13221,8 @@ func deriveHash_58(object [9]int32) uint64 {
 	return h
 }
 
-// deriveHash_59 returns the hash of the object.
-func deriveHash_59(object [10]int64) uint64 {
+// deriveHash_ArrayOf10_int64 returns the hash of the object.
+func deriveHash_ArrayOf10_int64(object [10]int64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14127,8 +13230,8 @@ func deriveHash_59(object [10]int64) uint64 {
 	return h
 }
 
-// deriveHash_60 returns the hash of the object.
-func deriveHash_60(object [11]int8) uint64 {
+// deriveHash_ArrayOf11_int8 returns the hash of the object.
+func deriveHash_ArrayOf11_int8(object [11]int8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14136,8 +13239,8 @@ func deriveHash_60(object [11]int8) uint64 {
 	return h
 }
 
-// deriveHash_61 returns the hash of the object.
-func deriveHash_61(object [12]rune) uint64 {
+// deriveHash_ArrayOf12_rune returns the hash of the object.
+func deriveHash_ArrayOf12_rune(object [12]rune) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14145,17 +13248,17 @@ func deriveHash_61(object [12]rune) uint64 {
 	return h
 }
 
-// deriveHash_62 returns the hash of the object.
-func deriveHash_62(object [13]string) uint64 {
+// deriveHash_ArrayOf13_string returns the hash of the object.
+func deriveHash_ArrayOf13_string(object [13]string) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_s(object[i])
+		h = 31*h + deriveHash_string(object[i])
 	}
 	return h
 }
 
-// deriveHash_63 returns the hash of the object.
-func deriveHash_63(object [14]uint) uint64 {
+// deriveHash_ArrayOf14_uint returns the hash of the object.
+func deriveHash_ArrayOf14_uint(object [14]uint) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14163,8 +13266,8 @@ func deriveHash_63(object [14]uint) uint64 {
 	return h
 }
 
-// deriveHash_64 returns the hash of the object.
-func deriveHash_64(object [15]uint16) uint64 {
+// deriveHash_ArrayOf15_uint16 returns the hash of the object.
+func deriveHash_ArrayOf15_uint16(object [15]uint16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14172,8 +13275,8 @@ func deriveHash_64(object [15]uint16) uint64 {
 	return h
 }
 
-// deriveHash_65 returns the hash of the object.
-func deriveHash_65(object [16]uint32) uint64 {
+// deriveHash_ArrayOf16_uint32 returns the hash of the object.
+func deriveHash_ArrayOf16_uint32(object [16]uint32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14181,8 +13284,8 @@ func deriveHash_65(object [16]uint32) uint64 {
 	return h
 }
 
-// deriveHash_66 returns the hash of the object.
-func deriveHash_66(object [17]uint64) uint64 {
+// deriveHash_ArrayOf17_uint64 returns the hash of the object.
+func deriveHash_ArrayOf17_uint64(object [17]uint64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + object[i]
@@ -14190,8 +13293,8 @@ func deriveHash_66(object [17]uint64) uint64 {
 	return h
 }
 
-// deriveHash_67 returns the hash of the object.
-func deriveHash_67(object [18]uint8) uint64 {
+// deriveHash_ArrayOf18_uint8 returns the hash of the object.
+func deriveHash_ArrayOf18_uint8(object [18]uint8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14199,8 +13302,8 @@ func deriveHash_67(object [18]uint8) uint64 {
 	return h
 }
 
-// deriveHash_68 returns the hash of the object.
-func deriveHash_68(object [19]uintptr) uint64 {
+// deriveHash_ArrayOf19_uintptr returns the hash of the object.
+func deriveHash_ArrayOf19_uintptr(object [19]uintptr) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14208,71 +13311,71 @@ func deriveHash_68(object [19]uintptr) uint64 {
 	return h
 }
 
-// deriveHash_69 returns the hash of the object.
-func deriveHash_69(object [10]bool) uint64 {
+// deriveHash_ArrayOf10_bool returns the hash of the object.
+func deriveHash_ArrayOf10_bool(object [10]bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_(object[i])
+		h = 31*h + deriveHash_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_70 returns the hash of the object.
-func deriveHash_70(object [1]*bool) uint64 {
+// deriveHash_ArrayOf1_PtrTo_bool returns the hash of the object.
+func deriveHash_ArrayOf1_PtrTo_bool(object [1]*bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + deriveHash_PtrTo_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_71 returns the hash of the object.
-func deriveHash_71(object [2]*byte) uint64 {
+// deriveHash_ArrayOf2_PtrTo_byte returns the hash of the object.
+func deriveHash_ArrayOf2_PtrTo_byte(object [2]*byte) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + deriveHash_PtrTo_byte(object[i])
 	}
 	return h
 }
 
-// deriveHash_72 returns the hash of the object.
-func deriveHash_72(object [3]*complex128) uint64 {
+// deriveHash_ArrayOf3_PtrTo_complex128 returns the hash of the object.
+func deriveHash_ArrayOf3_PtrTo_complex128(object [3]*complex128) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_3(object[i])
+		h = 31*h + deriveHash_PtrTo_complex128(object[i])
 	}
 	return h
 }
 
-// deriveHash_73 returns the hash of the object.
-func deriveHash_73(object [4]*complex64) uint64 {
+// deriveHash_ArrayOf4_PtrTo_complex64 returns the hash of the object.
+func deriveHash_ArrayOf4_PtrTo_complex64(object [4]*complex64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_4(object[i])
+		h = 31*h + deriveHash_PtrTo_complex64(object[i])
 	}
 	return h
 }
 
-// deriveHash_74 returns the hash of the object.
-func deriveHash_74(object [5]*float64) uint64 {
+// deriveHash_ArrayOf5_PtrTo_float64 returns the hash of the object.
+func deriveHash_ArrayOf5_PtrTo_float64(object [5]*float64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_5(object[i])
+		h = 31*h + deriveHash_PtrTo_float64(object[i])
 	}
 	return h
 }
 
-// deriveHash_75 returns the hash of the object.
-func deriveHash_75(object [6]*float32) uint64 {
+// deriveHash_ArrayOf6_PtrTo_float32 returns the hash of the object.
+func deriveHash_ArrayOf6_PtrTo_float32(object [6]*float32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_6(object[i])
+		h = 31*h + deriveHash_PtrTo_float32(object[i])
 	}
 	return h
 }
 
-// deriveHash_76 returns the hash of the object.
-func deriveHash_76(object [7]*int) uint64 {
+// deriveHash_ArrayOf7_PtrTo_int returns the hash of the object.
+func deriveHash_ArrayOf7_PtrTo_int(object [7]*int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + deriveHashPtrToint(object[i])
@@ -14280,216 +13383,216 @@ func deriveHash_76(object [7]*int) uint64 {
 	return h
 }
 
-// deriveHash_77 returns the hash of the object.
-func deriveHash_77(object [8]*int16) uint64 {
+// deriveHash_ArrayOf8_PtrTo_int16 returns the hash of the object.
+func deriveHash_ArrayOf8_PtrTo_int16(object [8]*int16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_7(object[i])
+		h = 31*h + deriveHash_PtrTo_int16(object[i])
 	}
 	return h
 }
 
-// deriveHash_78 returns the hash of the object.
-func deriveHash_78(object [9]*int32) uint64 {
+// deriveHash_ArrayOf9_PtrTo_int32 returns the hash of the object.
+func deriveHash_ArrayOf9_PtrTo_int32(object [9]*int32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_PtrTo_int32(object[i])
 	}
 	return h
 }
 
-// deriveHash_79 returns the hash of the object.
-func deriveHash_79(object [10]*int64) uint64 {
+// deriveHash_ArrayOf10_PtrTo_int64 returns the hash of the object.
+func deriveHash_ArrayOf10_PtrTo_int64(object [10]*int64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_9(object[i])
+		h = 31*h + deriveHash_PtrTo_int64(object[i])
 	}
 	return h
 }
 
-// deriveHash_80 returns the hash of the object.
-func deriveHash_80(object [11]*int8) uint64 {
+// deriveHash_ArrayOf11_PtrTo_int8 returns the hash of the object.
+func deriveHash_ArrayOf11_PtrTo_int8(object [11]*int8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_10(object[i])
+		h = 31*h + deriveHash_PtrTo_int8(object[i])
 	}
 	return h
 }
 
-// deriveHash_81 returns the hash of the object.
-func deriveHash_81(object [12]*rune) uint64 {
+// deriveHash_ArrayOf12_PtrTo_rune returns the hash of the object.
+func deriveHash_ArrayOf12_PtrTo_rune(object [12]*rune) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_8(object[i])
+		h = 31*h + deriveHash_PtrTo_int32(object[i])
 	}
 	return h
 }
 
-// deriveHash_82 returns the hash of the object.
-func deriveHash_82(object [13]*string) uint64 {
+// deriveHash_ArrayOf13_PtrTo_string returns the hash of the object.
+func deriveHash_ArrayOf13_PtrTo_string(object [13]*string) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_11(object[i])
+		h = 31*h + deriveHash_PtrTo_string(object[i])
 	}
 	return h
 }
 
-// deriveHash_83 returns the hash of the object.
-func deriveHash_83(object [14]*uint) uint64 {
+// deriveHash_ArrayOf14_PtrTo_uint returns the hash of the object.
+func deriveHash_ArrayOf14_PtrTo_uint(object [14]*uint) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_12(object[i])
+		h = 31*h + deriveHash_PtrTo_uint(object[i])
 	}
 	return h
 }
 
-// deriveHash_84 returns the hash of the object.
-func deriveHash_84(object [15]*uint16) uint64 {
+// deriveHash_ArrayOf15_PtrTo_uint16 returns the hash of the object.
+func deriveHash_ArrayOf15_PtrTo_uint16(object [15]*uint16) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_13(object[i])
+		h = 31*h + deriveHash_PtrTo_uint16(object[i])
 	}
 	return h
 }
 
-// deriveHash_85 returns the hash of the object.
-func deriveHash_85(object [16]*uint32) uint64 {
+// deriveHash_ArrayOf16_PtrTo_uint32 returns the hash of the object.
+func deriveHash_ArrayOf16_PtrTo_uint32(object [16]*uint32) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_14(object[i])
+		h = 31*h + deriveHash_PtrTo_uint32(object[i])
 	}
 	return h
 }
 
-// deriveHash_86 returns the hash of the object.
-func deriveHash_86(object [17]*uint64) uint64 {
+// deriveHash_ArrayOf17_PtrTo_uint64 returns the hash of the object.
+func deriveHash_ArrayOf17_PtrTo_uint64(object [17]*uint64) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_15(object[i])
+		h = 31*h + deriveHash_PtrTo_uint64(object[i])
 	}
 	return h
 }
 
-// deriveHash_87 returns the hash of the object.
-func deriveHash_87(object [18]*uint8) uint64 {
+// deriveHash_ArrayOf18_PtrTo_uint8 returns the hash of the object.
+func deriveHash_ArrayOf18_PtrTo_uint8(object [18]*uint8) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_2(object[i])
+		h = 31*h + deriveHash_PtrTo_byte(object[i])
 	}
 	return h
 }
 
-// deriveHash_88 returns the hash of the object.
-func deriveHash_88(object [19]*uintptr) uint64 {
+// deriveHash_ArrayOf19_PtrTo_uintptr returns the hash of the object.
+func deriveHash_ArrayOf19_PtrTo_uintptr(object [19]*uintptr) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_16(object[i])
+		h = 31*h + deriveHash_PtrTo_uintptr(object[i])
 	}
 	return h
 }
 
-// deriveHash_89 returns the hash of the object.
-func deriveHash_89(object [10]*bool) uint64 {
+// deriveHash_ArrayOf10_PtrTo_bool returns the hash of the object.
+func deriveHash_ArrayOf10_PtrTo_bool(object [10]*bool) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_1(object[i])
+		h = 31*h + deriveHash_PtrTo_bool(object[i])
 	}
 	return h
 }
 
-// deriveHash_90 returns the hash of the object.
-func deriveHash_90(object map[string]uint32) uint64 {
+// deriveHash_MapOf_string_To_uint32 returns the hash of the object.
+func deriveHash_MapOf_string_To_uint32(object map[string]uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_(object)) {
-		h = 31*h + deriveHash_s(k)
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_uint32(object)) {
+		h = 31*h + deriveHash_string(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_91 returns the hash of the object.
-func deriveHash_91(object map[uint8]int64) uint64 {
+// deriveHash_MapOf_uint8_To_int64 returns the hash of the object.
+func deriveHash_MapOf_uint8_To_int64(object map[uint8]int64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort(deriveKeys_1(object)) {
+	for _, k := range deriveSort_SliceOf_uint8(deriveKeys_MapOf_uint8_To_int64(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_92 returns the hash of the object.
-func deriveHash_92(object map[bool]string) uint64 {
+// deriveHash_MapOf_bool_To_string returns the hash of the object.
+func deriveHash_MapOf_bool_To_string(object map[bool]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_(deriveKeys_2(object)) {
-		h = 31*h + deriveHash_(k)
-		h = 31*h + deriveHash_s(object[k])
+	for _, k := range deriveSort_SliceOf_bool(deriveKeys_MapOf_bool_To_string(object)) {
+		h = 31*h + deriveHash_bool(k)
+		h = 31*h + deriveHash_string(object[k])
 	}
 	return h
 }
 
-// deriveHash_93 returns the hash of the object.
-func deriveHash_93(object map[string]bool) uint64 {
+// deriveHash_MapOf_string_To_bool returns the hash of the object.
+func deriveHash_MapOf_string_To_bool(object map[string]bool) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_3(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_bool(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_bool(object[k])
 	}
 	return h
 }
 
-// deriveHash_94 returns the hash of the object.
-func deriveHash_94(object map[complex128]complex64) uint64 {
+// deriveHash_MapOf_complex128_To_complex64 returns the hash of the object.
+func deriveHash_MapOf_complex128_To_complex64(object map[complex128]complex64) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_1(deriveKeys_4(object)) {
+	for _, k := range deriveSort_SliceOf_complex128(deriveKeys_MapOf_complex128_To_complex64(object)) {
 		h = 31*h + (31 * ((31 * 17) + math.Float64bits(real(k)))) + math.Float64bits(imag(k))
 		h = 31*h + (31 * ((31 * 17) + uint64(math.Float32bits(real(object[k]))))) + uint64(math.Float32bits(imag(object[k])))
 	}
 	return h
 }
 
-// deriveHash_95 returns the hash of the object.
-func deriveHash_95(object map[float64]uint32) uint64 {
+// deriveHash_MapOf_float64_To_uint32 returns the hash of the object.
+func deriveHash_MapOf_float64_To_uint32(object map[float64]uint32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_2(deriveKeys_5(object)) {
+	for _, k := range deriveSort_SliceOf_float64(deriveKeys_MapOf_float64_To_uint32(object)) {
 		h = 31*h + math.Float64bits(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_96 returns the hash of the object.
-func deriveHash_96(object map[uint16]uint8) uint64 {
+// deriveHash_MapOf_uint16_To_uint8 returns the hash of the object.
+func deriveHash_MapOf_uint16_To_uint8(object map[uint16]uint8) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_3(deriveKeys_6(object)) {
+	for _, k := range deriveSort_SliceOf_uint16(deriveKeys_MapOf_uint16_To_uint8(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_97 returns the hash of the object.
-func deriveHash_97(object [][]int) uint64 {
+// deriveHash_SliceOf_SliceOf_int returns the hash of the object.
+func deriveHash_SliceOf_SliceOf_int(object [][]int) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14500,57 +13603,57 @@ func deriveHash_97(object [][]int) uint64 {
 	return h
 }
 
-// deriveHash_98 returns the hash of the object.
-func deriveHash_98(object [][]string) uint64 {
+// deriveHash_SliceOf_SliceOf_string returns the hash of the object.
+func deriveHash_SliceOf_SliceOf_string(object [][]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_27(object[i])
+		h = 31*h + deriveHash_SliceOf_string(object[i])
 	}
 	return h
 }
 
-// deriveHash_99 returns the hash of the object.
-func deriveHash_99(object [][]*int) uint64 {
+// deriveHash_SliceOf_SliceOf_PtrTo_int returns the hash of the object.
+func deriveHash_SliceOf_SliceOf_PtrTo_int(object [][]*int) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_39(object[i])
+		h = 31*h + deriveHash_SliceOf_PtrTo_int(object[i])
 	}
 	return h
 }
 
-// deriveHash_100 returns the hash of the object.
-func deriveHash_100(object *[4]int) uint64 {
+// deriveHash_PtrTo_ArrayOf4_int returns the hash of the object.
+func deriveHash_PtrTo_ArrayOf4_int(object *[4]int) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_134(*object)
+	return (31 * 17) + deriveHash_ArrayOf4_int(*object)
 }
 
-// deriveHash_N returns the hash of the object.
-func deriveHash_N(object Name) uint64 {
+// deriveHash_Name returns the hash of the object.
+func deriveHash_Name(object Name) uint64 {
 	return deriveHashName(&object)
 }
 
-// deriveHash_101 returns the hash of the object.
-func deriveHash_101(object []Name) uint64 {
+// deriveHash_SliceOf_Name returns the hash of the object.
+func deriveHash_SliceOf_Name(object []Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_N(object[i])
+		h = 31*h + deriveHash_Name(object[i])
 	}
 	return h
 }
 
-// deriveHash_102 returns the hash of the object.
-func deriveHash_102(object []*Name) uint64 {
+// deriveHash_SliceOf_PtrTo_Name returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_Name(object []*Name) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14561,195 +13664,195 @@ func deriveHash_102(object []*Name) uint64 {
 	return h
 }
 
-// deriveHash_S returns the hash of the object.
-func deriveHash_S(object StructWithoutMethod) uint64 {
-	return deriveHash_103(&object)
+// deriveHash_StructWithoutMethod returns the hash of the object.
+func deriveHash_StructWithoutMethod(object StructWithoutMethod) uint64 {
+	return deriveHash_PtrTo_StructWithoutMethod(&object)
 }
 
-// deriveHash_103 returns the hash of the object.
-func deriveHash_103(object *StructWithoutMethod) uint64 {
+// deriveHash_PtrTo_StructWithoutMethod returns the hash of the object.
+func deriveHash_PtrTo_StructWithoutMethod(object *StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_s(object.Name)
+	h = 31*h + deriveHash_string(object.Name)
 	return h
 }
 
-// deriveHash_104 returns the hash of the object.
-func deriveHash_104(object []StructWithoutMethod) uint64 {
+// deriveHash_SliceOf_StructWithoutMethod returns the hash of the object.
+func deriveHash_SliceOf_StructWithoutMethod(object []StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_S(object[i])
+		h = 31*h + deriveHash_StructWithoutMethod(object[i])
 	}
 	return h
 }
 
-// deriveHash_105 returns the hash of the object.
-func deriveHash_105(object []*StructWithoutMethod) uint64 {
+// deriveHash_SliceOf_PtrTo_StructWithoutMethod returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_StructWithoutMethod(object []*StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_103(object[i])
+		h = 31*h + deriveHash_PtrTo_StructWithoutMethod(object[i])
 	}
 	return h
 }
 
-// deriveHash_106 returns the hash of the object.
-func deriveHash_106(object map[Name]string) uint64 {
+// deriveHash_MapOf_Name_To_string returns the hash of the object.
+func deriveHash_MapOf_Name_To_string(object map[Name]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_4(deriveKeys_7(object)) {
-		h = 31*h + deriveHash_N(k)
-		h = 31*h + deriveHash_s(object[k])
+	for _, k := range deriveSort_SliceOf_Name(deriveKeys_MapOf_Name_To_string(object)) {
+		h = 31*h + deriveHash_Name(k)
+		h = 31*h + deriveHash_string(object[k])
 	}
 	return h
 }
 
-// deriveHash_107 returns the hash of the object.
-func deriveHash_107(object map[string]Name) uint64 {
+// deriveHash_MapOf_string_To_Name returns the hash of the object.
+func deriveHash_MapOf_string_To_Name(object map[string]Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_8(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_N(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_Name(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_Name(object[k])
 	}
 	return h
 }
 
-// deriveHash_108 returns the hash of the object.
-func deriveHash_108(object map[string]*Name) uint64 {
+// deriveHash_MapOf_string_To_PtrTo_Name returns the hash of the object.
+func deriveHash_MapOf_string_To_PtrTo_Name(object map[string]*Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_9(object)) {
-		h = 31*h + deriveHash_s(k)
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_Name(object)) {
+		h = 31*h + deriveHash_string(k)
 		h = 31*h + deriveHashName(object[k])
 	}
 	return h
 }
 
-// deriveHash_109 returns the hash of the object.
-func deriveHash_109(object map[string][]Name) uint64 {
+// deriveHash_MapOf_string_To_SliceOf_Name returns the hash of the object.
+func deriveHash_MapOf_string_To_SliceOf_Name(object map[string][]Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_10(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_101(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_Name(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_SliceOf_Name(object[k])
 	}
 	return h
 }
 
-// deriveHash_110 returns the hash of the object.
-func deriveHash_110(object map[string][]*Name) uint64 {
+// deriveHash_MapOf_string_To_SliceOf_PtrTo_Name returns the hash of the object.
+func deriveHash_MapOf_string_To_SliceOf_PtrTo_Name(object map[string][]*Name) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_11(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_102(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_Name(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_SliceOf_PtrTo_Name(object[k])
 	}
 	return h
 }
 
-// deriveHash_111 returns the hash of the object.
-func deriveHash_111(object map[string]StructWithoutMethod) uint64 {
+// deriveHash_MapOf_string_To_StructWithoutMethod returns the hash of the object.
+func deriveHash_MapOf_string_To_StructWithoutMethod(object map[string]StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_12(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_S(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_StructWithoutMethod(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_StructWithoutMethod(object[k])
 	}
 	return h
 }
 
-// deriveHash_112 returns the hash of the object.
-func deriveHash_112(object map[StructWithoutMethod]string) uint64 {
+// deriveHash_MapOf_StructWithoutMethod_To_string returns the hash of the object.
+func deriveHash_MapOf_StructWithoutMethod_To_string(object map[StructWithoutMethod]string) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_5(deriveKeys_13(object)) {
-		h = 31*h + deriveHash_S(k)
-		h = 31*h + deriveHash_s(object[k])
+	for _, k := range deriveSort_SliceOf_StructWithoutMethod(deriveKeys_MapOf_StructWithoutMethod_To_string(object)) {
+		h = 31*h + deriveHash_StructWithoutMethod(k)
+		h = 31*h + deriveHash_string(object[k])
 	}
 	return h
 }
 
-// deriveHash_113 returns the hash of the object.
-func deriveHash_113(object map[string]*StructWithoutMethod) uint64 {
+// deriveHash_MapOf_string_To_PtrTo_StructWithoutMethod returns the hash of the object.
+func deriveHash_MapOf_string_To_PtrTo_StructWithoutMethod(object map[string]*StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_14(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_103(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_PtrTo_StructWithoutMethod(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_PtrTo_StructWithoutMethod(object[k])
 	}
 	return h
 }
 
-// deriveHash_114 returns the hash of the object.
-func deriveHash_114(object map[string][]StructWithoutMethod) uint64 {
+// deriveHash_MapOf_string_To_SliceOf_StructWithoutMethod returns the hash of the object.
+func deriveHash_MapOf_string_To_SliceOf_StructWithoutMethod(object map[string][]StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_15(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_104(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_StructWithoutMethod(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_SliceOf_StructWithoutMethod(object[k])
 	}
 	return h
 }
 
-// deriveHash_115 returns the hash of the object.
-func deriveHash_115(object map[string][]*StructWithoutMethod) uint64 {
+// deriveHash_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod returns the hash of the object.
+func deriveHash_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(object map[string][]*StructWithoutMethod) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_16(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_105(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_StructWithoutMethod(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_SliceOf_PtrTo_StructWithoutMethod(object[k])
 	}
 	return h
 }
 
-// deriveHash_116 returns the hash of the object.
-func deriveHash_116(object map[int]RecursiveType) uint64 {
+// deriveHash_MapOf_int_To_RecursiveType returns the hash of the object.
+func deriveHash_MapOf_int_To_RecursiveType(object map[int]RecursiveType) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys_17(object)) {
+	for _, k := range deriveSortedInts(deriveKeys_MapOf_int_To_RecursiveType(object)) {
 		h = 31*h + uint64(k)
-		h = 31*h + deriveHash_R(object[k])
+		h = 31*h + deriveHash_RecursiveType(object[k])
 	}
 	return h
 }
 
-// deriveHash_St returns the hash of the object.
-func deriveHash_St(object Structs) uint64 {
+// deriveHash_Structs returns the hash of the object.
+func deriveHash_Structs(object Structs) uint64 {
 	return deriveHashStructs(&object)
 }
 
-// deriveHash_117 returns the hash of the object.
-func deriveHash_117(object *StructWithoutEqualMethod) uint64 {
+// deriveHash_PtrTo_StructWithoutEqualMethod returns the hash of the object.
+func deriveHash_PtrTo_StructWithoutEqualMethod(object *StructWithoutEqualMethod) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14758,13 +13861,13 @@ func deriveHash_117(object *StructWithoutEqualMethod) uint64 {
 	return h
 }
 
-// deriveHash_Str returns the hash of the object.
-func deriveHash_Str(object StructWithoutEqualMethod) uint64 {
-	return deriveHash_117(&object)
+// deriveHash_StructWithoutEqualMethod returns the hash of the object.
+func deriveHash_StructWithoutEqualMethod(object StructWithoutEqualMethod) uint64 {
+	return deriveHash_PtrTo_StructWithoutEqualMethod(&object)
 }
 
-// deriveHash_118 returns the hash of the object.
-func deriveHash_118(object *extra.StructWithoutEqualMethod) uint64 {
+// deriveHash_PtrTo_extra_StructWithoutEqualMethod returns the hash of the object.
+func deriveHash_PtrTo_extra_StructWithoutEqualMethod(object *extra.StructWithoutEqualMethod) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14773,21 +13876,21 @@ func deriveHash_118(object *extra.StructWithoutEqualMethod) uint64 {
 	return h
 }
 
-// deriveHash_Stru returns the hash of the object.
-func deriveHash_Stru(object extra.StructWithoutEqualMethod) uint64 {
-	return deriveHash_118(&object)
+// deriveHash_extra_StructWithoutEqualMethod returns the hash of the object.
+func deriveHash_extra_StructWithoutEqualMethod(object extra.StructWithoutEqualMethod) uint64 {
+	return deriveHash_PtrTo_extra_StructWithoutEqualMethod(&object)
 }
 
-// deriveHash_119 returns the hash of the object.
-func deriveHash_119(object *MyEnum) uint64 {
+// deriveHash_PtrTo_MyEnum returns the hash of the object.
+func deriveHash_PtrTo_MyEnum(object *MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_120 returns the hash of the object.
-func deriveHash_120(object []MyEnum) uint64 {
+// deriveHash_SliceOf_MyEnum returns the hash of the object.
+func deriveHash_SliceOf_MyEnum(object []MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14798,46 +13901,46 @@ func deriveHash_120(object []MyEnum) uint64 {
 	return h
 }
 
-// deriveHash_121 returns the hash of the object.
-func deriveHash_121(object []*MyEnum) uint64 {
+// deriveHash_SliceOf_PtrTo_MyEnum returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_MyEnum(object []*MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_119(object[i])
+		h = 31*h + deriveHash_PtrTo_MyEnum(object[i])
 	}
 	return h
 }
 
-// deriveHash_122 returns the hash of the object.
-func deriveHash_122(object map[int32]MyEnum) uint64 {
+// deriveHash_MapOf_int32_To_MyEnum returns the hash of the object.
+func deriveHash_MapOf_int32_To_MyEnum(object map[int32]MyEnum) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_6(deriveKeys_18(object)) {
+	for _, k := range deriveSort_SliceOf_int32(deriveKeys_MapOf_int32_To_MyEnum(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_123 returns the hash of the object.
-func deriveHash_123(object map[MyEnum]int32) uint64 {
+// deriveHash_MapOf_MyEnum_To_int32 returns the hash of the object.
+func deriveHash_MapOf_MyEnum_To_int32(object map[MyEnum]int32) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSort_7(deriveKeys_19(object)) {
+	for _, k := range deriveSort_SliceOf_MyEnum(deriveKeys_MapOf_MyEnum_To_int32(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_124 returns the hash of the object.
-func deriveHash_124(object [2]MyEnum) uint64 {
+// deriveHash_ArrayOf2_MyEnum returns the hash of the object.
+func deriveHash_ArrayOf2_MyEnum(object [2]MyEnum) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -14845,36 +13948,36 @@ func deriveHash_124(object [2]MyEnum) uint64 {
 	return h
 }
 
-// deriveHash_125 returns the hash of the object.
-func deriveHash_125(object *MySlice) uint64 {
+// deriveHash_PtrTo_MySlice returns the hash of the object.
+func deriveHash_PtrTo_MySlice(object *MySlice) uint64 {
 	if object == nil {
 		return 0
 	}
-	return (31 * 17) + deriveHash_25(*object)
+	return (31 * 17) + deriveHash_SliceOf_int64(*object)
 }
 
-// deriveHash_126 returns the hash of the object.
-func deriveHash_126(object []MySlice) uint64 {
+// deriveHash_SliceOf_MySlice returns the hash of the object.
+func deriveHash_SliceOf_MySlice(object []MySlice) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_25(object[i])
+		h = 31*h + deriveHash_SliceOf_int64(object[i])
 	}
 	return h
 }
 
-// deriveHash_127 returns the hash of the object.
-func deriveHash_127(object *time.Duration) uint64 {
+// deriveHash_PtrTo_time_Duration returns the hash of the object.
+func deriveHash_PtrTo_time_Duration(object *time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	return (31 * 17) + uint64(*object)
 }
 
-// deriveHash_128 returns the hash of the object.
-func deriveHash_128(object []time.Duration) uint64 {
+// deriveHash_SliceOf_time_Duration returns the hash of the object.
+func deriveHash_SliceOf_time_Duration(object []time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -14885,71 +13988,119 @@ func deriveHash_128(object []time.Duration) uint64 {
 	return h
 }
 
-// deriveHash_129 returns the hash of the object.
-func deriveHash_129(object []*time.Duration) uint64 {
+// deriveHash_SliceOf_PtrTo_time_Duration returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_time_Duration(object []*time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_127(object[i])
+		h = 31*h + deriveHash_PtrTo_time_Duration(object[i])
 	}
 	return h
 }
 
-// deriveHash_130 returns the hash of the object.
-func deriveHash_130(object map[int]time.Duration) uint64 {
+// deriveHash_MapOf_int_To_time_Duration returns the hash of the object.
+func deriveHash_MapOf_int_To_time_Duration(object map[int]time.Duration) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedInts(deriveKeys_20(object)) {
+	for _, k := range deriveSortedInts(deriveKeys_MapOf_int_To_time_Duration(object)) {
 		h = 31*h + uint64(k)
 		h = 31*h + uint64(object[k])
 	}
 	return h
 }
 
-// deriveHash_131 returns the hash of the object.
-func deriveHash_131(object map[string][]*pickle.Rick) uint64 {
+// deriveHash_MapOf_string_To_SliceOf_PtrTo_pickle_Rick returns the hash of the object.
+func deriveHash_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(object map[string][]*pickle.Rick) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	for _, k := range deriveSortedStrings(deriveKeys_21(object)) {
-		h = 31*h + deriveHash_s(k)
-		h = 31*h + deriveHash_135(object[k])
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_SliceOf_PtrTo_pickle_Rick(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_SliceOf_PtrTo_pickle_Rick(object[k])
 	}
 	return h
 }
 
-// deriveHash_p returns the hash of the object.
-func deriveHash_p(object privateStruct) uint64 {
-	return deriveHash_136(&object)
+// deriveHash_privateStruct returns the hash of the object.
+func deriveHash_privateStruct(object privateStruct) uint64 {
+	return deriveHash_PtrTo_privateStruct(&object)
+}
+
+// deriveHash_Tree_Name returns the hash of the object.
+func deriveHash_Tree_Name(object Tree[Name]) uint64 {
+	return deriveHash_PtrTo_Tree_Name(&object)
 }
 
-// deriveHash_132 returns the hash of the object.
-func deriveHash_132(object [10]int) uint64 {
+// deriveHash_SliceOf_Pair_string_PtrTo_Name returns the hash of the object.
+func deriveHash_SliceOf_Pair_string_PtrTo_Name(object []Pair[string, *Name]) uint64 {
+	if object == nil {
+		return 0
+	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + uint64(object[i])
+		h = 31*h + deriveHash_Pair_string_PtrTo_Name(object[i])
+	}
+	return h
+}
+
+// deriveHash_MapOf_string_To_Pair_int64_SliceOf_string returns the hash of the object.
+func deriveHash_MapOf_string_To_Pair_int64_SliceOf_string(object map[string]Pair[int64, []string]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for _, k := range deriveSortedStrings(deriveKeys_MapOf_string_To_Pair_int64_SliceOf_string(object)) {
+		h = 31*h + deriveHash_string(k)
+		h = 31*h + deriveHash_Pair_int64_SliceOf_string(object[k])
+	}
+	return h
+}
+
+// deriveHash_PtrTo_extra_Box_Pair_string_int returns the hash of the object.
+func deriveHash_PtrTo_extra_Box_Pair_string_int(object *extra.Box[Pair[string, int]]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_Pair_string_int(object.Contents)
+	return h
+}
+
+// deriveHash_CacheEntry returns the hash of the object.
+func deriveHash_CacheEntry(object CacheEntry) uint64 {
+	return deriveHash_PtrTo_CacheEntry(&object)
+}
+
+// deriveHash_SliceOf_PtrTo_Tree_int returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_Tree_int(object []*Tree[int]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + deriveHashTreeOfInt(object[i])
 	}
 	return h
 }
 
-// deriveHash_133 returns the hash of the object.
-func deriveHash_133(object struct {
-	Param0 *BuiltInTypes
-	Param1 int
-}) uint64 {
+// deriveHash_PtrTo_Pair_string_int64 returns the hash of the object.
+func deriveHash_PtrTo_Pair_string_int64(object *Pair[string, int64]) uint64 {
+	if object == nil {
+		return 0
+	}
 	h := uint64(17)
-	h = 31*h + deriveHashBuiltInTypes(object.Param0)
-	h = 31*h + uint64(object.Param1)
+	h = 31*h + deriveHash_string(object.Key)
+	h = 31*h + uint64(object.Value)
 	return h
 }
 
-// deriveGoString_71 returns a recursive representation of this as a valid go string.
-func deriveGoString_71(this *bool) string {
+// deriveGoString_PtrTo_bool returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_bool(this *bool) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *bool {\n")
 	if this == nil {
@@ -14963,8 +14114,8 @@ func deriveGoString_71(this *bool) string {
 	return buf.String()
 }
 
-// deriveGoString_72 returns a recursive representation of this as a valid go string.
-func deriveGoString_72(this *byte) string {
+// deriveGoString_PtrTo_byte returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_byte(this *byte) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *byte {\n")
 	if this == nil {
@@ -14978,8 +14129,8 @@ func deriveGoString_72(this *byte) string {
 	return buf.String()
 }
 
-// deriveGoString_73 returns a recursive representation of this as a valid go string.
-func deriveGoString_73(this *complex128) string {
+// deriveGoString_PtrTo_complex128 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_complex128(this *complex128) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *complex128 {\n")
 	if this == nil {
@@ -14993,8 +14144,8 @@ func deriveGoString_73(this *complex128) string {
 	return buf.String()
 }
 
-// deriveGoString_74 returns a recursive representation of this as a valid go string.
-func deriveGoString_74(this *complex64) string {
+// deriveGoString_PtrTo_complex64 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_complex64(this *complex64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *complex64 {\n")
 	if this == nil {
@@ -15008,8 +14159,8 @@ func deriveGoString_74(this *complex64) string {
 	return buf.String()
 }
 
-// deriveGoString_75 returns a recursive representation of this as a valid go string.
-func deriveGoString_75(this *float64) string {
+// deriveGoString_PtrTo_float64 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_float64(this *float64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *float64 {\n")
 	if this == nil {
@@ -15023,8 +14174,8 @@ func deriveGoString_75(this *float64) string {
 	return buf.String()
 }
 
-// deriveGoString_76 returns a recursive representation of this as a valid go string.
-func deriveGoString_76(this *float32) string {
+// deriveGoString_PtrTo_float32 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_float32(this *float32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *float32 {\n")
 	if this == nil {
@@ -15038,8 +14189,8 @@ func deriveGoString_76(this *float32) string {
 	return buf.String()
 }
 
-// deriveGoString_77 returns a recursive representation of this as a valid go string.
-func deriveGoString_77(this *int16) string {
+// deriveGoString_PtrTo_int16 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_int16(this *int16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int16 {\n")
 	if this == nil {
@@ -15053,8 +14204,8 @@ func deriveGoString_77(this *int16) string {
 	return buf.String()
 }
 
-// deriveGoString_78 returns a recursive representation of this as a valid go string.
-func deriveGoString_78(this *int32) string {
+// deriveGoString_PtrTo_int32 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_int32(this *int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int32 {\n")
 	if this == nil {
@@ -15068,8 +14219,8 @@ func deriveGoString_78(this *int32) string {
 	return buf.String()
 }
 
-// deriveGoString_79 returns a recursive representation of this as a valid go string.
-func deriveGoString_79(this *int64) string {
+// deriveGoString_PtrTo_int64 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_int64(this *int64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int64 {\n")
 	if this == nil {
@@ -15083,8 +14234,8 @@ func deriveGoString_79(this *int64) string {
 	return buf.String()
 }
 
-// deriveGoString_80 returns a recursive representation of this as a valid go string.
-func deriveGoString_80(this *int8) string {
+// deriveGoString_PtrTo_int8 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_int8(this *int8) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *int8 {\n")
 	if this == nil {
@@ -15098,8 +14249,8 @@ func deriveGoString_80(this *int8) string {
 	return buf.String()
 }
 
-// deriveGoString_81 returns a recursive representation of this as a valid go string.
-func deriveGoString_81(this *string) string {
+// deriveGoString_PtrTo_string returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_string(this *string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *string {\n")
 	if this == nil {
@@ -15113,8 +14264,8 @@ func deriveGoString_81(this *string) string {
 	return buf.String()
 }
 
-// deriveGoString_82 returns a recursive representation of this as a valid go string.
-func deriveGoString_82(this *uint) string {
+// deriveGoString_PtrTo_uint returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_uint(this *uint) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint {\n")
 	if this == nil {
@@ -15128,8 +14279,8 @@ func deriveGoString_82(this *uint) string {
 	return buf.String()
 }
 
-// deriveGoString_83 returns a recursive representation of this as a valid go string.
-func deriveGoString_83(this *uint16) string {
+// deriveGoString_PtrTo_uint16 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_uint16(this *uint16) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint16 {\n")
 	if this == nil {
@@ -15143,8 +14294,8 @@ func deriveGoString_83(this *uint16) string {
 	return buf.String()
 }
 
-// deriveGoString_84 returns a recursive representation of this as a valid go string.
-func deriveGoString_84(this *uint32) string {
+// deriveGoString_PtrTo_uint32 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_uint32(this *uint32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint32 {\n")
 	if this == nil {
@@ -15158,8 +14309,8 @@ func deriveGoString_84(this *uint32) string {
 	return buf.String()
 }
 
-// deriveGoString_85 returns a recursive representation of this as a valid go string.
-func deriveGoString_85(this *uint64) string {
+// deriveGoString_PtrTo_uint64 returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_uint64(this *uint64) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uint64 {\n")
 	if this == nil {
@@ -15173,8 +14324,8 @@ func deriveGoString_85(this *uint64) string {
 	return buf.String()
 }
 
-// deriveGoString_86 returns a recursive representation of this as a valid go string.
-func deriveGoString_86(this *uintptr) string {
+// deriveGoString_PtrTo_uintptr returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_uintptr(this *uintptr) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *uintptr {\n")
 	if this == nil {
@@ -15188,8 +14339,8 @@ func deriveGoString_86(this *uintptr) string {
 	return buf.String()
 }
 
-// deriveGoString_87 returns a recursive representation of this as a valid go string.
-func deriveGoString_87(this []string) string {
+// deriveGoString_SliceOf_string returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_string(this []string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []string {\n")
 	if this == nil {
@@ -15201,8 +14352,8 @@ func deriveGoString_87(this []string) string {
 	return buf.String()
 }
 
-// deriveGoString_s returns a recursive representation of this as a valid go string.
-func deriveGoString_s(this string) string {
+// deriveGoString_string returns a recursive representation of this as a valid go string.
+func deriveGoString_string(this string) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() string {\n")
 	fmt.Fprintf(buf, "return %#v\n", this)
@@ -15210,8 +14361,8 @@ func deriveGoString_s(this string) string {
 	return buf.String()
 }
 
-// deriveGoString_R returns a recursive representation of this as a valid go string.
-func deriveGoString_R(this RecursiveType) string {
+// deriveGoString_RecursiveType returns a recursive representation of this as a valid go string.
+func deriveGoString_RecursiveType(this RecursiveType) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.RecursiveType {\n")
 	fmt.Fprintf(buf, "this := &test.RecursiveType{}\n")
@@ -15219,15 +14370,15 @@ func deriveGoString_R(this RecursiveType) string {
 		fmt.Fprintf(buf, "this.Bytes = %#v\n", this.Bytes)
 	}
 	if this.N != nil {
-		fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_55(this.N))
+		fmt.Fprintf(buf, "this.N = %s\n", deriveGoString_MapOf_int_To_RecursiveType(this.N))
 	}
 	fmt.Fprintf(buf, "return *this\n")
 	fmt.Fprintf(buf, "}()\n")
 	return buf.String()
 }
 
-// deriveGoString_M returns a recursive representation of this as a valid go string.
-func deriveGoString_M(this MyEnum) string {
+// deriveGoString_MyEnum returns a recursive representation of this as a valid go string.
+func deriveGoString_MyEnum(this MyEnum) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.MyEnum {\n")
 	fmt.Fprintf(buf, "return %#v\n", this)
@@ -15235,8 +14386,8 @@ func deriveGoString_M(this MyEnum) string {
 	return buf.String()
 }
 
-// deriveGoString_i returns a recursive representation of this as a valid go string.
-func deriveGoString_i(this int32) string {
+// deriveGoString_int32 returns a recursive representation of this as a valid go string.
+func deriveGoString_int32(this int32) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() int32 {\n")
 	fmt.Fprintf(buf, "return %#v\n", this)
@@ -15244,8 +14395,8 @@ func deriveGoString_i(this int32) string {
 	return buf.String()
 }
 
-// deriveGoString_My returns a recursive representation of this as a valid go string.
-func deriveGoString_My(this MySlice) string {
+// deriveGoString_MySlice returns a recursive representation of this as a valid go string.
+func deriveGoString_MySlice(this MySlice) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() test.MySlice {\n")
 	if this == nil {
@@ -15257,8 +14408,8 @@ func deriveGoString_My(this MySlice) string {
 	return buf.String()
 }
 
-// deriveGoString_D returns a recursive representation of this as a valid go string.
-func deriveGoString_D(this time.Duration) string {
+// deriveGoString_time_Duration returns a recursive representation of this as a valid go string.
+func deriveGoString_time_Duration(this time.Duration) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() time.Duration {\n")
 	fmt.Fprintf(buf, "return %#v\n", this)
@@ -15266,8 +14417,8 @@ func deriveGoString_D(this time.Duration) string {
 	return buf.String()
 }
 
-// deriveGoString_88 returns a recursive representation of this as a valid go string.
-func deriveGoString_88(this []*pickle.Rick) string {
+// deriveGoString_SliceOf_PtrTo_pickle_Rick returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_pickle_Rick(this []*pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() []*pickle.Rick {\n")
 	if this == nil {
@@ -15275,7 +14426,7 @@ func deriveGoString_88(this []*pickle.Rick) string {
 	} else {
 		fmt.Fprintf(buf, "this := make([]*pickle.Rick, %d)\n", len(this))
 		for i := range this {
-			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_89(this[i]))
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoString_PtrTo_pickle_Rick(this[i]))
 		}
 		fmt.Fprintf(buf, "return this\n")
 	}
@@ -15283,8 +14434,8 @@ func deriveGoString_88(this []*pickle.Rick) string {
 	return buf.String()
 }
 
-// deriveDeepCopy_55 recursively copies the contents of src into dst.
-func deriveDeepCopy_55(dst, src []*pickle.Rick) {
+// deriveDeepCopy_SliceOf_PtrTo_pickle_Rick recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_pickle_Rick(dst, src []*pickle.Rick) {
 	for src_i, src_value := range src {
 		if src_value == nil {
 			dst[src_i] = nil
@@ -15295,19 +14446,77 @@ func deriveDeepCopy_55(dst, src []*pickle.Rick) {
 	}
 }
 
-// deriveCompare_s returns:
+// deriveDeepCopy_SliceOf_PtrTo_Tree_int recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_Tree_int(dst, src []*Tree[int]) {
+	for src_i, src_value := range src {
+		if src_value == nil {
+			dst[src_i] = nil
+		} else {
+			dst[src_i] = new(Tree[int])
+			deriveDeepCopy_PtrTo_Tree_int(dst[src_i], src_value)
+		}
+	}
+}
+
+// deriveDeepCopy_SliceOf_PtrTo_Tree_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_Tree_Name(dst, src []*Tree[Name]) {
+	for src_i, src_value := range src {
+		if src_value == nil {
+			dst[src_i] = nil
+		} else {
+			dst[src_i] = new(Tree[Name])
+			deriveDeepCopy_PtrTo_Tree_Name(dst[src_i], src_value)
+		}
+	}
+}
+
+// deriveDeepCopy_PtrTo_Pair_string_PtrTo_Name recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Pair_string_PtrTo_Name(dst, src *Pair[string, *Name]) {
+	dst.Key = src.Key
+	if src.Value == nil {
+		dst.Value = nil
+	} else {
+		dst.Value = new(Name)
+		src.Value.DeepCopy(dst.Value)
+	}
+}
+
+// deriveDeepCopy_PtrTo_Pair_int64_SliceOf_string recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Pair_int64_SliceOf_string(dst, src *Pair[int64, []string]) {
+	dst.Key = src.Key
+	if src.Value == nil {
+		dst.Value = nil
+	} else {
+		if dst.Value != nil {
+			if len(src.Value) > len(dst.Value) {
+				if cap(dst.Value) >= len(src.Value) {
+					dst.Value = (dst.Value)[:len(src.Value)]
+				} else {
+					dst.Value = make([]string, len(src.Value))
+				}
+			} else if len(src.Value) < len(dst.Value) {
+				dst.Value = (dst.Value)[:len(src.Value)]
+			}
+		} else {
+			dst.Value = make([]string, len(src.Value))
+		}
+		copy(dst.Value, src.Value)
+	}
+}
+
+// deriveCompare_string returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_s(this, that string) int {
+func deriveCompare_string(this, that string) int {
 	return strings.Compare(this, that)
 }
 
-// deriveCompare_139 returns:
+// deriveCompare_ArrayOf4_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_139(this, that [4]int) int {
+func deriveCompare_ArrayOf4_int(this, that [4]int) int {
 	if len(this) != len(that) {
 		if len(this) < len(that) {
 			return -1
@@ -15315,18 +14524,18 @@ func deriveCompare_139(this, that [4]int) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_i(this[i], that[i]); c != 0 {
+		if c := deriveCompare_int(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_140 returns:
+// deriveCompare_MapOf_int_To_int returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_140(this, that map[int]int) int {
+func deriveCompare_MapOf_int_To_int(this, that map[int]int) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -15342,18 +14551,18 @@ func deriveCompare_140(this, that map[int]int) int {
 		}
 		return 1
 	}
-	thiskeys := deriveSortedInts(deriveKeys(this))
-	thatkeys := deriveSortedInts(deriveKeys(that))
+	thiskeys := deriveSortedInts(deriveKeys_MapOf_int_To_int(this))
+	thatkeys := deriveSortedInts(deriveKeys_MapOf_int_To_int(that))
 	for i, thiskey := range thiskeys {
 		thatkey := thatkeys[i]
 		if thiskey == thatkey {
 			thisvalue := this[thiskey]
 			thatvalue := that[thatkey]
-			if c := deriveCompare_i(thisvalue, thatvalue); c != 0 {
+			if c := deriveCompare_int(thisvalue, thatvalue); c != 0 {
 				return c
 			}
 		} else {
-			if c := deriveCompare_i(thiskey, thatkey); c != 0 {
+			if c := deriveCompare_int(thiskey, thatkey); c != 0 {
 				return c
 			}
 		}
@@ -15361,11 +14570,39 @@ func deriveCompare_140(this, that map[int]int) int {
 	return 0
 }
 
-// deriveCompare_141 returns:
+// deriveCompare_SliceOf_PtrTo_pickle_Rick returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_SliceOf_PtrTo_pickle_Rick(this, that []*pickle.Rick) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if len(this) != len(that) {
+		if len(this) < len(that) {
+			return -1
+		}
+		return 1
+	}
+	for i := 0; i < len(this); i++ {
+		if c := deriveCompare_PtrTo_pickle_Rick(this[i], that[i]); c != 0 {
+			return c
+		}
+	}
+	return 0
+}
+
+// deriveCompare_SliceOf_PtrTo_Tree_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_141(this, that []*pickle.Rick) int {
+func deriveCompare_SliceOf_PtrTo_Tree_Name(this, that []*Tree[Name]) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -15382,31 +14619,116 @@ func deriveCompare_141(this, that []*pickle.Rick) int {
 		return 1
 	}
 	for i := 0; i < len(this); i++ {
-		if c := deriveCompare_142(this[i], that[i]); c != 0 {
+		if c := deriveCompare_PtrTo_Tree_Name(this[i], that[i]); c != 0 {
 			return c
 		}
 	}
 	return 0
 }
 
-// deriveCompare_N returns:
+// deriveCompare_PtrTo_Pair_string_PtrTo_Name returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_Pair_string_PtrTo_Name(this, that *Pair[string, *Name]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := strings.Compare(this.Key, that.Key); c != 0 {
+		return c
+	}
+	if c := this.Value.Compare(that.Value); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_PtrTo_Pair_int64_SliceOf_string returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_Pair_int64_SliceOf_string(this, that *Pair[int64, []string]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_int64(this.Key, that.Key); c != 0 {
+		return c
+	}
+	if c := deriveCompare_SliceOf_string(this.Value, that.Value); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_PtrTo_Pair_string_int returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_Pair_string_int(this, that *Pair[string, int]) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := strings.Compare(this.Key, that.Key); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int(this.Value, that.Value); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_Name returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_N(this, that Name) int {
+func deriveCompare_Name(this, that Name) int {
 	return (&this).Compare(&that)
 }
 
-// deriveCompare_S returns:
+// deriveCompare_StructWithoutMethod returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_S(this, that StructWithoutMethod) int {
-	return deriveCompare_107(&this, &that)
+func deriveCompare_StructWithoutMethod(this, that StructWithoutMethod) int {
+	return deriveCompare_PtrTo_StructWithoutMethod(&this, &that)
+}
+
+// deriveEqual_SliceOf_PtrTo_pickle_Rick returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_pickle_Rick(this, that []*pickle.Rick) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqual_PtrTo_pickle_Rick(this[i], that[i])) {
+			return false
+		}
+	}
+	return true
 }
 
-// deriveEqual_96 returns whether this and that are equal.
-func deriveEqual_96(this, that []*pickle.Rick) bool {
+// deriveEqual_SliceOf_PtrTo_Tree_Name returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_Tree_Name(this, that []*Tree[Name]) bool {
 	if this == nil || that == nil {
 		return this == nil && that == nil
 	}
@@ -15414,15 +14736,38 @@ func deriveEqual_96(this, that []*pickle.Rick) bool {
 		return false
 	}
 	for i := 0; i < len(this); i++ {
-		if !(deriveEqual_97(this[i], that[i])) {
+		if !(deriveEqual_PtrTo_Tree_Name(this[i], that[i])) {
 			return false
 		}
 	}
 	return true
 }
 
-// deriveHash_134 returns the hash of the object.
-func deriveHash_134(object [4]int) uint64 {
+// deriveEqual_PtrTo_Pair_string_PtrTo_Name returns whether this and that are equal.
+func deriveEqual_PtrTo_Pair_string_PtrTo_Name(this, that *Pair[string, *Name]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Key == that.Key &&
+			this.Value.Equal(that.Value)
+}
+
+// deriveEqual_PtrTo_Pair_int64_SliceOf_string returns whether this and that are equal.
+func deriveEqual_PtrTo_Pair_int64_SliceOf_string(this, that *Pair[int64, []string]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Key == that.Key &&
+			deriveEqual_SliceOf_string(this.Value, that.Value)
+}
+
+// deriveClone_PtrTo_CacheEntry recursively copies the contents of src into dst.
+func deriveClone_PtrTo_CacheEntry(dst, src *CacheEntry) {
+	// Token is skipped, since it is tagged with `derive:"clone=-"`.
+	dst.Value = src.Value
+	dst.memo = src.memo
+}
+
+// deriveHash_ArrayOf4_int returns the hash of the object.
+func deriveHash_ArrayOf4_int(object [4]int) uint64 {
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
 		h = 31*h + uint64(object[i])
@@ -15430,25 +14775,25 @@ func deriveHash_134(object [4]int) uint64 {
 	return h
 }
 
-// deriveHash_R returns the hash of the object.
-func deriveHash_R(object RecursiveType) uint64 {
+// deriveHash_RecursiveType returns the hash of the object.
+func deriveHash_RecursiveType(object RecursiveType) uint64 {
 	return deriveHashRecursiveType(&object)
 }
 
-// deriveHash_135 returns the hash of the object.
-func deriveHash_135(object []*pickle.Rick) uint64 {
+// deriveHash_SliceOf_PtrTo_pickle_Rick returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_pickle_Rick(object []*pickle.Rick) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
 	for i := 0; i < len(object); i++ {
-		h = 31*h + deriveHash_137(object[i])
+		h = 31*h + deriveHash_PtrTo_pickle_Rick(object[i])
 	}
 	return h
 }
 
-// deriveHash_136 returns the hash of the object.
-func deriveHash_136(object *privateStruct) uint64 {
+// deriveHash_PtrTo_privateStruct returns the hash of the object.
+func deriveHash_PtrTo_privateStruct(object *privateStruct) uint64 {
 	if object == nil {
 		return 0
 	}
@@ -15457,8 +14802,46 @@ func deriveHash_136(object *privateStruct) uint64 {
 	return h
 }
 
-// deriveGoString_89 returns a recursive representation of this as a valid go string.
-func deriveGoString_89(this *pickle.Rick) string {
+// deriveHash_PtrTo_Tree_Name returns the hash of the object.
+func deriveHash_PtrTo_Tree_Name(object *Tree[Name]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_Name(object.Value)
+	h = 31*h + deriveHash_SliceOf_PtrTo_Tree_Name(object.Children)
+	return h
+}
+
+// deriveHash_Pair_string_PtrTo_Name returns the hash of the object.
+func deriveHash_Pair_string_PtrTo_Name(object Pair[string, *Name]) uint64 {
+	return deriveHash_PtrTo_Pair_string_PtrTo_Name(&object)
+}
+
+// deriveHash_Pair_int64_SliceOf_string returns the hash of the object.
+func deriveHash_Pair_int64_SliceOf_string(object Pair[int64, []string]) uint64 {
+	return deriveHash_PtrTo_Pair_int64_SliceOf_string(&object)
+}
+
+// deriveHash_Pair_string_int returns the hash of the object.
+func deriveHash_Pair_string_int(object Pair[string, int]) uint64 {
+	return deriveHash_PtrTo_Pair_string_int(&object)
+}
+
+// deriveHash_PtrTo_CacheEntry returns the hash of the object.
+func deriveHash_PtrTo_CacheEntry(object *CacheEntry) uint64 {
+	if object == nil {
+		return 0
+	}
+	// memo is skipped, since it is tagged with `derive:"equal=-,hash=-,compare=-"`.
+	h := uint64(17)
+	h = 31*h + uint64(object.Value)
+	h = 31*h + deriveHash_string(object.Token)
+	return h
+}
+
+// deriveGoString_PtrTo_pickle_Rick returns a recursive representation of this as a valid go string.
+func deriveGoString_PtrTo_pickle_Rick(this *pickle.Rick) string {
 	buf := bytes.NewBuffer(nil)
 	fmt.Fprintf(buf, "func() *pickle.Rick {\n")
 	if this == nil {
@@ -15472,11 +14855,11 @@ func deriveGoString_89(this *pickle.Rick) string {
 	return buf.String()
 }
 
-// deriveCompare_142 returns:
+// deriveCompare_PtrTo_pickle_Rick returns:
 //   - 0 if this and that are equal,
 //   - -1 is this is smaller and
 //   - +1 is this is bigger.
-func deriveCompare_142(this, that *pickle.Rick) int {
+func deriveCompare_PtrTo_pickle_Rick(this, that *pickle.Rick) int {
 	if this == nil {
 		if that == nil {
 			return 0
@@ -15492,19 +14875,97 @@ func deriveCompare_142(this, that *pickle.Rick) int {
 	return 0
 }
 
-// deriveEqual_97 returns whether this and that are equal.
-func deriveEqual_97(this, that *pickle.Rick) bool {
+// deriveEqual_PtrTo_pickle_Rick returns whether this and that are equal.
+func deriveEqual_PtrTo_pickle_Rick(this, that *pickle.Rick) bool {
 	return (this == nil && that == nil) ||
 		this != nil && that != nil &&
 			this.Portal == that.Portal
 }
 
-// deriveHash_137 returns the hash of the object.
-func deriveHash_137(object *pickle.Rick) uint64 {
+// deriveHash_PtrTo_pickle_Rick returns the hash of the object.
+func deriveHash_PtrTo_pickle_Rick(object *pickle.Rick) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_string(object.Portal)
+	return h
+}
+
+// deriveHash_SliceOf_PtrTo_Tree_Name returns the hash of the object.
+func deriveHash_SliceOf_PtrTo_Tree_Name(object []*Tree[Name]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + deriveHash_PtrTo_Tree_Name(object[i])
+	}
+	return h
+}
+
+// deriveHash_PtrTo_Pair_string_PtrTo_Name returns the hash of the object.
+func deriveHash_PtrTo_Pair_string_PtrTo_Name(object *Pair[string, *Name]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_string(object.Key)
+	h = 31*h + deriveHashName(object.Value)
+	return h
+}
+
+// deriveHash_PtrTo_Pair_int64_SliceOf_string returns the hash of the object.
+func deriveHash_PtrTo_Pair_int64_SliceOf_string(object *Pair[int64, []string]) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + uint64(object.Key)
+	h = 31*h + deriveHash_SliceOf_string(object.Value)
+	return h
+}
+
+// deriveHash_PtrTo_Pair_string_int returns the hash of the object.
+func deriveHash_PtrTo_Pair_string_int(object *Pair[string, int]) uint64 {
 	if object == nil {
 		return 0
 	}
 	h := uint64(17)
-	h = 31*h + deriveHash_s(object.Portal)
+	h = 31*h + deriveHash_string(object.Key)
+	h = 31*h + uint64(object.Value)
 	return h
 }
+
+// Equal returns whether this and that are equal.
+func (this *Methods) Equal(that *Methods) bool {
+	return deriveEqual_PtrTo_Methods(this, that)
+}
+
+// Compare returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func (this *Methods) Compare(that *Methods) int {
+	return deriveCompare_PtrTo_Methods(this, that)
+}
+
+// Hash returns the hash of the object.
+func (this *Methods) Hash() uint64 {
+	return deriveHash_PtrTo_Methods(this)
+}
+
+// GoString returns a recursive representation of this as a valid go string.
+func (this *Methods) GoString() string {
+	return deriveGoString_PtrTo_Methods(this)
+}
+
+// DeepCopy recursively copies the contents of this into that.
+func (this *Methods) DeepCopy(that *Methods) {
+	deriveDeepCopy_PtrTo_Methods(that, this)
+}
+
+// Clone returns a clone of this.
+func (this *Methods) Clone() *Methods {
+	return deriveClone_PtrTo_Methods(this)
+}
@@ -0,0 +1,1940 @@
+// Code generated by goderive DO NOT EDIT.
+
+package test
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// deriveTakeWhile returns the prefix of the list, where each item matches the predicate.
+//
+// Deprecated: In favour of generics.
+func deriveTakeWhile(predicate func(int) bool, list []int) []int {
+	out := make([]int, 0, len(list))
+	for i, elem := range list {
+		if !predicate(elem) {
+			break
+		}
+		out = append(out, list[i])
+	}
+	return out
+}
+
+// deriveIntersectSetOfInt64s returns the intersection of the two maps' keys.
+//
+// Deprecated: In favour of generics.
+func deriveIntersectSetOfInt64s(this, that map[int64]struct{}) map[int64]struct{} {
+	intersect := make(map[int64]struct{}, deriveMinInt(len(this), len(that)))
+	for k := range this {
+		if _, ok := that[k]; ok {
+			intersect[k] = struct{}{}
+		}
+	}
+	return intersect
+}
+
+// deriveIntersectOfInt64s returns the intersection of the two lists' values
+// It assumes that the first list only contains unique items.
+//
+// Deprecated: In favour of generics.
+func deriveIntersectOfInt64s(this, that []int64) []int64 {
+	intersect := make([]int64, 0, deriveMinInt(len(this), len(that)))
+	for i, v := range this {
+		if deriveContainsInt64s(that, v) {
+			intersect = append(intersect, this[i])
+		}
+	}
+	return intersect
+}
+
+// deriveTraverse returns a list where each element of the input list has been morphed by the input function or an error.
+func deriveTraverse(f func(string) (int, error), list []string) ([]int, error) {
+	out := make([]int, len(list))
+	var err error
+	for i, elem := range list {
+		out[i], err = f(elem)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// derivePipeline composes f and g into a concurrent pipeline.
+func derivePipeline(f func(lines []string) <-chan string, g func(line string) <-chan int) func([]string) <-chan int {
+	return func(a []string) <-chan int {
+		b := f(a)
+		return deriveJoinChannels(deriveFmapChanChan(g, b))
+	}
+}
+
+// deriveGoStringTreeOfPair returns a recursive representation of this as a valid go string.
+func deriveGoStringTreeOfPair(this *Tree[Pair[string, *Name]]) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *test.Tree[test.Pair[string, *test.Name]] {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &test.Tree[test.Pair[string, *test.Name]]{}\n")
+		fmt.Fprintf(buf, "this.Value = %s\n", deriveGoString_Pair_string_PtrTo_Name(this.Value))
+		if this.Children != nil {
+			fmt.Fprintf(buf, "this.Children = %s\n", deriveGoString_SliceOf_PtrTo_Tree_Pair_string_PtrTo_Name(this.Children))
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntArray returns a recursive representation of this as a valid go string.
+func deriveGoStringIntArray(this [10]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() [10]int {\n")
+	fmt.Fprintf(buf, "return %#v\n", this)
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringMapOfIntToInt returns a recursive representation of this as a valid go string.
+func deriveGoStringMapOfIntToInt(this map[int]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() map[int]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "return %#v\n", this)
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringIntPtrArray returns a recursive representation of this as a valid go string.
+func deriveGoStringIntPtrArray(this *[10]int) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *[10]int {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := new([10]int)\n")
+		fmt.Fprintf(buf, "*this = %#v\n", *this)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringNoPointerStruct returns a recursive representation of this as a valid go string.
+func deriveGoStringNoPointerStruct(this BuiltInTypes) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.BuiltInTypes {\n")
+	fmt.Fprintf(buf, "this := &test.BuiltInTypes{}\n")
+	fmt.Fprintf(buf, "this.Bool = %#v\n", this.Bool)
+	fmt.Fprintf(buf, "this.Byte = %#v\n", this.Byte)
+	fmt.Fprintf(buf, "this.Complex128 = %#v\n", this.Complex128)
+	fmt.Fprintf(buf, "this.Complex64 = %#v\n", this.Complex64)
+	fmt.Fprintf(buf, "this.Float64 = %#v\n", this.Float64)
+	fmt.Fprintf(buf, "this.Float32 = %#v\n", this.Float32)
+	fmt.Fprintf(buf, "this.Int = %#v\n", this.Int)
+	fmt.Fprintf(buf, "this.Int16 = %#v\n", this.Int16)
+	fmt.Fprintf(buf, "this.Int32 = %#v\n", this.Int32)
+	fmt.Fprintf(buf, "this.Int64 = %#v\n", this.Int64)
+	fmt.Fprintf(buf, "this.Int8 = %#v\n", this.Int8)
+	fmt.Fprintf(buf, "this.Rune = %#v\n", this.Rune)
+	fmt.Fprintf(buf, "this.String = %#v\n", this.String)
+	fmt.Fprintf(buf, "this.Uint = %#v\n", this.Uint)
+	fmt.Fprintf(buf, "this.Uint16 = %#v\n", this.Uint16)
+	fmt.Fprintf(buf, "this.Uint32 = %#v\n", this.Uint32)
+	fmt.Fprintf(buf, "this.Uint64 = %#v\n", this.Uint64)
+	fmt.Fprintf(buf, "this.Uint8 = %#v\n", this.Uint8)
+	fmt.Fprintf(buf, "this.UintPtr = %#v\n", this.UintPtr)
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoStringScore returns a recursive representation of this as a valid go string.
+func deriveGoStringScore(this *Score) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() *test.Score {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := &test.Score{}\n")
+		fmt.Fprintf(buf, "this.Name = %#v\n", this.Name)
+		fmt.Fprintf(buf, "this.Points = %#v\n", this.Points)
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveDeepCopySimpleStructWithDeepCopy recursively copies the contents of src into dst.
+func deriveDeepCopySimpleStructWithDeepCopy(dst, src *SimpleStructWithDeepCopy) {
+	dst.Level = src.Level
+}
+
+// deriveDeepCopyAliasWithMethod recursively copies the contents of src into dst.
+func deriveDeepCopyAliasWithMethod(dst, src *aliasWithMethod) {
+	dst.Level = src.Level
+}
+
+// deriveContainsInt64s returns whether the item is contained in the list.
+//
+// Deprecated: In favour of generics.
+func deriveContainsInt64s(list []int64, item int64) bool {
+	for _, v := range list {
+		if v == item {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveContainsStruct returns whether the item is contained in the list.
+//
+// Deprecated: In favour of generics.
+func deriveContainsStruct(list []*BuiltInTypes, item *BuiltInTypes) bool {
+	for _, v := range list {
+		if deriveEqualPtrToBuiltInTypes(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveContainsStructPtr returns whether the item is contained in the list.
+//
+// Deprecated: In favour of generics.
+func deriveContainsStructPtr(list []PtrToBuiltInTypes, item PtrToBuiltInTypes) bool {
+	for _, v := range list {
+		if deriveEqual_PtrToBuiltInTypes(v, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveUncurryMarshal combines a function that returns a function, into one function.
+func deriveUncurryMarshal(f func(data []byte) func(v any) error) func(data []byte, v any) error {
+	return func(data []byte, v any) error {
+		return f(data)(v)
+	}
+}
+
+// deriveUncurry3 combines a function that returns a function, into one function.
+func deriveUncurry3(f func(a int) func(b string, c bool) string) func(a int, b string, c bool) string {
+	return func(a int, b string, c bool) string {
+		return f(a)(b, c)
+	}
+}
+
+// deriveUncurryCurried combines a function that returns a function, into one function.
+func deriveUncurryCurried(f func(b string) func(c bool) string) func(b string, c bool) string {
+	return func(b string, c bool) string {
+		return f(b)(c)
+	}
+}
+
+// deriveUncurryBlankIdentifier combines a function that returns a function, into one function.
+func deriveUncurryBlankIdentifier(f func(param_0 string) func(innerParam_0 bool, c int) string) func(param_0 string, innerParam_0 bool, c int) string {
+	return func(param_0 string, innerParam_0 bool, c int) string {
+		return f(param_0)(innerParam_0, c)
+	}
+}
+
+// deriveToError0 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError0(err error, f func() bool) func() error {
+	return func() error {
+		success := f()
+		if success {
+			return nil
+		}
+		return err
+	}
+}
+
+// deriveToError1 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError1(err error, f func() (int, bool)) func() (int, error) {
+	return func() (int, error) {
+		out0, success := f()
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveToError2 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError2(err error, f func(a int) (int, bool)) func(a int) (int, error) {
+	return func(a int) (int, error) {
+		out0, success := f(a)
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveToError3 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError3(err error, f func(a int, b int) (int, bool)) func(a int, b int) (int, error) {
+	return func(a int, b int) (int, error) {
+		out0, success := f(a, b)
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveToError4 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError4(err error, f func(a int, b int) (int, int, bool)) func(a int, b int) (int, int, error) {
+	return func(a int, b int) (int, int, error) {
+		out0, out1, success := f(a, b)
+		if success {
+			return out0, out1, nil
+		}
+		return out0, out1, err
+	}
+}
+
+// deriveToError5 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError5(err error, f func(lt *LocalType) (*LocalType, bool)) func(lt *LocalType) (*LocalType, error) {
+	return func(lt *LocalType) (*LocalType, error) {
+		out0, success := f(lt)
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveToError6 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError6(err error, f func(t *time.Time) (*time.Time, bool)) func(t *time.Time) (*time.Time, error) {
+	return func(t *time.Time) (*time.Time, error) {
+		out0, success := f(t)
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveToError7 transforms the given function's last bool type into an error type. The transformed function returns the given error when the result of the given function is false, otherwise it returns nil.
+func deriveToError7(err error, f func(param_0 string) (string, bool)) func(param_0 string) (string, error) {
+	return func(param_0 string) (string, error) {
+		out0, success := f(param_0)
+		if success {
+			return out0, nil
+		}
+		return out0, err
+	}
+}
+
+// deriveCompose composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveCompose(f0 func() (string, error), f1 func(string) (float64, error)) func() (float64, error) {
+	return func() (float64, error) {
+		v_1_0, err0 := f0()
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveComposeA composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveComposeA(f0 func(string) (string, error), f1 func(string) (float64, error)) func(string) (float64, error) {
+	return func(v_0_0 string) (float64, error) {
+		v_1_0, err0 := f0(v_0_0)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveCompose2 composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveCompose2(f0 func(string, string) ([]string, string, error), f1 func([]string, string) (float64, error)) func(string, string) (float64, error) {
+	return func(v_0_0 string, v_0_1 string) (float64, error) {
+		v_1_0, v_1_1, err0 := f0(v_0_0, v_0_1)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0, v_1_1)
+		if err1 != nil {
+			return 0, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveComposeRetBool composes functions f0 and f1 into one function, that takes the parameters from f0 and returns the results from f1.
+func deriveComposeRetBool(f0 func(string) (string, error), f1 func(string) (bool, error)) func(string) (bool, error) {
+	return func(v_0_0 string) (bool, error) {
+		v_1_0, err0 := f0(v_0_0)
+		if err0 != nil {
+			return false, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return false, err1
+		}
+		return v_2_0, nil
+	}
+}
+
+// deriveComposeVariadic composes functions f0, f1 and f2 into one function, that takes the parameters from f0 and returns the results from f2.
+func deriveComposeVariadic(f0 func(string) (string, error), f1 func(string) (float64, error), f2 func(float64) (int, error)) func(string) (int, error) {
+	return func(v_0_0 string) (int, error) {
+		v_1_0, err0 := f0(v_0_0)
+		if err0 != nil {
+			return 0, err0
+		}
+		v_2_0, err1 := f1(v_1_0)
+		if err1 != nil {
+			return 0, err1
+		}
+		v_3_0, err2 := f2(v_2_0)
+		if err2 != nil {
+			return 0, err2
+		}
+		return v_3_0, nil
+	}
+}
+
+// deriveCompareCurryComplex64 returns a curried compare function, which returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompareCurryComplex64(this complex128) func(complex128) int {
+	return func(that complex128) int {
+		if thisr, thatr := real(this), real(that); thisr == thatr {
+			if thisi, thati := imag(this), imag(that); thisi == thati {
+				return 0
+			} else if thisi < thati {
+				return -1
+			} else {
+				return 1
+			}
+		} else if thisr < thatr {
+			return -1
+		} else {
+			return 1
+		}
+	}
+}
+
+// deriveCompareStringAlias returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompareStringAlias(this, that stringAlias) int {
+	return strings.Compare(string(this), string(that))
+}
+
+// deriveCompareStructWithStringAlias returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompareStructWithStringAlias(this, that StructWithStringAlias) int {
+	return deriveCompare_PtrTo_StructWithStringAlias(&this, &that)
+}
+
+// deriveCompareDeriveTheDerived returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompareDeriveTheDerived(this, that *DeriveTheDerived) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := deriveCompare_int(this.Field, that.Field); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompareScore returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompareScore(this, that Score) int {
+	return deriveCompare_PtrTo_Score(&this, &that)
+}
+
+// deriveUniqueInt64s returns a list containing only the unique items from the input list.
+// It does this by reusing the input list.
+//
+// Deprecated: In favour of generics.
+func deriveUniqueInt64s(list []int64) []int64 {
+	if len(list) == 0 {
+		return nil
+	}
+	return deriveKeysForInt64s(deriveSetInt64s(list))
+}
+
+// deriveUniqueStructs returns a list containing only the unique items from the input list.
+// It does this by reusing the input list.
+//
+// Deprecated: In favour of generics.
+func deriveUniqueStructs(list []*BuiltInTypes) []*BuiltInTypes {
+	if len(list) == 0 {
+		return nil
+	}
+	table := make(map[uint64][]int)
+	u := 0
+	for i := 0; i < len(list); i++ {
+		contains := false
+		hash := deriveHashBuiltInTypes(list[i])
+		indexes := table[hash]
+		for _, index := range indexes {
+			if deriveEqualPtrToBuiltInTypes(list[index], list[i]) {
+				contains = true
+				break
+			}
+		}
+		if contains {
+			continue
+		}
+		if i != u {
+			list[u] = list[i]
+		}
+		table[hash] = append(table[hash], u)
+		u++
+	}
+	return list[:u]
+}
+
+// deriveUniqueStructsPtrs returns a list containing only the unique items from the input list.
+// It does this by reusing the input list.
+//
+// Deprecated: In favour of generics.
+func deriveUniqueStructsPtrs(list []PtrToBuiltInTypes) []PtrToBuiltInTypes {
+	if len(list) == 0 {
+		return nil
+	}
+	table := make(map[uint64][]int)
+	u := 0
+	for i := 0; i < len(list); i++ {
+		contains := false
+		hash := deriveHash_PtrToBuiltInTypes(list[i])
+		indexes := table[hash]
+		for _, index := range indexes {
+			if deriveEqual_PtrToBuiltInTypes(list[index], list[i]) {
+				contains = true
+				break
+			}
+		}
+		if contains {
+			continue
+		}
+		if i != u {
+			list[u] = list[i]
+		}
+		table[hash] = append(table[hash], u)
+		u++
+	}
+	return list[:u]
+}
+
+// deriveFilter returns a list of all items in the list that matches the predicate.
+//
+// Deprecated: In favour of generics.
+func deriveFilter(predicate func(int) bool, list []int) []int {
+	j := 0
+	for i, elem := range list {
+		if predicate(elem) {
+			if i != j {
+				list[j] = list[i]
+			}
+			j++
+		}
+	}
+	return list[:j]
+}
+
+// deriveFilterJudy returns a list of all items in the list that matches the predicate.
+//
+// Deprecated: In favour of generics.
+func deriveFilterJudy(predicate func(string) bool, list []string) []string {
+	j := 0
+	for i, elem := range list {
+		if predicate(elem) {
+			if i != j {
+				list[j] = list[i]
+			}
+			j++
+		}
+	}
+	return list[:j]
+}
+
+// deriveUnionSetOfInt64s returns the union of two maps, with respect to the keys.
+// It does this by adding the keys to the first map.
+//
+// Deprecated: In favour of generics.
+func deriveUnionSetOfInt64s(union, that map[int64]struct{}) map[int64]struct{} {
+	for k := range that {
+		union[k] = struct{}{}
+	}
+	return union
+}
+
+// deriveUnionOfInt64s returns the union of the items of the two input lists.
+// It does this by append items to the first list.
+//
+// Deprecated: In favour of generics.
+func deriveUnionOfInt64s(this, that []int64) []int64 {
+	for i, v := range that {
+		if !deriveContainsInt64s(this, v) {
+			this = append(this, that[i])
+		}
+	}
+	return this
+}
+
+// deriveTuple1 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple1(v0 int) func() int {
+	return func() int {
+		return v0
+	}
+}
+
+// deriveTuple2 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple2(v0 int, v1 string) func() (int, string) {
+	return func() (int, string) {
+		return v0, v1
+	}
+}
+
+// deriveTuple3 returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple3(v0 int, v1 string, v2 *BuiltInTypes) func() (int, string, *BuiltInTypes) {
+	return func() (int, string, *BuiltInTypes) {
+		return v0, v1, v2
+	}
+}
+
+// deriveTupleError returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTupleError(v0 []byte, v1 error) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return v0, v1
+	}
+}
+
+// deriveEqualInefficientDeriveTheDerived returns whether this and that are equal.
+func deriveEqualInefficientDeriveTheDerived(this, that int) bool {
+	return this == that
+}
+
+// deriveEqualPtrToint returns whether this and that are equal.
+func deriveEqualPtrToint(this, that *int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		return *this == *that
+	}
+	return false
+}
+
+// deriveEqualPtrToSliceOfint returns whether this and that are equal.
+func deriveEqualPtrToSliceOfint(this, that *[]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		if *this == nil || *that == nil {
+			return *this == nil && *that == nil
+		}
+		if len(*this) != len(*that) {
+			return false
+		}
+		for i := 0; i < len(*this); i++ {
+			if !((*this)[i] == (*that)[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqualPtrToArray10Ofint returns whether this and that are equal.
+func deriveEqualPtrToArray10Ofint(this, that *[10]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		for i := 0; i < len(*this); i++ {
+			if !((*this)[i] == (*that)[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqualPtrToMapOfintToint returns whether this and that are equal.
+func deriveEqualPtrToMapOfintToint(this, that *map[int]int) bool {
+	if this == nil && that == nil {
+		return true
+	}
+	if this != nil && that != nil {
+		if *this == nil || *that == nil {
+			return *this == nil && *that == nil
+		}
+		if len(*this) != len(*that) {
+			return false
+		}
+		for k, v := range *this {
+			thatv, ok := (*that)[k]
+			if !ok {
+				return false
+			}
+			if !(v == thatv) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// deriveEqual1 returns whether this and that are equal.
+func deriveEqual1(this, that BuiltInTypes) bool {
+	return (&this).Equal(&that)
+}
+
+// deriveEqualCurry returns an equal closure, with the first parameter already filled in.
+func deriveEqualCurry(this *BuiltInTypes) func(*BuiltInTypes) bool {
+	return func(that *BuiltInTypes) bool {
+		return (this == nil && that == nil) ||
+			this != nil && that != nil &&
+				this.Bool == that.Bool &&
+				this.Byte == that.Byte &&
+				this.Complex128 == that.Complex128 &&
+				this.Complex64 == that.Complex64 &&
+				this.Float64 == that.Float64 &&
+				this.Float32 == that.Float32 &&
+				this.Int == that.Int &&
+				this.Int16 == that.Int16 &&
+				this.Int32 == that.Int32 &&
+				this.Int64 == that.Int64 &&
+				this.Int8 == that.Int8 &&
+				this.Rune == that.Rune &&
+				this.String == that.String &&
+				this.Uint == that.Uint &&
+				this.Uint16 == that.Uint16 &&
+				this.Uint32 == that.Uint32 &&
+				this.Uint64 == that.Uint64 &&
+				this.Uint8 == that.Uint8 &&
+				this.UintPtr == that.UintPtr
+	}
+}
+
+// deriveEqualMapTypes returns whether this and that are equal.
+func deriveEqualMapTypes(this, that *SomeJson) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Other.Equal(that.Other)
+}
+
+// deriveEqualVisitor returns whether this and that are equal.
+func deriveEqualVisitor(this, that Visitor) bool {
+	return deriveEqual_PtrTo_Visitor(&this, &that)
+}
+
+// deriveEqualTreeOfString returns whether this and that are equal.
+func deriveEqualTreeOfString(this, that *Tree[string]) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Value == that.Value &&
+			deriveEqual_SliceOf_PtrTo_Tree_string(this.Children, that.Children)
+}
+
+// deriveEqualLatestVersions returns whether this and that are equal.
+func deriveEqualLatestVersions(this, that *LatestVersions) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name.Equal(that.Name) &&
+			this.Count.Equal(that.Count)
+}
+
+// deriveEqualScore returns whether this and that are equal.
+func deriveEqualScore(this, that Score) bool {
+	return deriveEqualPtrToScore(&this, &that)
+}
+
+// deriveEqualPtrToScore returns whether this and that are equal.
+func deriveEqualPtrToScore(this, that *Score) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			this.Name == that.Name &&
+			this.Points == that.Points
+}
+
+// deriveEqual_PtrToBuiltInTypes returns whether this and that are equal.
+func deriveEqual_PtrToBuiltInTypes(this, that PtrToBuiltInTypes) bool {
+	return (&this).Equal(&that)
+}
+
+// deriveCurryMarshal returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurryMarshal(f func(data []byte, v any) error) func(data []byte) func(v any) error {
+	return func(data []byte) func(v any) error {
+		return func(v any) error {
+			return f(data, v)
+		}
+	}
+}
+
+// deriveCurry3 returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurry3(f func(a int, b string, c bool) string) func(a int) func(b string, c bool) string {
+	return func(a int) func(b string, c bool) string {
+		return func(b string, c bool) string {
+			return f(a, b, c)
+		}
+	}
+}
+
+// deriveCurryCurried returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurryCurried(f func(b string, c bool) string) func(b string) func(c bool) string {
+	return func(b string) func(c bool) string {
+		return func(c bool) string {
+			return f(b, c)
+		}
+	}
+}
+
+// deriveCurryBlackIdentifier returns a function that has one parameter, which corresponds to the input functions first parameter, and a result that is a function, which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveCurryBlackIdentifier(f func(param_0 string, param_1 bool, param_2 int) string) func(param_0 string) func(param_1 bool, param_2 int) string {
+	return func(param_0 string) func(param_1 bool, param_2 int) string {
+		return func(param_1 bool, param_2 int) string {
+			return f(param_0, param_1, param_2)
+		}
+	}
+}
+
+// deriveCloneSliceOfint returns a clone of the src parameter.
+func deriveCloneSliceOfint(src []int) []int {
+	if src == nil {
+		return nil
+	}
+	dst := make([]int, len(src))
+	deriveDeepCopy_SliceOf_int(dst, src)
+	return dst
+}
+
+// deriveCloneMapOfintToint returns a clone of the src parameter.
+func deriveCloneMapOfintToint(src map[int]int) map[int]int {
+	if src == nil {
+		return nil
+	}
+	dst := make(map[int]int)
+	deriveDeepCopy_MapOf_int_To_int(dst, src)
+	return dst
+}
+
+// deriveClonePtrToint returns a clone of the src parameter.
+func deriveClonePtrToint(src *int) *int {
+	if src == nil {
+		return nil
+	}
+	dst := new(int)
+	deriveDeepCopy_PtrTo_int(dst, src)
+	return dst
+}
+
+// deriveClonePtrToSliceOfint returns a clone of the src parameter.
+func deriveClonePtrToSliceOfint(src *[]int) *[]int {
+	if src == nil {
+		return nil
+	}
+	dst := new([]int)
+	deriveDeepCopy_PtrTo_SliceOf_int(dst, src)
+	return dst
+}
+
+// deriveClonePtrToArray10Ofint returns a clone of the src parameter.
+func deriveClonePtrToArray10Ofint(src *[10]int) *[10]int {
+	if src == nil {
+		return nil
+	}
+	dst := new([10]int)
+	deriveDeepCopy_PtrTo_ArrayOf10_int(dst, src)
+	return dst
+}
+
+// deriveClonePtrToMapOfintToint returns a clone of the src parameter.
+func deriveClonePtrToMapOfintToint(src *map[int]int) *map[int]int {
+	if src == nil {
+		return nil
+	}
+	dst := new(map[int]int)
+	deriveDeepCopy_PtrTo_MapOf_int_To_int(dst, src)
+	return dst
+}
+
+// deriveClone1 returns a clone of the src parameter.
+func deriveClone1(src BuiltInTypes) BuiltInTypes {
+	dst := new(BuiltInTypes)
+	deriveDeepCopyPtrToBuiltInTypes(dst, &src)
+	return *dst
+}
+
+// deriveCloneTreeOfString returns a clone of the src parameter.
+func deriveCloneTreeOfString(src *Tree[string]) *Tree[string] {
+	if src == nil {
+		return nil
+	}
+	dst := new(Tree[string])
+	deriveDeepCopy_PtrTo_Tree_string(dst, src)
+	return dst
+}
+
+// deriveCloneScore returns a clone of the src parameter.
+func deriveCloneScore(src *Score) *Score {
+	if src == nil {
+		return nil
+	}
+	dst := new(Score)
+	deriveDeepCopy_PtrTo_Score(dst, src)
+	return dst
+}
+
+// deriveApplyMarshal applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveApplyMarshal(f func(v any) ([]byte, error), v any) func() ([]byte, error) {
+	return func() ([]byte, error) {
+		return f(v)
+	}
+}
+
+// deriveApplyMultiple applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveApplyMultiple(f func(a int, b string, c bool) string, c bool) func(a int, b string) string {
+	return func(a int, b string) string {
+		return f(a, b, c)
+	}
+}
+
+// deriveApply3 applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveApply3(f func(a string, b int, c bool) string, c bool) func(a string, b int) string {
+	return func(a string, b int) string {
+		return f(a, b, c)
+	}
+}
+
+// deriveApplyApplied applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveApplyApplied(f func(a string, b int) string, b int) func(a string) string {
+	return func(a string) string {
+		return f(a, b)
+	}
+}
+
+// deriveApplyBlankIdentifier applies the second argument to a given function's last argument and returns a function which which takes the rest of the parameters as input and finally returns the original input function's results.
+func deriveApplyBlankIdentifier(f func(a string, param_1 bool, c int) string, c int) func(a string, param_1 bool) string {
+	return func(a string, param_1 bool) string {
+		return f(a, param_1, c)
+	}
+}
+
+// deriveSortInt64s sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortInt64s(list []int64) []int64 {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSortStructs sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortStructs(list []*BuiltInTypes) []*BuiltInTypes {
+	sort.Slice(list, func(i, j int) bool { return deriveComparePtrToBuiltInTypes(list[i], list[j]) < 0 })
+	return list
+}
+
+// deriveSortedSliceIntAlias sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortedSliceIntAlias(list []intAlias) []intAlias {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSortedSliceStringAlias sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortedSliceStringAlias(list []stringAlias) []stringAlias {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSortedSliceFloat64Alias sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortedSliceFloat64Alias(list []float64Alias) []float64Alias {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveSortedStringKeyAlias sorts the slice inplace and also returns it.
+//
+// Deprecated: In favour of generics.
+func deriveSortedStringKeyAlias(list []stringKeyAlias) []stringKeyAlias {
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
+
+// deriveKeysForInt64s returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForInt64s(m map[int64]struct{}) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForFmap returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForFmap(m map[int]string) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapStringToString returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForMapStringToString(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapStringAliasToString returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForMapStringAliasToString(m map[stringKeyAlias]string) []stringKeyAlias {
+	keys := make([]stringKeyAlias, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapStringToStringAlias returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForMapStringToStringAlias(m map[string]stringAlias) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapIntToInt64 returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForMapIntToInt64(m map[int]int64) []int {
+	keys := make([]int, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveKeysForMapInt64ToInt64 returns the keys of the input map as a slice.
+//
+// Deprecated: In favour of generics.
+func deriveKeysForMapInt64ToInt64(m map[int64]int64) []int64 {
+	keys := make([]int64, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// deriveJoinSS concatenates the list of lists into one list.
+func deriveJoinSS(listOfLists [][]string) []string {
+	if listOfLists == nil {
+		return nil
+	}
+	l := 0
+	for _, elem := range listOfLists {
+		l += len(elem)
+	}
+	res := make([]string, 0, l)
+	for _, elem := range listOfLists {
+		res = append(res, elem...)
+	}
+	return res
+}
+
+// deriveJoinEE returns the error or calls f and returns it's value and error.
+func deriveJoinEE(f func() (int64, error), err error) (int64, error) {
+	if err != nil {
+		return 0, err
+	}
+	return f()
+}
+
+// deriveJoinChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
+func deriveJoinChannels(in <-chan (<-chan int)) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoin concatenates the list of lists into one list.
+func deriveJoin(listOfLists [][]int) []int {
+	if listOfLists == nil {
+		return nil
+	}
+	l := 0
+	for _, elem := range listOfLists {
+		l += len(elem)
+	}
+	res := make([]int, 0, l)
+	for _, elem := range listOfLists {
+		res = append(res, elem...)
+	}
+	return res
+}
+
+// deriveJoinString concatenates the list of strings into one string.
+func deriveJoinString(list []string) string {
+	return strings.Join(list, "")
+}
+
+// deriveJoinJustError returns the error or calls f and returns it's error.
+func deriveJoinJustError(f func() error, err error) error {
+	if err != nil {
+		return err
+	}
+	return f()
+}
+
+// deriveJoinErrorAndString returns the error or calls f and returns it's value and error.
+func deriveJoinErrorAndString(f func() (string, error), err error) (string, error) {
+	if err != nil {
+		return "", err
+	}
+	return f()
+}
+
+// deriveJoinErrorAndValues returns the error or calls f and returns it's value and error.
+func deriveJoinErrorAndValues(f func() (string, int, error), err error) (string, int, error) {
+	if err != nil {
+		return "", 0, err
+	}
+	return f()
+}
+
+// deriveJoinSendRecvChannels listens on all channels resulting from the input channel and sends all their results on the output channel.
+func deriveJoinSendRecvChannels(in chan (<-chan int64)) <-chan int64 {
+	out := make(chan int64)
+	go func() {
+		wait := sync.WaitGroup{}
+		for c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinSliceOfRecvChannels listens on all input channels and sends all their results onto the single output channel.
+func deriveJoinSliceOfRecvChannels(in []<-chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for _, c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinSliceOfSendRecvChannels listens on all input channels and sends all their results onto the single output channel.
+func deriveJoinSliceOfSendRecvChannels(in []chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		wait := sync.WaitGroup{}
+		for _, c := range in {
+			wait.Add(1)
+			res := c
+			go func() {
+				for r := range res {
+					out <- r
+				}
+				wait.Done()
+			}()
+		}
+		wait.Wait()
+		close(out)
+	}()
+	return out
+}
+
+// deriveJoinVariantOfSendRecvChannels listens on all input channels c0 and c1, and sends all their results onto the single output channel.
+func deriveJoinVariantOfSendRecvChannels(c0 chan int, c1 chan int) <-chan int {
+	out := make(chan int)
+	go func() {
+		for c0 != nil || c1 != nil {
+			select {
+			case v0, ok0 := <-c0:
+				if !ok0 {
+					c0 = nil
+				} else {
+					out <- v0
+				}
+			case v1, ok1 := <-c1:
+				if !ok1 {
+					c1 = nil
+				} else {
+					out <- v1
+				}
+			}
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveHashPtrToArray10Ofint returns the hash of the object.
+func deriveHashPtrToArray10Ofint(object *[10]int) uint64 {
+	if object == nil {
+		return 0
+	}
+	return (31 * 17) + deriveHash_ArrayOf10_int(*object)
+}
+
+// deriveHash1 returns the hash of the object.
+func deriveHash1(object BuiltInTypes) uint64 {
+	return deriveHashBuiltInTypes(&object)
+}
+
+// deriveHashScore returns the hash of the object.
+func deriveHashScore(object *Score) uint64 {
+	if object == nil {
+		return 0
+	}
+	h := uint64(17)
+	h = 31*h + deriveHash_string(object.Name)
+	h = 31*h + uint64(object.Points)
+	return h
+}
+
+// deriveHash_PtrToBuiltInTypes returns the hash of the object.
+func deriveHash_PtrToBuiltInTypes(object PtrToBuiltInTypes) uint64 {
+	return deriveHashPtrToBuiltInTypes(&object)
+}
+
+// deriveFmapForKeys returns a list where each element of the input list has been morphed by the input function.
+func deriveFmapForKeys(f func(int) string, list []int) []string {
+	out := make([]string, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmap returns a list where each element of the input list has been morphed by the input function.
+func deriveFmap(f func(int) int, list []int) []int {
+	out := make([]int, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapString morphs a string into list by apply the input function to each rune.
+func deriveFmapString(f func(rune) bool, ss string) []bool {
+	out := make([]bool, len([]rune(ss)))
+	for i, elem := range ss {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapError returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapError(f func(int) int64, g func() (int, error)) (int64, error) {
+	v, err := g()
+	if err != nil {
+		return 0, err
+	}
+	return f(v), nil
+}
+
+// deriveFmapEE returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapEE(f func(string) (int, error), g func() (string, error)) (func() (int, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple_int_error(f(v)), nil
+}
+
+// deriveFmapPrint returns an error if g returns one, otherwise it applies f to g's result.
+func deriveFmapPrint(f func(string), g func() (string, error)) error {
+	v, err := g()
+	if err != nil {
+		return err
+	}
+	f(v)
+	return nil
+}
+
+// deriveFmapMore returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapMore(f func(string) (int, string, error), g func() (string, error)) (func() (int, string, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple_int_string_error(f(v)), nil
+}
+
+// deriveFmapChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
+func deriveFmapChan(f func(string) int, in <-chan string) <-chan int {
+	out := make(chan int, cap(in))
+	go func() {
+		for a := range in {
+			b := f(a)
+			out <- b
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveFmapSS returns a list where each element of the input list has been morphed by the input function.
+func deriveFmapSS(f func(string) []string, list []string) [][]string {
+	out := make([][]string, len(list))
+	for i, elem := range list {
+		out[i] = f(elem)
+	}
+	return out
+}
+
+// deriveFmapEE64 returns an error if g returns one, otherwise it applies f to g's result and returns it.
+func deriveFmapEE64(f func(string) (int64, error), g func() (string, error)) (func() (int64, error), error) {
+	v, err := g()
+	if err != nil {
+		return nil, err
+	}
+	return deriveTuple_int64_error(f(v)), nil
+}
+
+// deriveFmapChanChan returns an output channel where the items are the result of the input function being applied to the items on the input channel.
+func deriveFmapChanChan(f func(string) <-chan int, in <-chan string) <-chan (<-chan int) {
+	out := make(chan (<-chan int), cap(in))
+	go func() {
+		for a := range in {
+			b := f(a)
+			out <- b
+		}
+		close(out)
+	}()
+	return out
+}
+
+// deriveFlipMarshal returns the input function, but where first two parameters are flipped.
+func deriveFlipMarshal(f func(data []byte, v any) error) func(v any, data []byte) error {
+	return func(v any, data []byte) error {
+		return f(data, v)
+	}
+}
+
+// deriveFlip3 returns the input function, but where first two parameters are flipped.
+func deriveFlip3(f func(a int, b string, c bool) string) func(b string, a int, c bool) string {
+	return func(b string, a int, c bool) string {
+		return f(a, b, c)
+	}
+}
+
+// deriveFlipBlankIdentifier returns the input function, but where first two parameters are flipped.
+func deriveFlipBlankIdentifier(f func(a string, param_1 bool, c int) string) func(param_1 bool, a string, c int) string {
+	return func(param_1 bool, a string, c int) string {
+		return f(a, param_1, c)
+	}
+}
+
+// deriveSetInt64s returns the input list as a map with the items of the list as the keys of the map.
+//
+// Deprecated: In favour of generics.
+func deriveSetInt64s(list []int64) map[int64]struct{} {
+	set := make(map[int64]struct{}, len(list))
+	for _, v := range list {
+		set[v] = struct{}{}
+	}
+	return set
+}
+
+// deriveMinInt64s returns the minimum value from the list, or the default value if the list is empty.
+//
+// Deprecated: In favour of generics.
+func deriveMinInt64s(list []int64, def int64) int64 {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v < m {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMinInt returns the minimum of the two input values.
+//
+// Deprecated: In favour of generics.
+func deriveMinInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+// deriveMinStructs returns the minimum value from the list, or the default value if the list is empty.
+//
+// Deprecated: In favour of generics.
+func deriveMinStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if deriveComparePtrToBuiltInTypes(v, m) < 0 {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMemGet returns a memoized version of the input function.
+func deriveMemGet(f func() *BuiltInTypes) func() *BuiltInTypes {
+	memoized := false
+	var res0 *BuiltInTypes
+	return func() *BuiltInTypes {
+		if !memoized {
+			res0 = f()
+			memoized = true
+		}
+		return res0
+	}
+}
+
+// deriveMemInc returns a memoized version of the input function.
+func deriveMemInc(f func(n int) int) func(n int) int {
+	m := make(map[int]int)
+	return func(param0 int) int {
+		if v, ok := m[param0]; ok {
+			return v
+		}
+		v := f(param0)
+		m[param0] = v
+		return v
+	}
+}
+
+// deriveMemIncTo returns a memoized version of the input function.
+func deriveMemIncTo(f func(a Adder) int) func(a Adder) int {
+	m := make(map[Adder]int)
+	return func(param0 Adder) int {
+		if v, ok := m[param0]; ok {
+			return v
+		}
+		v := f(param0)
+		m[param0] = v
+		return v
+	}
+}
+
+// deriveMemAdd returns a memoized version of the input function.
+func deriveMemAdd(f func(a int, b int) int) func(a int, b int) int {
+	type input struct {
+		Param0 int
+		Param1 int
+	}
+	m := make(map[input]int)
+	return func(param0 int, param1 int) int {
+		in := input{param0, param1}
+		if v, ok := m[in]; ok {
+			return v
+		}
+		v := f(param0, param1)
+		m[in] = v
+		return v
+	}
+}
+
+// deriveMemAddTo returns a memoized version of the input function.
+func deriveMemAddTo(f func(a Adder, b int) int) func(a Adder, b int) int {
+	type input struct {
+		Param0 Adder
+		Param1 int
+	}
+	m := make(map[input]int)
+	return func(param0 Adder, param1 int) int {
+		in := input{param0, param1}
+		if v, ok := m[in]; ok {
+			return v
+		}
+		v := f(param0, param1)
+		m[in] = v
+		return v
+	}
+}
+
+// deriveMemSet returns a memoized version of the input function.
+func deriveMemSet(f func(a *BuiltInTypes, b int) *BuiltInTypes) func(a *BuiltInTypes, b int) *BuiltInTypes {
+	type input struct {
+		Param0 *BuiltInTypes
+		Param1 int
+	}
+	type mem struct {
+		in  input
+		out *BuiltInTypes
+	}
+	m := make(map[uint64][]mem)
+	return func(param0 *BuiltInTypes, param1 int) *BuiltInTypes {
+		in := input{param0, param1}
+		h := deriveHash_fdb5c2f2(in)
+		vs, ok := m[h]
+		if ok {
+			for _, v := range vs {
+				if deriveEqual_13db7a41(v.in, in) {
+					return v.out
+				}
+			}
+		}
+		res0 := f(param0, param1)
+		m[h] = append(m[h], mem{in, res0})
+		return res0
+	}
+}
+
+// deriveMemSetErr returns a memoized version of the input function.
+func deriveMemSetErr(f func(a *BuiltInTypes, b int) (*BuiltInTypes, error)) func(a *BuiltInTypes, b int) (*BuiltInTypes, error) {
+	type input struct {
+		Param0 *BuiltInTypes
+		Param1 int
+	}
+	type output struct {
+		Res0 *BuiltInTypes
+		Res1 error
+	}
+	type mem struct {
+		in  input
+		out output
+	}
+	m := make(map[uint64][]mem)
+	return func(param0 *BuiltInTypes, param1 int) (*BuiltInTypes, error) {
+		in := input{param0, param1}
+		h := deriveHash_fdb5c2f2(in)
+		vs, ok := m[h]
+		if ok {
+			for _, v := range vs {
+				if deriveEqual_13db7a41(v.in, in) {
+					return v.out.Res0, v.out.Res1
+				}
+			}
+		}
+		res0, res1 := f(param0, param1)
+		m[h] = append(m[h], mem{in, output{res0, res1}})
+		return res0, res1
+	}
+}
+
+// deriveMaxInt64s returns the maximum value from the input list and the default value, if the list is empty.
+//
+// Deprecated: In favour of generics.
+func deriveMaxInt64s(list []int64, def int64) int64 {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if v > m {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveMaxInt returns the maximum of the two input values.
+//
+// Deprecated: In favour of generics.
+func deriveMaxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// deriveMaxStructs returns the maximum value from the input list and the default value, if the list is empty.
+//
+// Deprecated: In favour of generics.
+func deriveMaxStructs(list []*BuiltInTypes, def *BuiltInTypes) *BuiltInTypes {
+	if len(list) == 0 {
+		return def
+	}
+	m := list[0]
+	list = list[1:]
+	for i, v := range list {
+		if deriveComparePtrToBuiltInTypes(v, m) > 0 {
+			m = list[i]
+		}
+	}
+	return m
+}
+
+// deriveDup duplicates messages received on c to both c1 and c2.
+func deriveDup(c chan int) (c1, c2 <-chan int) {
+	cc1, cc2 := make(chan int, cap(c)), make(chan int, cap(c))
+	go func() {
+		for v := range c {
+			cc1 <- v
+			cc2 <- v
+		}
+		close(cc1)
+		close(cc2)
+	}()
+	return cc1, cc2
+}
+
+// deriveAny reports whether the predicate returns true for any of the elements in the given slice.
+//
+// Deprecated: In favour of generics.
+func deriveAny(pred func(int) bool, list []int) bool {
+	for _, elem := range list {
+		if pred(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveAnyEqualCurry reports whether the predicate returns true for any of the elements in the given slice.
+//
+// Deprecated: In favour of generics.
+func deriveAnyEqualCurry(pred func(*BuiltInTypes) bool, list []*BuiltInTypes) bool {
+	for _, elem := range list {
+		if pred(elem) {
+			return true
+		}
+	}
+	return false
+}
+
+// deriveAll reports whether the predicate returns true for all of the elements in the given slice.
+//
+// Deprecated: In favour of generics.
+func deriveAll(predicate func(int) bool, slice []int) bool {
+	for _, elem := range slice {
+		if !predicate(elem) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveDo concurrently executes the input functions f0 and f1 and when all functions are finished the first error, if any, and results are returned.
+func deriveDo(f0 func() (string, error), f1 func() (int, error)) (string, int, error) {
+	errChan := make(chan error)
+	var v0 string
+	go func() {
+		var v0err error
+		v0, v0err = f0()
+		errChan <- v0err
+	}()
+	var v1 int
+	go func() {
+		var v1err error
+		v1, v1err = f1()
+		errChan <- v1err
+	}()
+	var err error
+	for i := 0; i < 2; i++ {
+		errc := <-errChan
+		if errc != nil {
+			if err == nil {
+				err = errc
+			}
+		}
+	}
+	return v0, v1, err
+}
+
+// deriveGoString_Pair_string_PtrTo_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_Pair_string_PtrTo_Name(this Pair[string, *Name]) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() test.Pair[string, *test.Name] {\n")
+	fmt.Fprintf(buf, "this := &test.Pair[string, *test.Name]{}\n")
+	fmt.Fprintf(buf, "this.Key = %#v\n", this.Key)
+	if this.Value != nil {
+		fmt.Fprintf(buf, "this.Value = %s\n", deriveGoStringName(this.Value))
+	}
+	fmt.Fprintf(buf, "return *this\n")
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveGoString_SliceOf_PtrTo_Tree_Pair_string_PtrTo_Name returns a recursive representation of this as a valid go string.
+func deriveGoString_SliceOf_PtrTo_Tree_Pair_string_PtrTo_Name(this []*Tree[Pair[string, *Name]]) string {
+	buf := bytes.NewBuffer(nil)
+	fmt.Fprintf(buf, "func() []*test.Tree[test.Pair[string, *test.Name]] {\n")
+	if this == nil {
+		fmt.Fprintf(buf, "return nil\n")
+	} else {
+		fmt.Fprintf(buf, "this := make([]*test.Tree[test.Pair[string, *test.Name]], %d)\n", len(this))
+		for i := range this {
+			fmt.Fprintf(buf, "this[%d] = %s\n", i, deriveGoStringTreeOfPair(this[i]))
+		}
+		fmt.Fprintf(buf, "return this\n")
+	}
+	fmt.Fprintf(buf, "}()\n")
+	return buf.String()
+}
+
+// deriveDeepCopy_SliceOf_int recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_int(dst, src []int) {
+	copy(dst, src)
+}
+
+// deriveDeepCopy_PtrTo_int recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_int(dst, src *int) {
+	*dst = *src
+}
+
+// deriveDeepCopy_PtrTo_ArrayOf10_int recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_ArrayOf10_int(dst, src *[10]int) {
+	*dst = *src
+}
+
+// deriveDeepCopy_PtrTo_Tree_string recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Tree_string(dst, src *Tree[string]) {
+	dst.Value = src.Value
+	if src.Children == nil {
+		dst.Children = nil
+	} else {
+		if dst.Children != nil {
+			if len(src.Children) > len(dst.Children) {
+				if cap(dst.Children) >= len(src.Children) {
+					dst.Children = (dst.Children)[:len(src.Children)]
+				} else {
+					dst.Children = make([]*Tree[string], len(src.Children))
+				}
+			} else if len(src.Children) < len(dst.Children) {
+				dst.Children = (dst.Children)[:len(src.Children)]
+			}
+		} else {
+			dst.Children = make([]*Tree[string], len(src.Children))
+		}
+		deriveDeepCopy_SliceOf_PtrTo_Tree_string(dst.Children, src.Children)
+	}
+}
+
+// deriveDeepCopy_PtrTo_Score recursively copies the contents of src into dst.
+func deriveDeepCopy_PtrTo_Score(dst, src *Score) {
+	dst.Name = src.Name
+	dst.Points = src.Points
+}
+
+// deriveCompare_PtrTo_StructWithStringAlias returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_StructWithStringAlias(this, that *StructWithStringAlias) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := strings.Compare(string(this.Field), string(that.Field)); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveCompare_PtrTo_Score returns:
+//   - 0 if this and that are equal,
+//   - -1 is this is smaller and
+//   - +1 is this is bigger.
+func deriveCompare_PtrTo_Score(this, that *Score) int {
+	if this == nil {
+		if that == nil {
+			return 0
+		}
+		return -1
+	}
+	if that == nil {
+		return 1
+	}
+	if c := strings.Compare(this.Name, that.Name); c != 0 {
+		return c
+	}
+	if c := deriveCompare_int(this.Points, that.Points); c != 0 {
+		return c
+	}
+	return 0
+}
+
+// deriveTuple_int_error returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple_int_error(v0 int, v1 error) func() (int, error) {
+	return func() (int, error) {
+		return v0, v1
+	}
+}
+
+// deriveTuple_int_string_error returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple_int_string_error(v0 int, v1 string, v2 error) func() (int, string, error) {
+	return func() (int, string, error) {
+		return v0, v1, v2
+	}
+}
+
+// deriveTuple_int64_error returns a function, which returns the input values.
+// Since tuples are not first class citizens in Go, this is a way to fake it, because functions that return tuples are first class citizens.
+func deriveTuple_int64_error(v0 int64, v1 error) func() (int64, error) {
+	return func() (int64, error) {
+		return v0, v1
+	}
+}
+
+// deriveEqual_PtrTo_Visitor returns whether this and that are equal.
+func deriveEqual_PtrTo_Visitor(this, that *Visitor) bool {
+	return (this == nil && that == nil) ||
+		this != nil && that != nil &&
+			((this.UserName == nil && that.UserName == nil) || (this.UserName != nil && that.UserName != nil && *(this.UserName) == *(that.UserName))) &&
+			this.RemoteAddr == that.RemoteAddr
+}
+
+// deriveEqual_SliceOf_PtrTo_Tree_string returns whether this and that are equal.
+func deriveEqual_SliceOf_PtrTo_Tree_string(this, that []*Tree[string]) bool {
+	if this == nil || that == nil {
+		return this == nil && that == nil
+	}
+	if len(this) != len(that) {
+		return false
+	}
+	for i := 0; i < len(this); i++ {
+		if !(deriveEqualTreeOfString(this[i], that[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+// deriveEqual_13db7a41 returns whether this and that are equal.
+func deriveEqual_13db7a41(this, that struct {
+	Param0 *BuiltInTypes
+	Param1 int
+}) bool {
+	return this.Param0.Equal(that.Param0) &&
+		this.Param1 == that.Param1
+}
+
+// deriveHash_ArrayOf10_int returns the hash of the object.
+func deriveHash_ArrayOf10_int(object [10]int) uint64 {
+	h := uint64(17)
+	for i := 0; i < len(object); i++ {
+		h = 31*h + uint64(object[i])
+	}
+	return h
+}
+
+// deriveHash_fdb5c2f2 returns the hash of the object.
+func deriveHash_fdb5c2f2(object struct {
+	Param0 *BuiltInTypes
+	Param1 int
+}) uint64 {
+	h := uint64(17)
+	h = 31*h + deriveHashBuiltInTypes(object.Param0)
+	h = 31*h + uint64(object.Param1)
+	return h
+}
+
+// deriveDeepCopy_SliceOf_PtrTo_Tree_string recursively copies the contents of src into dst.
+func deriveDeepCopy_SliceOf_PtrTo_Tree_string(dst, src []*Tree[string]) {
+	for src_i, src_value := range src {
+		if src_value == nil {
+			dst[src_i] = nil
+		} else {
+			dst[src_i] = new(Tree[string])
+			deriveDeepCopy_PtrTo_Tree_string(dst[src_i], src_value)
+		}
+	}
+}
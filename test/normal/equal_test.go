@@ -53,6 +53,8 @@ func TestEqualStructs(t *testing.T) {
 		&Duration{},
 		&Nickname{},
 		&PrivateEmbedded{},
+		&Generics{},
+		&Methods{},
 	}
 	for _, this := range structs {
 		desc := reflect.TypeOf(this).Elem().Name()
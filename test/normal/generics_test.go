@@ -0,0 +1,106 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"go/parser"
+	"go/token"
+	"reflect"
+	"testing"
+)
+
+type Versioned[T comparable] struct {
+	Value   T
+	Version int
+}
+
+// Equal ignores the version, so that we can test that goderive uses this method.
+func (this *Versioned[T]) Equal(that *Versioned[T]) bool {
+	return this.Value == that.Value
+}
+
+type LatestVersions struct {
+	Name  *Versioned[string]
+	Count *Versioned[int]
+}
+
+func newIntTree(values ...int) *Tree[int] {
+	tree := &Tree[int]{Value: values[0]}
+	for _, v := range values[1:] {
+		tree.Children = append(tree.Children, &Tree[int]{Value: v})
+	}
+	return tree
+}
+
+func TestGenericsRecursiveInstantiations(t *testing.T) {
+	intTree := newIntTree(1, 2, 3)
+	stringTree := &Tree[string]{Value: "a", Children: []*Tree[string]{{Value: "b"}}}
+	if !deriveEqualTreeOfInt(intTree, newIntTree(1, 2, 3)) {
+		t.Fatalf("expected equal int trees")
+	}
+	if deriveEqualTreeOfInt(intTree, newIntTree(1, 2, 4)) {
+		t.Fatalf("expected different int trees")
+	}
+	if !deriveEqualTreeOfString(stringTree, deriveCloneTreeOfString(stringTree)) {
+		t.Fatalf("expected equal string trees")
+	}
+	if c := deriveCompareTreeOfInt(intTree, newIntTree(1, 2, 4)); c != -1 {
+		t.Fatalf("compare: got %d want %d", c, -1)
+	}
+	if deriveHashTreeOfInt(intTree) != deriveHashTreeOfInt(newIntTree(1, 2, 3)) {
+		t.Fatalf("expected equal hashes for equal int trees")
+	}
+}
+
+func TestGenericsEqualMethod(t *testing.T) {
+	this := &LatestVersions{Name: &Versioned[string]{"a", 1}, Count: &Versioned[int]{1, 1}}
+	that := &LatestVersions{Name: &Versioned[string]{"a", 2}, Count: &Versioned[int]{1, 2}}
+	if !deriveEqualLatestVersions(this, that) {
+		t.Fatalf("expected the Equal method of the instantiated type to be used, which ignores the version")
+	}
+	that.Count.Value = 2
+	if deriveEqualLatestVersions(this, that) {
+		t.Fatalf("expected not equal")
+	}
+}
+
+func TestGenericsGoString(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		this := random(&Tree[Pair[string, *Name]]{}).(*Tree[Pair[string, *Name]])
+		s := deriveGoStringTreeOfPair(this)
+		content := `package main
+		func main() {
+		` + s + `
+		}
+		`
+		fset := token.NewFileSet()
+		if _, err := parser.ParseFile(fset, "main.go", content, parser.AllErrors); err != nil {
+			t.Fatalf("parse error: %v, given input <%s>", err, s)
+		}
+	}
+}
+
+func TestGenericsDeepCopy(t *testing.T) {
+	this := &Generics{IntTree: newIntTree(1, 2, 3), Pairs: []Pair[string, *Name]{{Key: "a", Value: &Name{}}}}
+	that := &Generics{}
+	deriveDeepCopyPtrToGenerics(that, this)
+	if !reflect.DeepEqual(this, that) {
+		t.Fatalf("want %#v got %#v", this, that)
+	}
+	that.IntTree.Children[0].Value = 4
+	if this.IntTree.Children[0].Value != 2 {
+		t.Fatalf("expected a deep copy of the tree")
+	}
+}
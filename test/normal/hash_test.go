@@ -51,6 +51,8 @@ func TestHashStructs(t *testing.T) {
 		&Duration{},
 		&Nickname{},
 		&PrivateEmbedded{},
+		&Generics{},
+		&Methods{},
 	}
 	for _, this := range structs {
 		desc := reflect.TypeOf(this).Elem().Name()
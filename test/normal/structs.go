@@ -17,6 +17,7 @@ package test
 import (
 	"math/rand"
 	"reflect"
+	"sync"
 	"time"
 
 	"awalterschulze.org/go/goderive/test/extra"
@@ -901,3 +902,98 @@ type StructOfStructs struct {
 func (this *StructOfStructs) DeepCopy(that *StructOfStructs) {
 	deriveDeepCopyPtrToStructOfStructs(that, this)
 }
+
+type Pair[K comparable, V any] struct {
+	Key   K
+	Value V
+}
+
+type Tree[T any] struct {
+	Value    T
+	Children []*Tree[T]
+}
+
+type Generics struct {
+	IntTree    *Tree[int]
+	NameTree   Tree[Name]
+	Pairs      []Pair[string, *Name]
+	PairsByKey map[string]Pair[int64, []string]
+	Box        *extra.Box[Pair[string, int]]
+}
+
+func (this *Generics) Equal(that *Generics) bool {
+	return deriveEqualPtrToGenerics(this, that)
+}
+
+func (this *Generics) Compare(that *Generics) int {
+	return deriveComparePtrToGenerics(this, that)
+}
+
+func (this *Generics) DeepCopy(that *Generics) {
+	deriveDeepCopyPtrToGenerics(that, this)
+}
+
+func (this *Generics) Clone() *Generics {
+	return deriveCloneGenerics(this)
+}
+
+func (this *Generics) Hash() uint64 {
+	return deriveHashGenerics(this)
+}
+
+// Cache has fields that are skipped by all or some of the plugins, because of their derive struct tag.
+type Cache struct {
+	Key     string
+	Values  []int
+	mu      sync.Mutex `derive:"-"`
+	Hits    int        `derive:"equal=-,hash=-,compare=-"`
+	Updated time.Time  `derive:"-"`
+	Entry   CacheEntry
+}
+
+// CacheEntry is comparable with ==, but == cannot be used to derive equality, since memo is skipped.
+type CacheEntry struct {
+	Value int
+	memo  int    `derive:"equal=-,hash=-,compare=-"`
+	Token string `derive:"clone=-"`
+}
+
+func (this *Cache) Equal(that *Cache) bool {
+	return deriveEqualPtrToCache(this, that)
+}
+
+func (this *Cache) Compare(that *Cache) int {
+	return deriveComparePtrToCache(this, that)
+}
+
+func (this *Cache) DeepCopy(that *Cache) {
+	deriveDeepCopyPtrToCache(that, this)
+}
+
+func (this *Cache) Clone() *Cache {
+	return deriveCloneCache(this)
+}
+
+func (this *Cache) Hash() uint64 {
+	return deriveHashCache(this)
+}
+
+func (this *Cache) GoString() string {
+	return deriveGoStringCache(this)
+}
+
+// Methods has all of its methods generated by goderive, instead of calling the derive functions from handwritten methods.
+//
+//goderive:methods equal,compare,hash,gostring,deepcopy,clone
+type Methods struct {
+	Name    string
+	Aliases []string
+	Pair    *Pair[string, int64]
+	Inner   Name
+}
+
+// Score is used to test derive functions that are passed as function values, instead of being called.
+type Score struct {
+	Name   string
+	Points int
+}
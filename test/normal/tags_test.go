@@ -0,0 +1,113 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func newCaches() (*Cache, *Cache) {
+	this := &Cache{Key: "a", Values: []int{1, 2}, Hits: 1, Updated: time.Unix(1, 0), Entry: CacheEntry{Value: 1, memo: 1}}
+	that := &Cache{Key: "a", Values: []int{1, 2}, Hits: 2, Updated: time.Unix(2, 0), Entry: CacheEntry{Value: 1, memo: 2}}
+	that.mu.Lock()
+	return this, that
+}
+
+func TestSkipEqual(t *testing.T) {
+	this, that := newCaches()
+	if !this.Equal(that) {
+		t.Fatalf("expected skipped fields to be ignored")
+	}
+	that.Entry.Value = 2
+	if this.Equal(that) {
+		t.Fatalf("expected entries with different values to not be equal")
+	}
+}
+
+func TestSkipHash(t *testing.T) {
+	this, that := newCaches()
+	if this.Hash() != that.Hash() {
+		t.Fatalf("expected skipped fields to be ignored")
+	}
+	that.Key = "b"
+	if this.Hash() == that.Hash() {
+		t.Fatalf("expected different keys to have different hashes")
+	}
+}
+
+func TestSkipCompare(t *testing.T) {
+	this, that := newCaches()
+	if c := this.Compare(that); c != 0 {
+		t.Fatalf("expected skipped fields to be ignored, but got %d", c)
+	}
+	that.Key = "b"
+	if c := this.Compare(that); c != -1 {
+		t.Fatalf("want -1, but got %d", c)
+	}
+}
+
+func TestSkipDeepCopy(t *testing.T) {
+	this, that := newCaches()
+	this.DeepCopy(that)
+	if that.Hits != this.Hits {
+		t.Fatalf("expected Hits to be copied, since it is only skipped by equal, hash and compare")
+	}
+	if !that.Updated.Equal(time.Unix(2, 0)) {
+		t.Fatalf("expected Updated to keep its value, but got %v", that.Updated)
+	}
+	if that.mu.TryLock() {
+		t.Fatalf("expected the mutex to not be copied")
+	}
+}
+
+func TestSkipClone(t *testing.T) {
+	this, _ := newCaches()
+	this.mu.Lock()
+	that := this.Clone()
+	if !that.Updated.IsZero() {
+		t.Fatalf("expected Updated to not be cloned, but got %v", that.Updated)
+	}
+	if !that.mu.TryLock() {
+		t.Fatalf("expected the mutex to not be cloned")
+	}
+	if !this.Equal(that) {
+		t.Fatalf("expected clone to be equal")
+	}
+}
+
+func TestSkipCloneOnly(t *testing.T) {
+	this, that := newCaches()
+	this.Entry.Token = "token"
+	if clone := this.Clone(); clone.Entry.Token != "" {
+		t.Fatalf("expected Token to not be cloned, but got %q", clone.Entry.Token)
+	}
+	this.DeepCopy(that)
+	if that.Entry.Token != "token" {
+		t.Fatalf("expected Token to be copied, since it is only skipped by clone, but got %q", that.Entry.Token)
+	}
+}
+
+func TestSkipGoString(t *testing.T) {
+	this, _ := newCaches()
+	s := this.GoString()
+	if strings.Contains(s, "Updated") || strings.Contains(s, "mu") {
+		t.Fatalf("expected skipped fields to not be part of the GoString, but got %s", s)
+	}
+	if !strings.Contains(s, "Hits") {
+		t.Fatalf("expected Hits to be part of the GoString, since it is not skipped by gostring, but got %s", s)
+	}
+}
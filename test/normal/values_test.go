@@ -0,0 +1,67 @@
+//  Copyright 2017 Walter Schulze
+//
+//  Licensed under the Apache License, Version 2.0 (the "License");
+//  you may not use this file except in compliance with the License.
+//  You may obtain a copy of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+//  Unless required by applicable law or agreed to in writing, software
+//  distributed under the License is distributed on an "AS IS" BASIS,
+//  WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+//  See the License for the specific language governing permissions and
+//  limitations under the License.
+
+package test
+
+import (
+	"slices"
+	"testing"
+)
+
+type scoreFuncs struct {
+	clone func(*Score) *Score
+	hash  func(*Score) uint64
+}
+
+func TestValueParameter(t *testing.T) {
+	scores := []Score{{"b", 1}, {"a", 2}, {"a", 1}}
+	slices.SortFunc(scores, deriveCompareScore)
+	want := []Score{{"a", 1}, {"a", 2}, {"b", 1}}
+	if !slices.EqualFunc(scores, want, deriveEqualScore) {
+		t.Fatalf("got %v, want %v", scores, want)
+	}
+}
+
+func TestValueDeclaration(t *testing.T) {
+	var equal func(this, that *Score) bool = deriveEqualPtrToScore
+	if !equal(&Score{"a", 1}, &Score{"a", 1}) {
+		t.Fatalf("expected equal scores")
+	}
+	if equal(&Score{"a", 1}, &Score{"a", 2}) {
+		t.Fatalf("expected different scores")
+	}
+}
+
+func TestValueAssignment(t *testing.T) {
+	var gostring func(*Score) string
+	gostring = deriveGoStringScore
+	if got := gostring(&Score{"a", 1}); len(got) == 0 {
+		t.Fatalf("expected a GoString")
+	}
+}
+
+func TestValueCompositeLiteral(t *testing.T) {
+	funcs := scoreFuncs{
+		clone: deriveCloneScore,
+		hash:  deriveHashScore,
+	}
+	s := &Score{"a", 1}
+	c := funcs.clone(s)
+	if c == s || *c != *s {
+		t.Fatalf("expected a copy, but got %v", c)
+	}
+	if funcs.hash(s) != funcs.hash(c) {
+		t.Fatalf("expected equal hashes")
+	}
+}
@@ -0,0 +1,32 @@
+cp safe.gold safe.go
+if ! goderive -safe . ; then
+    echo "expected safe mode to generate code for types with methods that compare their private fields"
+    rm -f ./derived.gen.go ./safe.go
+    exit 1
+fi
+status=0
+if grep -q -e '"reflect"' -e '"unsafe"' derived.gen.go ; then
+    echo "expected safe mode to never import reflect or unsafe"
+    status=1
+fi
+if ! grep -q 'Equal(' derived.gen.go || ! grep -q 'Compare(' derived.gen.go ; then
+    echo "expected safe mode to call the Equal and Compare methods of time.Time"
+    status=1
+fi
+if ! go vet . ; then
+    status=1
+fi
+rm -f ./derived.gen.go ./safe.go
+for f in buffer deepcopy ; do
+    cp $f.gold $f.go
+    if goderive -safe . 2> $f.err ; then
+        echo "expected safe mode to refuse to generate code that accesses the private fields of $f"
+        status=1
+    elif ! grep -q "is a private field of the external type" $f.err ; then
+        cat $f.err
+        echo "expected safe mode to report the private field of $f"
+        status=1
+    fi
+    rm -f ./derived.gen.go ./$f.go ./$f.err
+done
+exit $status
@@ -0,0 +1,26 @@
+mkdir -p a b
+cp a.gold a/a.go
+cp b.gold b/b.go
+goderive -shared=test/shared/derived ./a ./b
+status=0
+if ! grep -q "^func DeriveEqual_time_Time(" ./derived/derived.gen.go || ! grep -q "^func DeriveEqual_SliceOf_time_Time(" ./derived/derived.gen.go; then
+    echo "expected the functions for time.Time to be generated in the shared package"
+    status=1
+elif ! grep -q "return derived.DeriveEqual_time_Time(this, that)" ./a/derived.gen.go || ! grep -q "return derived.DeriveEqual_time_Time(this, that)" ./b/derived.gen.go; then
+    echo "expected the derived files to call the shared package"
+    status=1
+elif ! grep -q "^func deriveEqual(this, that \*A) bool" ./a/derived.gen.go; then
+    echo "expected the function for a type of the package to be generated in the package"
+    status=1
+elif ! go vet ./a ./b ./derived; then
+    echo "expected the packages to compile"
+    status=1
+else
+    goderive -shared=test/shared/derived ./a
+    if ! grep -q "^func DeriveEqual_SliceOf_time_Time(" ./derived/derived.gen.go; then
+        echo "expected the functions required by package b to be kept, when only package a is generated"
+        status=1
+    fi
+fi
+rm -rf ./a ./b ./derived
+exit $status
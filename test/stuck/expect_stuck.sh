@@ -0,0 +1,14 @@
+cp stuck.gold stuck.go
+if goderive -json . > stuck.json ; then
+    echo "expected the calls, for which the argument types cannot be inferred, to be reported"
+    rm -f ./derived.gen.go ./stuck.go ./stuck.json
+    exit 1
+fi
+sed -i "s#$(pwd)/##g" stuck.json
+status=0
+if ! diff expected.json stuck.json ; then
+    echo "expected the report of every stuck call to match expected.json"
+    status=1
+fi
+rm -f ./derived.gen.go ./stuck.go ./stuck.json
+exit $status
@@ -0,0 +1,23 @@
+cp testonly.gold testonly.go
+cp testonly_test.gold testonly_test.go
+cp xtest_test.gold xtest_test.go
+goderive .
+status=0
+if ! grep -q "^func deriveEqual(" ./derived.gen.go; then
+    echo "expected deriveEqual, which is called from the package, in derived.gen.go"
+    status=1
+elif grep -q "deriveClone" ./derived.gen.go; then
+    echo "expected deriveClone, which is only called from tests, not to be in derived.gen.go"
+    status=1
+elif ! grep -q "^func deriveClone(" ./derived.gen_test.go; then
+    echo "expected deriveClone in derived.gen_test.go"
+    status=1
+elif ! grep -q "^package testonly_test" ./derived.gen_xtest_test.go || ! grep -q "^func deriveSort(" ./derived.gen_xtest_test.go; then
+    echo "expected deriveSort in the external test package in derived.gen_xtest_test.go"
+    status=1
+elif ! go build . || ! go test .; then
+    echo "expected the package to build without its tests and the tests to pass"
+    status=1
+fi
+rm -f ./testonly.go ./testonly_test.go ./xtest_test.go ./derived.gen.go ./derived.gen_test.go ./derived.gen_xtest_test.go
+exit $status
@@ -0,0 +1,23 @@
+cp before.gold unused.go
+goderive .
+cp after.gold unused.go
+goderive -report-unused . > unused.out
+status=0
+if ! grep -q "derived.gen.go:[0-9]*:[0-9]*: deriveKeys is not used by any call or derived function" unused.out; then
+    echo "expected deriveKeys to be reported as unused"
+    status=1
+elif grep -q "deriveEqual" unused.out; then
+    echo "expected deriveEqual not to be reported"
+    status=1
+elif ! grep -q "unused.go:[0-9]*:[0-9]*: deriveFilterPositive is declared by hand, but has the prefix of the filter plugin" unused.out; then
+    echo "expected deriveFilterPositive to be reported as declared by hand"
+    status=1
+elif grep -q "deriveKeys" derived.gen.go; then
+    echo "expected deriveKeys to be removed from derived.gen.go"
+    status=1
+fi
+if [ $status -ne 0 ]; then
+    cat unused.out
+fi
+rm ./unused.go ./unused.out ./derived.gen.go
+exit $status
@@ -0,0 +1,48 @@
+cp watch.gold watch.go
+goderive -watch -autoname -nocache -v . 2> ./watch.log &
+pid=$!
+# eventually waits up to ten seconds for the condition to become true.
+eventually() {
+    for i in $(seq 1 100); do
+        if eval "$1"; then
+            return 0
+        fi
+        sleep 0.1
+    done
+    return 1
+}
+runs() {
+    grep -c "^cache:" ./watch.log
+}
+status=0
+if ! eventually 'grep -q "func deriveMin_uint(" derived.gen.go 2> /dev/null && grep -q "deriveMin_uint(a, b)" watch.go'; then
+    echo "expected watch to generate the code and rename the conflicting call"
+    status=1
+else
+    # the derived file and the renamed call are written by goderive itself and should not trigger another run.
+    sleep 2
+    if [ "$(runs)" != "1" ]; then
+        echo "expected watch not to trigger itself, but it ran $(runs) times"
+        status=1
+    fi
+    printf '\nfunc keys(m map[string]int) []string {\n\treturn deriveKeys(m)\n}\n' >> watch.go
+    if ! eventually 'grep -q "func deriveKeys(" derived.gen.go'; then
+        echo "expected watch to generate the code for the changed file"
+        status=1
+    fi
+    printf '\nfunc notMap(a int) []int {\n\treturn deriveKeys(a)\n}\n' >> watch.go
+    if ! eventually 'grep -q "watch.go:.*keys: " ./watch.log'; then
+        echo "expected watch to print the diagnostics"
+        status=1
+    elif ! kill -0 $pid 2> /dev/null; then
+        echo "expected watch to keep running after the diagnostics"
+        status=1
+    fi
+fi
+kill $pid 2> /dev/null
+wait $pid 2> /dev/null
+if [ $status -ne 0 ]; then
+    cat ./watch.log
+fi
+rm -f ./derived.gen.go ./watch.go ./watch.log
+exit $status